@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	inventoryApiV1 "github.com/Denisz0785/spaceyard/inventory/internal/api/inventory/v1"
+	blobRepository "github.com/Denisz0785/spaceyard/inventory/internal/repository/blob"
+	partRepository "github.com/Denisz0785/spaceyard/inventory/internal/repository/part"
+	partService "github.com/Denisz0785/spaceyard/inventory/internal/service/part"
+	"github.com/Denisz0785/spaceyard/shared/pkg/interceptor"
+	in "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+const (
+	port     = "localhost:8080"
+	httpPort = "localhost:8090"
+	// Каталог для содержимого вложений деталей
+	attachmentsDir = "data/attachments"
+	// Таймауты для HTTP-сервера
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 10 * time.Second
+)
+
+func main() {
+	lis, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	// Запросы проверяются по аннотациям buf.validate до вызова обработчиков
+	validator, err := interceptor.NewValidator(
+		inventoryApiV1.ErrorDomain,
+		in.ErrorReason_ERROR_REASON_INVALID_ARGUMENT.String(),
+	)
+	if err != nil {
+		log.Fatalf("failed to create request validator: %v", err)
+	}
+
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(validator.Unary()),
+		grpc.StreamInterceptor(validator.Stream()),
+	)
+
+	// Регистрируем наш сервис
+	repo := partRepository.NewRepository()
+	blobStore, err := blobRepository.NewLocalStore(attachmentsDir)
+	if err != nil {
+		log.Fatalf("failed to create attachment store: %v", err)
+	}
+	service := partService.NewService(repo, blobStore)
+	api := inventoryApiV1.NewAPI(service)
+
+	in.RegisterInventoryServiceServer(s, api)
+
+	// Включаем рефлексию для отладки
+	reflection.Register(s)
+
+	go func() {
+		log.Printf("server listening at %v", lis.Addr())
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	// HTTP/JSON gateway поверх gRPC API
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Имена полей в JSON совпадают с proto (snake_case), как и в OpenAPI-описании
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+	err = in.RegisterInventoryServiceHandlerFromEndpoint(ctx, mux, port, []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	})
+	if err != nil {
+		log.Fatalf("failed to register gateway: %v", err)
+	}
+
+	gwServer := &http.Server{
+		Addr:              httpPort,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		log.Printf("http gateway listening at %s", httpPort)
+		if err := gwServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed to serve http gateway: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	log.Println("🛑 Shutting down servers...")
+
+	// Сначала останавливаем HTTP gateway, чтобы не принимать новые запросы
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+	if err := gwServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("failed to shutdown http gateway: %v", err)
+	}
+	log.Println("✅ HTTP gateway stopped")
+
+	// В конце останавливаем gRPC сервер
+	s.GracefulStop()
+	log.Println("✅ gRPC server stopped")
+}
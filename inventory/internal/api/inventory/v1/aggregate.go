@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/converter"
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// AggregatePhysicalProperties returns total mass and bounding volume of a set of parts.
+func (a *api) AggregatePhysicalProperties(ctx context.Context, req *inventoryv1.AggregatePhysicalPropertiesRequest) (*inventoryv1.AggregatePhysicalPropertiesResponse, error) {
+	log.Println("Get request for aggregate physical properties of parts")
+
+	summary, err := a.partService.AggregatePhysicalProperties(
+		ctx,
+		req.GetUuids(),
+		converter.UnitSystemFromProto(req.GetUnitSystem()),
+	)
+	if err != nil {
+		var notFoundErr *model.PartNotFoundError
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "uuids", "every uuid must be a valid UUID")
+		case errors.As(err, &notFoundErr):
+			return nil, partNotFoundError(notFoundErr.UUID)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return converter.PhysicalSummaryToProto(summary), nil
+}
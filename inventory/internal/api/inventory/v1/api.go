@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/Denisz0785/spaceyard/inventory/internal/service"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+type api struct {
+	inventoryv1.UnimplementedInventoryServiceServer
+
+	partService service.PartService
+}
+
+func NewAPI(partService service.PartService) *api {
+	return &api{
+		partService: partService,
+	}
+}
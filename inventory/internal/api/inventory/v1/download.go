@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"log"
+
+	"google.golang.org/grpc"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/converter"
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// downloadChunkSize — размер чанка при отдаче вложения
+const downloadChunkSize = 64 << 10
+
+// DownloadAttachment отдаёт метаданные вложения, затем его содержимое чанками
+func (a *api) DownloadAttachment(req *inventoryv1.DownloadAttachmentRequest, stream grpc.ServerStreamingServer[inventoryv1.DownloadAttachmentResponse]) error {
+	log.Println("Get request for download attachment")
+
+	attachment, content, err := a.partService.DownloadAttachment(stream.Context(), req.GetPartUuid(), req.GetAttachmentUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "part_uuid", "part_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrPartNotFound):
+			return partNotFoundError(req.GetPartUuid())
+		case errors.Is(err, model.ErrAttachmentNotFound):
+			return attachmentNotFoundError(req.GetPartUuid(), req.GetAttachmentUuid())
+		default:
+			return internalError(err)
+		}
+	}
+	defer func() {
+		if err := content.Close(); err != nil {
+			log.Printf("failed to close attachment content: %v", err)
+		}
+	}()
+
+	err = stream.Send(&inventoryv1.DownloadAttachmentResponse{
+		Payload: &inventoryv1.DownloadAttachmentResponse_Attachment{Attachment: converter.AttachmentToProto(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&inventoryv1.DownloadAttachmentResponse{
+				Payload: &inventoryv1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return internalError(err)
+		}
+	}
+}
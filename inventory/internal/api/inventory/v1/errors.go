@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"fmt"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// ErrorDomain передаётся в google.rpc.ErrorInfo, чтобы клиенты могли отличить ошибки InventoryService.
+const ErrorDomain = "inventory.spaceyard"
+
+// Типы ресурсов для google.rpc.ResourceInfo.
+const (
+	partResourceType       = "inventory.v1.Part"
+	attachmentResourceType = "inventory.v1.Attachment"
+)
+
+// invalidArgumentError возвращает InvalidArgument с нарушением для конкретного поля запроса.
+func invalidArgumentError(reason inventoryv1.ErrorReason, field, description string) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description)),
+		&errdetails.ErrorInfo{
+			Reason:   reason.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"field": field},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: description},
+			},
+		},
+	)
+}
+
+// partNotFoundError возвращает NotFound с описанием отсутствующей детали.
+func partNotFoundError(partUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "part with UUID %q not found", partUUID),
+		&errdetails.ErrorInfo{
+			Reason:   inventoryv1.ErrorReason_ERROR_REASON_PART_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"uuid": partUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: partResourceType,
+			ResourceName: partUUID,
+			Description:  "part does not exist",
+		},
+	)
+}
+
+// attachmentNotFoundError возвращает NotFound с описанием отсутствующего вложения.
+func attachmentNotFoundError(partUUID, attachmentUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "attachment with UUID %q not found", attachmentUUID),
+		&errdetails.ErrorInfo{
+			Reason:   inventoryv1.ErrorReason_ERROR_REASON_ATTACHMENT_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"part_uuid": partUUID, "uuid": attachmentUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: attachmentResourceType,
+			ResourceName: attachmentUUID,
+			Owner:        partUUID,
+			Description:  "attachment does not exist",
+		},
+	)
+}
+
+// internalError скрывает детали внутренней ошибки от клиента, оставляя их в логе.
+func internalError(err error) error {
+	log.Printf("internal error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		log.Printf("failed to attach error details: %v", err)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
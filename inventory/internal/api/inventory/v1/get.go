@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/converter"
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// GetPart return part by uuid
+func (a *api) GetPart(ctx context.Context, req *inventoryv1.GetPartRequest) (*inventoryv1.GetPartResponse, error) {
+	log.Println("Get request for get part by uuid")
+
+	part, err := a.partService.GetPart(ctx, req.GetUuid(), converter.UnitSystemFromProto(req.GetUnitSystem()), localesFromContext(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "uuid", "uuid must be a valid UUID")
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, partNotFoundError(req.GetUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &inventoryv1.GetPartResponse{Part: converter.PartToProto(part)}, nil
+}
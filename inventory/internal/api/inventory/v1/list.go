@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/converter"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// ListParts returns a list of parts, with optional filtering.
+func (a *api) ListParts(ctx context.Context, req *inventoryv1.ListPartsRequest) (*inventoryv1.ListPartsResponse, error) {
+	log.Println("Get request for get list parts by filters")
+
+	parts, err := a.partService.ListParts(
+		ctx,
+		converter.PartsFilterFromProto(req.GetFilter()),
+		converter.UnitSystemFromProto(req.GetUnitSystem()),
+		localesFromContext(ctx),
+	)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &inventoryv1.ListPartsResponse{Parts: converter.PartsToProto(parts)}, nil
+}
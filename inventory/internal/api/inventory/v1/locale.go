@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/metadata"
+)
+
+// Ключи метаданных с предпочитаемыми локалями. grpc-gateway пробрасывает
+// HTTP-заголовок Accept-Language с префиксом grpcgateway-.
+var localeMetadataKeys = []string{"accept-language", "grpcgateway-accept-language"}
+
+// localesFromContext возвращает локали из метаданных запроса в порядке убывания предпочтения.
+func localesFromContext(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	for _, key := range localeMetadataKeys {
+		values := md.Get(key)
+		if len(values) == 0 {
+			continue
+		}
+
+		var locales []string
+		for _, v := range values {
+			// Некорректный заголовок не должен ломать чтение: локаль просто не учитывается.
+			tags, _, err := language.ParseAcceptLanguage(v)
+			if err != nil {
+				continue
+			}
+			for _, tag := range tags {
+				locales = append(locales, tag.String())
+			}
+		}
+		return locales
+	}
+
+	return nil
+}
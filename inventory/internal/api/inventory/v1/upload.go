@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/converter"
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	partService "github.com/Denisz0785/spaceyard/inventory/internal/service/part"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// UploadAttachment принимает поток: первое сообщение с метаданными, затем чанки содержимого
+func (a *api) UploadAttachment(stream grpc.ClientStreamingServer[inventoryv1.UploadAttachmentRequest, inventoryv1.UploadAttachmentResponse]) error {
+	log.Println("Get request for upload attachment")
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "info", "first message must contain attachment info")
+		}
+		return err
+	}
+	if first.GetInfo() == nil {
+		return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "info", "first message must contain attachment info")
+	}
+
+	info := converter.UploadAttachmentInfoFromProto(first.GetInfo())
+	attachment, err := a.partService.UploadAttachment(stream.Context(), info, &chunkReader{stream: stream})
+	if err != nil {
+		return uploadAttachmentError(info.PartUUID, err)
+	}
+
+	return stream.SendAndClose(&inventoryv1.UploadAttachmentResponse{
+		Attachment: converter.AttachmentToProto(attachment),
+	})
+}
+
+func uploadAttachmentError(partUUID string, err error) error {
+	// Ошибки транспорта (отмена клиентом, дедлайн) возвращаем как есть.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, model.ErrInvalidUUID):
+		return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "info.part_uuid", "part_uuid must be a valid UUID")
+	case errors.Is(err, model.ErrInvalidAttachment):
+		return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT, "info", "file_name and kind are required")
+	case errors.Is(err, model.ErrPartNotFound):
+		return partNotFoundError(partUUID)
+	case errors.Is(err, model.ErrAttachmentTooLarge):
+		return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_ATTACHMENT_TOO_LARGE, "chunk",
+			"attachment must not exceed "+strconv.Itoa(partService.MaxAttachmentSize)+" bytes")
+	case errors.Is(err, model.ErrAttachmentChecksumMismatch):
+		return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_ATTACHMENT_CHECKSUM_MISMATCH, "info.sha256", "sha256 does not match uploaded content")
+	case errors.Is(err, model.ErrAttachmentContentTypeNotAllowed):
+		return invalidArgumentError(inventoryv1.ErrorReason_ERROR_REASON_ATTACHMENT_CONTENT_TYPE_NOT_ALLOWED, "info.kind", err.Error())
+	default:
+		return internalError(err)
+	}
+}
+
+// chunkReader превращает поток чанков в io.Reader для сервисного слоя
+type chunkReader struct {
+	stream grpc.ClientStreamingServer[inventoryv1.UploadAttachmentRequest, inventoryv1.UploadAttachmentResponse]
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetInfo() != nil {
+			return 0, status.Error(codes.InvalidArgument, "attachment info must be sent only once")
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
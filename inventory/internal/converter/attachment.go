@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// AttachmentToProto преобразует доменные метаданные вложения в protobuf-модель.
+func AttachmentToProto(attachment model.Attachment) *inventoryv1.Attachment {
+	return &inventoryv1.Attachment{
+		Uuid:        attachment.UUID,
+		FileName:    attachment.FileName,
+		Kind:        inventoryv1.AttachmentKind(attachment.Kind),
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Sha256:      attachment.SHA256,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+// AttachmentsToProto преобразует срез доменных вложений в срез protobuf-моделей.
+func AttachmentsToProto(attachments []model.Attachment) []*inventoryv1.Attachment {
+	result := make([]*inventoryv1.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, AttachmentToProto(attachment))
+	}
+	return result
+}
+
+// UploadAttachmentInfoFromProto преобразует первое сообщение потока загрузки в доменную модель.
+func UploadAttachmentInfoFromProto(info *inventoryv1.UploadAttachmentInfo) model.UploadAttachmentInfo {
+	return model.UploadAttachmentInfo{
+		PartUUID: info.GetPartUuid(),
+		FileName: info.GetFileName(),
+		Kind:     model.AttachmentKind(info.GetKind()),
+		SHA256:   info.GetSha256(),
+	}
+}
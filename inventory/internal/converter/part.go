@@ -0,0 +1,138 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// PartToProto преобразует доменную модель Part в protobuf-модель.
+func PartToProto(part model.Part) *inventoryv1.Part {
+	metadata := make(map[string]*inventoryv1.Value, len(part.Metadata))
+	for k, v := range part.Metadata {
+		metadata[k] = ValueToProto(v)
+	}
+
+	var createdAt, updatedAt *timestamppb.Timestamp
+	if !part.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(part.CreatedAt)
+	}
+	if !part.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(part.UpdatedAt)
+	}
+
+	result := &inventoryv1.Part{
+		Uuid:          part.UUID,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         money.ToProto(part.Price),
+		StockQuantity: part.StockQuantity,
+		Category:      inventoryv1.Category(part.Category),
+		Dimensions:    DimensionsToProto(part.Dimensions),
+		Manufacturer: &inventoryv1.Manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		},
+		Tags:         part.Tags,
+		Metadata:     metadata,
+		Attachments:  AttachmentsToProto(part.Attachments),
+		Locale:       part.Locale,
+		Translations: TranslationsToProto(part.Translations),
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+	}
+	// Устаревшее поле дублирует валюту цены для клиентов, собранных до перехода на Money.
+	result.CurrencyCode = part.Price.CurrencyCode //nolint:staticcheck // заполняется ради совместимости
+
+	return result
+}
+
+// PartsToProto преобразует срез доменных моделей Part в срез protobuf-моделей.
+func TranslationsToProto(translations map[string]model.Translation) map[string]*inventoryv1.PartTranslation {
+	result := make(map[string]*inventoryv1.PartTranslation, len(translations))
+	for locale, t := range translations {
+		result[locale] = &inventoryv1.PartTranslation{
+			Name:        t.Name,
+			Description: t.Description,
+		}
+	}
+	return result
+}
+
+func PartsToProto(parts []model.Part) []*inventoryv1.Part {
+	result := make([]*inventoryv1.Part, 0, len(parts))
+	for _, part := range parts {
+		result = append(result, PartToProto(part))
+	}
+	return result
+}
+
+// DimensionsToProto преобразует доменные размеры в protobuf-модель вместе с единицами измерения.
+func DimensionsToProto(d model.Dimensions) *inventoryv1.Dimensions {
+	return &inventoryv1.Dimensions{
+		Length:     d.Length,
+		Width:      d.Width,
+		Height:     d.Height,
+		Weight:     d.Weight,
+		LengthUnit: inventoryv1.LengthUnit(d.LengthUnit),
+		WeightUnit: inventoryv1.MassUnit(d.WeightUnit),
+		Volume:     d.Volume,
+		Density:    d.Density,
+	}
+}
+
+// UnitSystemFromProto преобразует protobuf-enum системы единиц в доменный.
+func UnitSystemFromProto(system inventoryv1.UnitSystem) model.UnitSystem {
+	return model.UnitSystem(system)
+}
+
+// PhysicalSummaryToProto преобразует суммарные физические характеристики в ответ API.
+func PhysicalSummaryToProto(summary model.PhysicalSummary) *inventoryv1.AggregatePhysicalPropertiesResponse {
+	return &inventoryv1.AggregatePhysicalPropertiesResponse{
+		TotalMass:   summary.TotalMass,
+		TotalVolume: summary.TotalVolume,
+		MassUnit:    inventoryv1.MassUnit(summary.MassUnit),
+		LengthUnit:  inventoryv1.LengthUnit(summary.LengthUnit),
+		PartsCount:  summary.PartsCount,
+	}
+}
+
+// ValueToProto преобразует доменное значение метаданных в protobuf-модель Value (oneof).
+func ValueToProto(v model.Value) *inventoryv1.Value {
+	switch v.Kind {
+	case model.ValueKindString:
+		return &inventoryv1.Value{Value: &inventoryv1.Value_StringValue{StringValue: v.String}}
+	case model.ValueKindInt64:
+		return &inventoryv1.Value{Value: &inventoryv1.Value_Int64Value{Int64Value: v.Int64}}
+	case model.ValueKindDouble:
+		return &inventoryv1.Value{Value: &inventoryv1.Value_DoubleValue{DoubleValue: v.Double}}
+	case model.ValueKindBool:
+		return &inventoryv1.Value{Value: &inventoryv1.Value_BoolValue{BoolValue: v.Bool}}
+	default:
+		return &inventoryv1.Value{}
+	}
+}
+
+// PartsFilterFromProto преобразует protobuf-фильтр в доменный. nil-фильтр означает отсутствие фильтрации.
+func PartsFilterFromProto(filter *inventoryv1.PartsFilter) model.PartsFilter {
+	if filter == nil {
+		return model.PartsFilter{}
+	}
+
+	categories := make([]model.Category, 0, len(filter.GetCategories()))
+	for _, category := range filter.GetCategories() {
+		categories = append(categories, model.Category(category))
+	}
+
+	return model.PartsFilter{
+		Uuids:                 filter.GetUuids(),
+		Names:                 filter.GetNames(),
+		Categories:            categories,
+		ManufacturerCountries: filter.GetManufacturerCountries(),
+		Tags:                  filter.GetTags(),
+		Search:                filter.GetSearch(),
+	}
+}
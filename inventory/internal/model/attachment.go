@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+type AttachmentKind int32
+
+const (
+	AttachmentKindUnspecified AttachmentKind = iota
+	AttachmentKindImage
+	AttachmentKindCAD
+	AttachmentKindCertificate
+)
+
+type Attachment struct {
+	UUID        string
+	FileName    string
+	Kind        AttachmentKind
+	ContentType string
+	Size        int64
+	SHA256      string
+	CreatedAt   time.Time
+}
+
+// UploadAttachmentInfo описывает загружаемое вложение до получения его содержимого.
+type UploadAttachmentInfo struct {
+	PartUUID string
+	FileName string
+	Kind     AttachmentKind
+	// SHA256 — ожидаемая контрольная сумма, пустая строка отключает проверку.
+	SHA256 string
+}
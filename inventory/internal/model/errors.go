@@ -0,0 +1,30 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPartNotFound = errors.New("part is not found")
+	ErrInvalidUUID  = errors.New("invalid part uuid")
+
+	ErrAttachmentNotFound              = errors.New("attachment is not found")
+	ErrInvalidAttachment               = errors.New("invalid attachment")
+	ErrAttachmentTooLarge              = errors.New("attachment is too large")
+	ErrAttachmentChecksumMismatch      = errors.New("attachment checksum mismatch")
+	ErrAttachmentContentTypeNotAllowed = errors.New("attachment content type is not allowed")
+)
+
+// PartNotFoundError уточняет ErrPartNotFound UUID отсутствующей детали.
+type PartNotFoundError struct {
+	UUID string
+}
+
+func (e *PartNotFoundError) Error() string {
+	return fmt.Sprintf("part %q is not found", e.UUID)
+}
+
+func (e *PartNotFoundError) Unwrap() error {
+	return ErrPartNotFound
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type PartsFilter struct {
+	Uuids                 []string
+	Names                 []string
+	Categories            []Category
+	ManufacturerCountries []string
+	Tags                  []string
+	// Search — подстрока для поиска по названиям и описаниям во всех локалях.
+	Search string
+}
+
+type Category int32
+
+const (
+	CategoryUnspecified Category = iota
+	CategoryEngine
+	CategoryFuel
+	CategoryPorthole
+	CategoryWing
+)
+
+type Dimensions struct {
+	Length     float64
+	Width      float64
+	Height     float64
+	Weight     float64
+	LengthUnit LengthUnit
+	WeightUnit MassUnit
+	// Volume и Density вычисляются сервисом при чтении и не хранятся.
+	Volume  float64
+	Density float64
+}
+
+type Manufacturer struct {
+	Name    string
+	Country string
+	Website string
+}
+
+// Value хранит одно из значений метаданных, заполненное поле определяет Kind.
+type Value struct {
+	Kind   ValueKind
+	String string
+	Int64  int64
+	Double float64
+	Bool   bool
+}
+
+type ValueKind int
+
+const (
+	ValueKindUnspecified ValueKind = iota
+	ValueKindString
+	ValueKindInt64
+	ValueKindDouble
+	ValueKindBool
+)
+
+type Part struct {
+	UUID          string
+	Name          string
+	Description   string
+	Price         money.Money
+	StockQuantity int64
+	Category      Category
+	Dimensions    Dimensions
+	Manufacturer  Manufacturer
+	Tags          []string
+	Metadata      map[string]Value
+	Attachments   []Attachment
+	// Locale — локаль, в которой возвращены Name и Description.
+	Locale string
+	// Translations хранит названия и описания по локалям (BCP 47).
+	Translations map[string]Translation
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Translation — название и описание детали в одной локали.
+type Translation struct {
+	Name        string
+	Description string
+}
@@ -0,0 +1,41 @@
+package model
+
+type LengthUnit int32
+
+const (
+	LengthUnitUnspecified LengthUnit = iota
+	LengthUnitMillimeter
+	LengthUnitCentimeter
+	LengthUnitMeter
+	LengthUnitInch
+	LengthUnitFoot
+)
+
+type MassUnit int32
+
+const (
+	MassUnitUnspecified MassUnit = iota
+	MassUnitGram
+	MassUnitKilogram
+	MassUnitTonne
+	MassUnitPound
+)
+
+// UnitSystem — система единиц, в которую переводятся физические величины при чтении.
+type UnitSystem int32
+
+const (
+	// UnitSystemUnspecified оставляет величины в единицах хранения.
+	UnitSystemUnspecified UnitSystem = iota
+	UnitSystemMetric
+	UnitSystemImperial
+)
+
+// PhysicalSummary — суммарные физические характеристики набора деталей.
+type PhysicalSummary struct {
+	TotalMass   float64
+	TotalVolume float64
+	MassUnit    MassUnit
+	LengthUnit  LengthUnit
+	PartsCount  int64
+}
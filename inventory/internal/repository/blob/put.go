@@ -0,0 +1,52 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Put пишет содержимое во временный файл и переименовывает его, чтобы читатели
+// никогда не видели частично записанный blob.
+func (s *localStore) Put(_ context.Context, key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temporary blob: %w", err)
+	}
+	defer func() {
+		// После успешного переименования файла уже нет, ошибка удаления не важна.
+		_ = os.Remove(tmp.Name())
+	}()
+
+	written, err := io.Copy(tmp, r)
+	if err != nil {
+		_ = tmp.Close()
+		return 0, fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return 0, fmt.Errorf("failed to sync blob: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close blob: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return 0, fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,34 @@
+package blob
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	def "github.com/Denisz0785/spaceyard/inventory/internal/repository"
+)
+
+var _ def.BlobStore = (*localStore)(nil)
+
+// localStore хранит содержимое вложений в файлах внутри каталога root.
+type localStore struct {
+	root string
+}
+
+func NewLocalStore(root string) (*localStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+
+	return &localStore{
+		root: root,
+	}, nil
+}
+
+// path переводит ключ в путь к файлу, не позволяя выйти за пределы root.
+func (s *localStore) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,138 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/inventory/internal/repository/model"
+)
+
+func PartToModel(part *repoModel.Part) model.Part {
+	metadata := make(map[string]model.Value, len(part.Metadata))
+	for k, v := range part.Metadata {
+		metadata[k] = model.Value{
+			Kind:   model.ValueKind(v.Kind),
+			String: v.String,
+			Int64:  v.Int64,
+			Double: v.Double,
+			Bool:   v.Bool,
+		}
+	}
+
+	return model.Part{
+		UUID:          part.UUID,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      model.Category(part.Category),
+		Dimensions: model.Dimensions{
+			Length:     part.Dimensions.Length,
+			Width:      part.Dimensions.Width,
+			Height:     part.Dimensions.Height,
+			Weight:     part.Dimensions.Weight,
+			LengthUnit: model.LengthUnit(part.Dimensions.LengthUnit),
+			WeightUnit: model.MassUnit(part.Dimensions.WeightUnit),
+		},
+		Manufacturer: model.Manufacturer(part.Manufacturer),
+		Tags:         slices.Clone(part.Tags),
+		Metadata:     metadata,
+		Attachments:  AttachmentsToModel(part.Attachments),
+		Translations: TranslationsToModel(part.Translations),
+		CreatedAt:    part.CreatedAt,
+		UpdatedAt:    part.UpdatedAt,
+	}
+}
+
+func PartToRepoModel(part model.Part) *repoModel.Part {
+	metadata := make(map[string]repoModel.Value, len(part.Metadata))
+	for k, v := range part.Metadata {
+		metadata[k] = repoModel.Value{
+			Kind:   repoModel.ValueKind(v.Kind),
+			String: v.String,
+			Int64:  v.Int64,
+			Double: v.Double,
+			Bool:   v.Bool,
+		}
+	}
+
+	return &repoModel.Part{
+		UUID:          part.UUID,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      repoModel.Category(part.Category),
+		Dimensions: repoModel.Dimensions{
+			Length:     part.Dimensions.Length,
+			Width:      part.Dimensions.Width,
+			Height:     part.Dimensions.Height,
+			Weight:     part.Dimensions.Weight,
+			LengthUnit: repoModel.LengthUnit(part.Dimensions.LengthUnit),
+			WeightUnit: repoModel.MassUnit(part.Dimensions.WeightUnit),
+		},
+		Manufacturer: repoModel.Manufacturer(part.Manufacturer),
+		Tags:         slices.Clone(part.Tags),
+		Metadata:     metadata,
+		Attachments:  AttachmentsToRepoModel(part.Attachments),
+		Translations: TranslationsToRepoModel(part.Translations),
+		CreatedAt:    part.CreatedAt,
+		UpdatedAt:    part.UpdatedAt,
+	}
+}
+
+func AttachmentToModel(attachment repoModel.Attachment) model.Attachment {
+	return model.Attachment{
+		UUID:        attachment.UUID,
+		FileName:    attachment.FileName,
+		Kind:        model.AttachmentKind(attachment.Kind),
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func AttachmentToRepoModel(attachment model.Attachment) repoModel.Attachment {
+	return repoModel.Attachment{
+		UUID:        attachment.UUID,
+		FileName:    attachment.FileName,
+		Kind:        repoModel.AttachmentKind(attachment.Kind),
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func AttachmentsToModel(attachments []repoModel.Attachment) []model.Attachment {
+	result := make([]model.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, AttachmentToModel(attachment))
+	}
+	return result
+}
+
+func AttachmentsToRepoModel(attachments []model.Attachment) []repoModel.Attachment {
+	result := make([]repoModel.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, AttachmentToRepoModel(attachment))
+	}
+	return result
+}
+
+func TranslationsToModel(translations map[string]repoModel.Translation) map[string]model.Translation {
+	result := make(map[string]model.Translation, len(translations))
+	for locale, t := range translations {
+		result[locale] = model.Translation(t)
+	}
+	return result
+}
+
+func TranslationsToRepoModel(translations map[string]model.Translation) map[string]repoModel.Translation {
+	result := make(map[string]repoModel.Translation, len(translations))
+	for locale, t := range translations {
+		result[locale] = repoModel.Translation(t)
+	}
+	return result
+}
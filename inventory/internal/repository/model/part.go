@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type Category int32
+
+type LengthUnit int32
+
+type MassUnit int32
+
+type AttachmentKind int32
+
+type Dimensions struct {
+	Length     float64
+	Width      float64
+	Height     float64
+	Weight     float64
+	LengthUnit LengthUnit
+	WeightUnit MassUnit
+}
+
+type Manufacturer struct {
+	Name    string
+	Country string
+	Website string
+}
+
+type ValueKind int
+
+type Value struct {
+	Kind   ValueKind
+	String string
+	Int64  int64
+	Double float64
+	Bool   bool
+}
+
+type Part struct {
+	UUID          string
+	Name          string
+	Description   string
+	Price         money.Money
+	StockQuantity int64
+	Category      Category
+	Dimensions    Dimensions
+	Manufacturer  Manufacturer
+	Tags          []string
+	Metadata      map[string]Value
+	Attachments   []Attachment
+	// Name и Description хранятся в локали по умолчанию, остальные локали — в Translations.
+	Translations map[string]Translation
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type Translation struct {
+	Name        string
+	Description string
+}
+
+type Attachment struct {
+	UUID        string
+	FileName    string
+	Kind        AttachmentKind
+	ContentType string
+	Size        int64
+	SHA256      string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,24 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	"github.com/Denisz0785/spaceyard/inventory/internal/repository/converter"
+)
+
+func (r *repository) AddAttachment(_ context.Context, partUUID string, attachment model.Attachment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	part, ok := r.parts[partUUID]
+	if !ok {
+		return model.ErrPartNotFound
+	}
+
+	part.Attachments = append(part.Attachments, converter.AttachmentToRepoModel(attachment))
+	part.UpdatedAt = time.Now()
+
+	return nil
+}
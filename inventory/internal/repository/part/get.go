@@ -0,0 +1,20 @@
+package part
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	"github.com/Denisz0785/spaceyard/inventory/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.Part, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	part, ok := r.parts[uuid]
+	if !ok {
+		return model.Part{}, model.ErrPartNotFound
+	}
+
+	return converter.PartToModel(part), nil
+}
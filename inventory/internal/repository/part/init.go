@@ -0,0 +1,59 @@
+package part
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	"github.com/Denisz0785/spaceyard/inventory/internal/repository/converter"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// init заполняет хранилище тестовыми деталями
+func (r *repository) init() {
+	now := time.Now()
+
+	parts := []model.Part{
+		{
+			UUID:        "37566f5a-cbb2-49e9-af41-4bc0e49f311a",
+			Name:        "star",
+			Description: "Star-shaped hull segment",
+			Price:       money.Money{CurrencyCode: "RUB", Units: 450},
+			Dimensions: model.Dimensions{
+				Length:     120,
+				Width:      80,
+				Height:     40,
+				Weight:     95,
+				LengthUnit: model.LengthUnitCentimeter,
+				WeightUnit: model.MassUnitKilogram,
+			},
+			Translations: map[string]model.Translation{
+				"ru": {Name: "звезда", Description: "Сегмент корпуса в форме звезды"},
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		{
+			UUID:        "8b9d2f4e-3c1a-4e5b-9f6d-7a2c1e0b4d38",
+			Name:        "beacon",
+			Description: "Imported navigation beacon",
+			Price:       money.Money{CurrencyCode: "USD", Units: 120, Nanos: 750_000_000},
+			Dimensions: model.Dimensions{
+				Length:     30,
+				Width:      30,
+				Height:     45,
+				Weight:     4.2,
+				LengthUnit: model.LengthUnitCentimeter,
+				WeightUnit: model.MassUnitKilogram,
+			},
+			Translations: map[string]model.Translation{
+				"ru": {Name: "маяк", Description: "Импортный навигационный маяк"},
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	for _, part := range parts {
+		r.parts[part.UUID] = converter.PartToRepoModel(part)
+	}
+}
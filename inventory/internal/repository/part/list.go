@@ -0,0 +1,126 @@
+package part
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	"github.com/Denisz0785/spaceyard/inventory/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/inventory/internal/repository/model"
+)
+
+type partFilter func(*repoModel.Part) bool
+
+// List returns a list of parts, with optional filtering.
+func (r *repository) List(_ context.Context, filter model.PartsFilter) ([]model.Part, error) {
+	filters := buildPartFilters(filter)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.Part, 0, len(r.parts))
+	for _, part := range r.parts {
+		matchesAll := true
+		for _, f := range filters {
+			if !f(part) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			result = append(result, converter.PartToModel(part))
+		}
+	}
+
+	return result, nil
+}
+
+func buildPartFilters(filter model.PartsFilter) []partFilter {
+	var filters []partFilter
+
+	if len(filter.Uuids) > 0 {
+		uuidSet := make(map[string]struct{})
+		for _, uuid := range filter.Uuids {
+			uuidSet[uuid] = struct{}{}
+		}
+		filters = append(filters, func(part *repoModel.Part) bool {
+			_, ok := uuidSet[part.UUID]
+			return ok
+		})
+	}
+
+	if len(filter.Names) > 0 {
+		nameSet := make(map[string]struct{})
+		for _, name := range filter.Names {
+			nameSet[name] = struct{}{}
+		}
+		filters = append(filters, func(part *repoModel.Part) bool {
+			if _, ok := nameSet[part.Name]; ok {
+				return true
+			}
+			for _, t := range part.Translations {
+				if _, ok := nameSet[t.Name]; ok {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	if len(filter.Categories) > 0 {
+		categorySet := make(map[repoModel.Category]struct{})
+		for _, category := range filter.Categories {
+			categorySet[repoModel.Category(category)] = struct{}{}
+		}
+		filters = append(filters, func(part *repoModel.Part) bool {
+			_, ok := categorySet[part.Category]
+			return ok
+		})
+	}
+
+	if len(filter.ManufacturerCountries) > 0 {
+		countrySet := make(map[string]struct{})
+		for _, country := range filter.ManufacturerCountries {
+			countrySet[country] = struct{}{}
+		}
+		filters = append(filters, func(part *repoModel.Part) bool {
+			_, ok := countrySet[part.Manufacturer.Country]
+			return ok
+		})
+	}
+
+	if len(filter.Tags) > 0 {
+		tagSet := make(map[string]struct{})
+		for _, tag := range filter.Tags {
+			tagSet[tag] = struct{}{}
+		}
+		filters = append(filters, func(part *repoModel.Part) bool {
+			for _, partTag := range part.Tags {
+				if _, ok := tagSet[partTag]; ok {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	if search := strings.ToLower(strings.TrimSpace(filter.Search)); search != "" {
+		matches := func(name, description string) bool {
+			return strings.Contains(strings.ToLower(name), search) ||
+				strings.Contains(strings.ToLower(description), search)
+		}
+		filters = append(filters, func(part *repoModel.Part) bool {
+			if matches(part.Name, part.Description) {
+				return true
+			}
+			for _, t := range part.Translations {
+				if matches(t.Name, t.Description) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	return filters
+}
@@ -0,0 +1,25 @@
+package part
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/inventory/internal/repository"
+	repoModel "github.com/Denisz0785/spaceyard/inventory/internal/repository/model"
+)
+
+var _ def.PartRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное in-memory хранилище деталей
+type repository struct {
+	mu    sync.RWMutex
+	parts map[string]*repoModel.Part
+}
+
+func NewRepository() *repository {
+	r := &repository{
+		parts: make(map[string]*repoModel.Part),
+	}
+	r.init()
+
+	return r
+}
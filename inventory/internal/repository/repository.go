@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"io"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+type PartRepository interface {
+	Get(ctx context.Context, uuid string) (model.Part, error)
+	List(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
+	AddAttachment(ctx context.Context, partUUID string, attachment model.Attachment) error
+}
+
+// BlobStore хранит содержимое вложений деталей по ключу.
+type BlobStore interface {
+	// Put сохраняет содержимое r и возвращает количество записанных байт.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,54 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+func (s *service) AggregatePhysicalProperties(ctx context.Context, partUUIDs []string, system model.UnitSystem) (model.PhysicalSummary, error) {
+	for _, partUUID := range partUUIDs {
+		if err := uuid.Validate(partUUID); err != nil {
+			return model.PhysicalSummary{}, model.ErrInvalidUUID
+		}
+	}
+
+	// Суммировать величины в разных системах нельзя, поэтому по умолчанию считаем в метрической.
+	if system == model.UnitSystemUnspecified {
+		system = model.UnitSystemMetric
+	}
+	lengthUnit, massUnit := targetUnits(model.Dimensions{}, system)
+
+	summary := model.PhysicalSummary{
+		MassUnit:   massUnit,
+		LengthUnit: lengthUnit,
+	}
+	if len(partUUIDs) == 0 {
+		return summary, nil
+	}
+
+	parts, err := s.repo.List(ctx, model.PartsFilter{Uuids: partUUIDs})
+	if err != nil {
+		return model.PhysicalSummary{}, err
+	}
+
+	byUUID := make(map[string]model.Dimensions, len(parts))
+	for _, part := range parts {
+		byUUID[part.UUID] = convertDimensions(part.Dimensions, system)
+	}
+
+	// Деталь, указанная несколько раз, учитывается столько же раз.
+	for _, partUUID := range partUUIDs {
+		dims, ok := byUUID[partUUID]
+		if !ok {
+			return model.PhysicalSummary{}, &model.PartNotFoundError{UUID: partUUID}
+		}
+		summary.TotalMass += dims.Weight
+		summary.TotalVolume += dims.Volume
+		summary.PartsCount++
+	}
+
+	return summary, nil
+}
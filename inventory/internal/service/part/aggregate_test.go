@@ -0,0 +1,83 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	partRepository "github.com/Denisz0785/spaceyard/inventory/internal/repository/part"
+)
+
+const (
+	starUUID   = "37566f5a-cbb2-49e9-af41-4bc0e49f311a"
+	beaconUUID = "8b9d2f4e-3c1a-4e5b-9f6d-7a2c1e0b4d38"
+)
+
+func TestAggregatePhysicalProperties(t *testing.T) {
+	tests := []struct {
+		name      string
+		partUUIDs []string
+		system    model.UnitSystem
+		want      model.PhysicalSummary
+		wantErr   error
+	}{
+		{
+			name:      "metric by default",
+			partUUIDs: []string{starUUID, beaconUUID},
+			want: model.PhysicalSummary{
+				TotalMass: 99.2, TotalVolume: 0.4245, PartsCount: 2,
+				MassUnit: model.MassUnitKilogram, LengthUnit: model.LengthUnitMeter,
+			},
+		},
+		{
+			name:      "repeated part counted every time",
+			partUUIDs: []string{starUUID, beaconUUID, beaconUUID},
+			system:    model.UnitSystemMetric,
+			want: model.PhysicalSummary{
+				TotalMass: 103.4, TotalVolume: 0.465, PartsCount: 3,
+				MassUnit: model.MassUnitKilogram, LengthUnit: model.LengthUnitMeter,
+			},
+		},
+		{
+			name:      "imperial",
+			partUUIDs: []string{starUUID},
+			system:    model.UnitSystemImperial,
+			want: model.PhysicalSummary{
+				TotalMass: 95 / 0.45359237, TotalVolume: 0.384 / (0.3048 * 0.3048 * 0.3048), PartsCount: 1,
+				MassUnit: model.MassUnitPound, LengthUnit: model.LengthUnitFoot,
+			},
+		},
+		{
+			name: "no parts",
+			want: model.PhysicalSummary{MassUnit: model.MassUnitKilogram, LengthUnit: model.LengthUnitMeter},
+		},
+		{
+			name:      "unknown part",
+			partUUIDs: []string{starUUID, "0f8fad5b-d9cb-469f-a165-70867728950e"},
+			wantErr:   model.ErrPartNotFound,
+		},
+		{
+			name:      "invalid uuid",
+			partUUIDs: []string{"star"},
+			wantErr:   model.ErrInvalidUUID,
+		},
+	}
+
+	s := NewService(partRepository.NewRepository(), nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.AggregatePhysicalProperties(context.Background(), tt.partUUIDs, tt.system)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AggregatePhysicalProperties() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.PartsCount != tt.want.PartsCount || got.MassUnit != tt.want.MassUnit || got.LengthUnit != tt.want.LengthUnit ||
+				!approxEqual(got.TotalMass, tt.want.TotalMass) || !approxEqual(got.TotalVolume, tt.want.TotalVolume) {
+				t.Fatalf("AggregatePhysicalProperties() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
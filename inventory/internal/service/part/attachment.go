@@ -0,0 +1,132 @@
+package part
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+const (
+	// MaxAttachmentSize ограничивает размер одного вложения.
+	MaxAttachmentSize = 20 << 20
+	// sniffLen — количество байт, по которым http.DetectContentType определяет тип.
+	sniffLen = 512
+)
+
+// allowedContentTypes задаёт допустимые типы содержимого по виду вложения.
+// Для видов, которых нет в списке, тип не ограничивается.
+var allowedContentTypes = map[model.AttachmentKind][]string{
+	model.AttachmentKindImage:       {"image/png", "image/jpeg", "image/gif", "image/webp"},
+	model.AttachmentKindCertificate: {"application/pdf"},
+}
+
+func (s *service) UploadAttachment(ctx context.Context, info model.UploadAttachmentInfo, content io.Reader) (model.Attachment, error) {
+	if err := uuid.Validate(info.PartUUID); err != nil {
+		return model.Attachment{}, model.ErrInvalidUUID
+	}
+	if strings.TrimSpace(info.FileName) == "" || info.Kind == model.AttachmentKindUnspecified {
+		return model.Attachment{}, model.ErrInvalidAttachment
+	}
+
+	if _, err := s.repo.Get(ctx, info.PartUUID); err != nil {
+		return model.Attachment{}, err
+	}
+
+	// Тип определяем по содержимому, а не доверяем имени файла.
+	br := bufio.NewReaderSize(content, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return model.Attachment{}, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	contentType := http.DetectContentType(head)
+	if !isContentTypeAllowed(info.Kind, contentType) {
+		return model.Attachment{}, fmt.Errorf("%w: %s", model.ErrAttachmentContentTypeNotAllowed, contentType)
+	}
+
+	attachment := model.Attachment{
+		UUID:        uuid.NewString(),
+		FileName:    path.Base(info.FileName),
+		Kind:        info.Kind,
+		ContentType: contentType,
+		CreatedAt:   time.Now(),
+	}
+	key := attachmentKey(info.PartUUID, attachment.UUID)
+
+	// Читаем на байт больше лимита, чтобы отличить файл ровно в лимит от превышения.
+	hash := sha256.New()
+	limited := &io.LimitedReader{R: io.TeeReader(br, hash), N: MaxAttachmentSize + 1}
+
+	attachment.Size, err = s.blobStore.Put(ctx, key, limited)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	attachment.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	switch {
+	case attachment.Size > MaxAttachmentSize:
+		err = model.ErrAttachmentTooLarge
+	case info.SHA256 != "" && !strings.EqualFold(info.SHA256, attachment.SHA256):
+		err = model.ErrAttachmentChecksumMismatch
+	default:
+		err = s.repo.AddAttachment(ctx, info.PartUUID, attachment)
+	}
+	if err != nil {
+		if delErr := s.blobStore.Delete(ctx, key); delErr != nil {
+			log.Printf("failed to delete rejected attachment %s: %v", key, delErr)
+		}
+		return model.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+func (s *service) DownloadAttachment(ctx context.Context, partUUID, attachmentUUID string) (model.Attachment, io.ReadCloser, error) {
+	if err := uuid.Validate(partUUID); err != nil {
+		return model.Attachment{}, nil, model.ErrInvalidUUID
+	}
+
+	part, err := s.repo.Get(ctx, partUUID)
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	idx := slices.IndexFunc(part.Attachments, func(a model.Attachment) bool {
+		return a.UUID == attachmentUUID
+	})
+	if idx < 0 {
+		return model.Attachment{}, nil, model.ErrAttachmentNotFound
+	}
+
+	content, err := s.blobStore.Get(ctx, attachmentKey(partUUID, attachmentUUID))
+	if err != nil {
+		return model.Attachment{}, nil, err
+	}
+
+	return part.Attachments[idx], content, nil
+}
+
+func attachmentKey(partUUID, attachmentUUID string) string {
+	return path.Join(partUUID, attachmentUUID)
+}
+
+func isContentTypeAllowed(kind model.AttachmentKind, contentType string) bool {
+	allowed, ok := allowedContentTypes[kind]
+	if !ok {
+		return true
+	}
+	return slices.Contains(allowed, contentType)
+}
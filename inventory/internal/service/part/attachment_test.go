@@ -0,0 +1,152 @@
+package part
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+	"github.com/Denisz0785/spaceyard/inventory/internal/repository/blob"
+	partRepository "github.com/Denisz0785/spaceyard/inventory/internal/repository/part"
+)
+
+// pngHeader — начало PNG-файла, по которому http.DetectContentType узнаёт image/png.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newAttachmentService(t *testing.T) (*service, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	store, err := blob.NewLocalStore(root)
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	return NewService(partRepository.NewRepository(), store), root
+}
+
+// storedFiles возвращает число файлов в хранилище вложений.
+func storedFiles(t *testing.T, root string) int {
+	t.Helper()
+
+	count := 0
+	err := filepath.WalkDir(root, func(_ string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			count++
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed to walk blob store: %v", err)
+	}
+	return count
+}
+
+func TestUploadAttachment(t *testing.T) {
+	png := append(bytes.Clone(pngHeader), bytes.Repeat([]byte{0}, 100)...)
+	sum := sha256.Sum256(png)
+
+	tests := []struct {
+		name    string
+		info    model.UploadAttachmentInfo
+		content io.Reader
+		wantErr error
+	}{
+		{
+			name:    "image with checksum",
+			info:    model.UploadAttachmentInfo{PartUUID: starUUID, FileName: "../photos/star.png", Kind: model.AttachmentKindImage, SHA256: strings.ToUpper(hex.EncodeToString(sum[:]))},
+			content: bytes.NewReader(png),
+		},
+		{
+			name:    "content does not match kind",
+			info:    model.UploadAttachmentInfo{PartUUID: starUUID, FileName: "star.png", Kind: model.AttachmentKindImage},
+			content: strings.NewReader("plain text pretending to be an image"),
+			wantErr: model.ErrAttachmentContentTypeNotAllowed,
+		},
+		{
+			name:    "checksum mismatch",
+			info:    model.UploadAttachmentInfo{PartUUID: starUUID, FileName: "star.png", Kind: model.AttachmentKindImage, SHA256: strings.Repeat("0", 64)},
+			content: bytes.NewReader(png),
+			wantErr: model.ErrAttachmentChecksumMismatch,
+		},
+		{
+			name:    "too large",
+			info:    model.UploadAttachmentInfo{PartUUID: starUUID, FileName: "star.step", Kind: model.AttachmentKindCAD},
+			content: io.LimitReader(zeroReader{}, MaxAttachmentSize+1),
+			wantErr: model.ErrAttachmentTooLarge,
+		},
+		{
+			name:    "unknown part",
+			info:    model.UploadAttachmentInfo{PartUUID: "0f8fad5b-d9cb-469f-a165-70867728950e", FileName: "star.png", Kind: model.AttachmentKindImage},
+			content: bytes.NewReader(png),
+			wantErr: model.ErrPartNotFound,
+		},
+		{
+			name:    "no file name",
+			info:    model.UploadAttachmentInfo{PartUUID: starUUID, FileName: " ", Kind: model.AttachmentKindImage},
+			content: bytes.NewReader(png),
+			wantErr: model.ErrInvalidAttachment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, root := newAttachmentService(t)
+
+			attachment, err := s.UploadAttachment(ctx, tt.info, tt.content)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UploadAttachment() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				// Отклонённое вложение не остаётся в хранилище.
+				if n := storedFiles(t, root); n != 0 {
+					t.Fatalf("blob store has %d files after a rejected upload", n)
+				}
+				return
+			}
+
+			if attachment.FileName != "star.png" || attachment.ContentType != "image/png" || attachment.Size != int64(len(png)) {
+				t.Fatalf("attachment = %+v", attachment)
+			}
+
+			got, content, err := s.DownloadAttachment(ctx, starUUID, attachment.UUID)
+			if err != nil {
+				t.Fatalf("DownloadAttachment() error = %v", err)
+			}
+			defer func() {
+				_ = content.Close()
+			}()
+			data, err := io.ReadAll(content)
+			if err != nil {
+				t.Fatalf("failed to read attachment: %v", err)
+			}
+			if got.UUID != attachment.UUID || !bytes.Equal(data, png) {
+				t.Fatalf("downloaded %s with %d bytes, want %s with %d bytes", got.UUID, len(data), attachment.UUID, len(png))
+			}
+		})
+	}
+}
+
+func TestDownloadAttachmentNotFound(t *testing.T) {
+	s, _ := newAttachmentService(t)
+
+	_, _, err := s.DownloadAttachment(context.Background(), starUUID, "0f8fad5b-d9cb-469f-a165-70867728950e")
+	if !errors.Is(err, model.ErrAttachmentNotFound) {
+		t.Fatalf("DownloadAttachment() error = %v, want %v", err, model.ErrAttachmentNotFound)
+	}
+}
+
+// zeroReader бесконечно отдаёт нулевые байты.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
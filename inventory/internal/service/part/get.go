@@ -0,0 +1,25 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+func (s *service) GetPart(ctx context.Context, partUUID string, system model.UnitSystem, locales []string) (model.Part, error) {
+	if err := uuid.Validate(partUUID); err != nil {
+		return model.Part{}, model.ErrInvalidUUID
+	}
+
+	part, err := s.repo.Get(ctx, partUUID)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	part.Dimensions = convertDimensions(part.Dimensions, system)
+	part = localize(part, locales)
+
+	return part, nil
+}
@@ -0,0 +1,21 @@
+package part
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+func (s *service) ListParts(ctx context.Context, filter model.PartsFilter, system model.UnitSystem, locales []string) ([]model.Part, error) {
+	parts, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range parts {
+		parts[i].Dimensions = convertDimensions(parts[i].Dimensions, system)
+		parts[i] = localize(parts[i], locales)
+	}
+
+	return parts, nil
+}
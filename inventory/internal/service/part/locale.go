@@ -0,0 +1,76 @@
+package part
+
+import (
+	"maps"
+	"slices"
+
+	"golang.org/x/text/language"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+// DefaultLocale — локаль, в которой хранятся основные название и описание детали.
+// Используется, когда ни одна из запрошенных локалей недоступна.
+const DefaultLocale = "en"
+
+// localize подставляет название и описание в наиболее подходящей из запрошенных локалей.
+// locales упорядочены по убыванию предпочтения. Пустые поля перевода берутся из локали по умолчанию.
+func localize(part model.Part, locales []string) model.Part {
+	translations := make(map[string]model.Translation, len(part.Translations)+1)
+	maps.Copy(translations, part.Translations)
+	translations[DefaultLocale] = model.Translation{Name: part.Name, Description: part.Description}
+
+	part.Translations = translations
+	part.Locale = DefaultLocale
+
+	locale := matchLocale(translations, locales)
+	if locale == DefaultLocale {
+		return part
+	}
+
+	t := translations[locale]
+	if t.Name != "" {
+		part.Name = t.Name
+	}
+	if t.Description != "" {
+		part.Description = t.Description
+	}
+	part.Locale = locale
+
+	return part
+}
+
+// matchLocale выбирает из доступных переводов локаль, лучше всего подходящую к запрошенным.
+func matchLocale(translations map[string]model.Translation, locales []string) string {
+	desired := make([]language.Tag, 0, len(locales))
+	for _, l := range locales {
+		tag, err := language.Parse(l)
+		if err != nil {
+			continue
+		}
+		desired = append(desired, tag)
+	}
+	if len(desired) == 0 {
+		return DefaultLocale
+	}
+
+	// Первой идёт локаль по умолчанию: matcher возвращает её, если совпадений нет.
+	available := []string{DefaultLocale}
+	for _, l := range slices.Sorted(maps.Keys(translations)) {
+		if l != DefaultLocale {
+			available = append(available, l)
+		}
+	}
+
+	supported := make([]language.Tag, 0, len(available))
+	for _, l := range available {
+		supported = append(supported, language.Make(l))
+	}
+
+	_, idx, confidence := language.NewMatcher(supported).Match(desired...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+
+	return available[idx]
+}
@@ -0,0 +1,90 @@
+package part
+
+import (
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+func TestLocalize(t *testing.T) {
+	part := model.Part{
+		Name:        "Star Engine",
+		Description: "Main propulsion unit",
+		Translations: map[string]model.Translation{
+			"ru":    {Name: "Звёздный двигатель", Description: "Маршевый двигатель"},
+			"de":    {Name: "Sternantrieb"},
+			"pt-BR": {Name: "Motor estelar", Description: "Unidade de propulsão"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		locales         []string
+		wantLocale      string
+		wantName        string
+		wantDescription string
+	}{
+		{
+			name:            "no locales",
+			wantLocale:      DefaultLocale,
+			wantName:        "Star Engine",
+			wantDescription: "Main propulsion unit",
+		},
+		{
+			name:            "exact match",
+			locales:         []string{"ru"},
+			wantLocale:      "ru",
+			wantName:        "Звёздный двигатель",
+			wantDescription: "Маршевый двигатель",
+		},
+		{
+			name:            "region falls back to language",
+			locales:         []string{"ru-RU"},
+			wantLocale:      "ru",
+			wantName:        "Звёздный двигатель",
+			wantDescription: "Маршевый двигатель",
+		},
+		{
+			name:            "first preferred wins",
+			locales:         []string{"de", "ru"},
+			wantLocale:      "de",
+			wantName:        "Sternantrieb",
+			wantDescription: "Main propulsion unit",
+		},
+		{
+			name:            "unknown locale is skipped",
+			locales:         []string{"not a locale", "pt-BR"},
+			wantLocale:      "pt-BR",
+			wantName:        "Motor estelar",
+			wantDescription: "Unidade de propulsão",
+		},
+		{
+			name:            "no translation",
+			locales:         []string{"ja"},
+			wantLocale:      DefaultLocale,
+			wantName:        "Star Engine",
+			wantDescription: "Main propulsion unit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := localize(part, tt.locales)
+			if got.Locale != tt.wantLocale {
+				t.Fatalf("localize() locale = %q, want %q", got.Locale, tt.wantLocale)
+			}
+			if got.Name != tt.wantName || got.Description != tt.wantDescription {
+				t.Fatalf("localize() = %q / %q, want %q / %q", got.Name, got.Description, tt.wantName, tt.wantDescription)
+			}
+			// Основные название и описание доступны среди переводов под локалью по умолчанию.
+			if def := got.Translations[DefaultLocale]; def.Name != part.Name || def.Description != part.Description {
+				t.Fatalf("default translation = %+v", def)
+			}
+		})
+	}
+
+	// Исходная деталь не меняется.
+	if _, ok := part.Translations[DefaultLocale]; ok {
+		t.Fatal("localize() modified the source translations")
+	}
+}
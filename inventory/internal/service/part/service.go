@@ -0,0 +1,20 @@
+package part
+
+import (
+	"github.com/Denisz0785/spaceyard/inventory/internal/repository"
+	def "github.com/Denisz0785/spaceyard/inventory/internal/service"
+)
+
+var _ def.PartService = (*service)(nil)
+
+type service struct {
+	repo      repository.PartRepository
+	blobStore repository.BlobStore
+}
+
+func NewService(repo repository.PartRepository, blobStore repository.BlobStore) *service {
+	return &service{
+		repo:      repo,
+		blobStore: blobStore,
+	}
+}
@@ -0,0 +1,68 @@
+package part
+
+import "github.com/Denisz0785/spaceyard/inventory/internal/model"
+
+// Коэффициенты перевода в базовые единицы СИ (метры и килограммы).
+var (
+	metersPerUnit = map[model.LengthUnit]float64{
+		model.LengthUnitMillimeter: 0.001,
+		model.LengthUnitCentimeter: 0.01,
+		model.LengthUnitMeter:      1,
+		model.LengthUnitInch:       0.0254,
+		model.LengthUnitFoot:       0.3048,
+	}
+	kilogramsPerUnit = map[model.MassUnit]float64{
+		model.MassUnitGram:     0.001,
+		model.MassUnitKilogram: 1,
+		model.MassUnitTonne:    1000,
+		model.MassUnitPound:    0.45359237,
+	}
+)
+
+// storedUnits возвращает единицы хранения, подставляя метры и килограммы для неуказанных.
+func storedUnits(d model.Dimensions) (model.LengthUnit, model.MassUnit) {
+	lengthUnit, massUnit := d.LengthUnit, d.WeightUnit
+	if _, ok := metersPerUnit[lengthUnit]; !ok {
+		lengthUnit = model.LengthUnitMeter
+	}
+	if _, ok := kilogramsPerUnit[massUnit]; !ok {
+		massUnit = model.MassUnitKilogram
+	}
+	return lengthUnit, massUnit
+}
+
+// targetUnits возвращает единицы, в которые нужно перевести размеры для системы system.
+func targetUnits(d model.Dimensions, system model.UnitSystem) (model.LengthUnit, model.MassUnit) {
+	switch system {
+	case model.UnitSystemMetric:
+		return model.LengthUnitMeter, model.MassUnitKilogram
+	case model.UnitSystemImperial:
+		return model.LengthUnitFoot, model.MassUnitPound
+	default:
+		return storedUnits(d)
+	}
+}
+
+// convertDimensions переводит размеры в систему единиц system и вычисляет объём и плотность.
+func convertDimensions(d model.Dimensions, system model.UnitSystem) model.Dimensions {
+	fromLength, fromMass := storedUnits(d)
+	toLength, toMass := targetUnits(d, system)
+
+	lengthFactor := metersPerUnit[fromLength] / metersPerUnit[toLength]
+	massFactor := kilogramsPerUnit[fromMass] / kilogramsPerUnit[toMass]
+
+	result := model.Dimensions{
+		Length:     d.Length * lengthFactor,
+		Width:      d.Width * lengthFactor,
+		Height:     d.Height * lengthFactor,
+		Weight:     d.Weight * massFactor,
+		LengthUnit: toLength,
+		WeightUnit: toMass,
+	}
+	result.Volume = result.Length * result.Width * result.Height
+	if result.Volume > 0 {
+		result.Density = result.Weight / result.Volume
+	}
+
+	return result
+}
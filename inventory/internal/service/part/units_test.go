@@ -0,0 +1,105 @@
+package part
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+// approxEqual сравнивает величины с относительной погрешностью, накопленной при переводе единиц.
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+func TestConvertDimensions(t *testing.T) {
+	tests := []struct {
+		name   string
+		dims   model.Dimensions
+		system model.UnitSystem
+		want   model.Dimensions
+	}{
+		{
+			name: "centimeters to metric",
+			dims: model.Dimensions{
+				Length: 120, Width: 80, Height: 40, Weight: 95,
+				LengthUnit: model.LengthUnitCentimeter, WeightUnit: model.MassUnitKilogram,
+			},
+			system: model.UnitSystemMetric,
+			want: model.Dimensions{
+				Length: 1.2, Width: 0.8, Height: 0.4, Weight: 95,
+				LengthUnit: model.LengthUnitMeter, WeightUnit: model.MassUnitKilogram,
+				Volume: 0.384, Density: 95 / 0.384,
+			},
+		},
+		{
+			name: "metric to imperial",
+			dims: model.Dimensions{
+				Length: 0.3048, Width: 0.6096, Height: 3.048, Weight: 0.45359237,
+				LengthUnit: model.LengthUnitMeter, WeightUnit: model.MassUnitKilogram,
+			},
+			system: model.UnitSystemImperial,
+			want: model.Dimensions{
+				Length: 1, Width: 2, Height: 10, Weight: 1,
+				LengthUnit: model.LengthUnitFoot, WeightUnit: model.MassUnitPound,
+				Volume: 20, Density: 0.05,
+			},
+		},
+		{
+			name: "stored units kept",
+			dims: model.Dimensions{
+				Length: 10, Width: 10, Height: 10, Weight: 2,
+				LengthUnit: model.LengthUnitMillimeter, WeightUnit: model.MassUnitTonne,
+			},
+			system: model.UnitSystemUnspecified,
+			want: model.Dimensions{
+				Length: 10, Width: 10, Height: 10, Weight: 2,
+				LengthUnit: model.LengthUnitMillimeter, WeightUnit: model.MassUnitTonne,
+				Volume: 1000, Density: 0.002,
+			},
+		},
+		{
+			name:   "unspecified units read as meters and kilograms",
+			dims:   model.Dimensions{Length: 2, Width: 1, Height: 1, Weight: 1000},
+			system: model.UnitSystemImperial,
+			want: model.Dimensions{
+				Length: 2 / 0.3048, Width: 1 / 0.3048, Height: 1 / 0.3048, Weight: 1000 / 0.45359237,
+				LengthUnit: model.LengthUnitFoot, WeightUnit: model.MassUnitPound,
+				Volume:  2 / (0.3048 * 0.3048 * 0.3048),
+				Density: 1000 / 0.45359237 / (2 / (0.3048 * 0.3048 * 0.3048)),
+			},
+		},
+		{
+			name:   "no size means no density",
+			dims:   model.Dimensions{Weight: 5, LengthUnit: model.LengthUnitMeter, WeightUnit: model.MassUnitKilogram},
+			system: model.UnitSystemMetric,
+			want: model.Dimensions{
+				Weight: 5, LengthUnit: model.LengthUnitMeter, WeightUnit: model.MassUnitKilogram,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertDimensions(tt.dims, tt.system)
+			if got.LengthUnit != tt.want.LengthUnit || got.WeightUnit != tt.want.WeightUnit {
+				t.Fatalf("units = %v, %v, want %v, %v", got.LengthUnit, got.WeightUnit, tt.want.LengthUnit, tt.want.WeightUnit)
+			}
+			for _, v := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"length", got.Length, tt.want.Length},
+				{"width", got.Width, tt.want.Width},
+				{"height", got.Height, tt.want.Height},
+				{"weight", got.Weight, tt.want.Weight},
+				{"volume", got.Volume, tt.want.Volume},
+				{"density", got.Density, tt.want.Density},
+			} {
+				if !approxEqual(v.got, v.want) {
+					t.Errorf("%s = %v, want %v", v.name, v.got, v.want)
+				}
+			}
+		})
+	}
+}
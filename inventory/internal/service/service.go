@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/Denisz0785/spaceyard/inventory/internal/model"
+)
+
+type PartService interface {
+	// GetPart и ListParts локализуют название и описание по locales, упорядоченным по предпочтению.
+	GetPart(ctx context.Context, uuid string, system model.UnitSystem, locales []string) (model.Part, error)
+	ListParts(ctx context.Context, filter model.PartsFilter, system model.UnitSystem, locales []string) ([]model.Part, error)
+	AggregatePhysicalProperties(ctx context.Context, uuids []string, system model.UnitSystem) (model.PhysicalSummary, error)
+	UploadAttachment(ctx context.Context, info model.UploadAttachmentInfo, content io.Reader) (model.Attachment, error)
+	// DownloadAttachment возвращает метаданные и содержимое вложения, содержимое закрывает вызывающий.
+	DownloadAttachment(ctx context.Context, partUUID, attachmentUUID string) (model.Attachment, io.ReadCloser, error)
+}
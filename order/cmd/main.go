@@ -9,23 +9,24 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
-	"sync"
 	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
-	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	orderApiV1 "github.com/Denisz0785/spaceyard/order/internal/api/order/v1"
+	orderApiV2 "github.com/Denisz0785/spaceyard/order/internal/api/order/v2"
+	inventoryv1 "github.com/Denisz0785/spaceyard/order/internal/client/grpc/inventory/v1"
+	paymentv1 "github.com/Denisz0785/spaceyard/order/internal/client/grpc/payment/v1"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
 	orderRepo "github.com/Denisz0785/spaceyard/order/internal/repo/order"
 	orderService "github.com/Denisz0785/spaceyard/order/internal/service/order"
-
-	inventoryv1 "github.com/Denisz0785/spaceyard/order/internal/client/grpc/inventory/v1"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
 	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
-	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
 )
 
 const (
@@ -35,200 +36,20 @@ const (
 	// Таймауты для HTTP-сервера
 	readHeaderTimeout = 5 * time.Second
 	shutdownTimeout   = 10 * time.Second
+	// reconciliationDirEnv задаёт каталог отчётов сверки оплаченных заказов с транзакциями
+	// PaymentService. Если переменная не задана, сверка не запускается.
+	reconciliationDirEnv = "ORDER_RECONCILIATION_DIR"
+	// reconciliationIntervalEnv задаёт период сверки (например, "1h"), по умолчанию раз в сутки.
+	reconciliationIntervalEnv     = "ORDER_RECONCILIATION_INTERVAL"
+	defaultReconciliationInterval = 24 * time.Hour
+	// ratesFileEnv задаёт таблицу курсов валют. Без неё заказ принимает только детали
+	// с ценами в валюте заказов и не пересчитывает сумму в другие валюты.
+	ratesFileEnv = "ORDER_RATES_FILE"
+	// paymentEventsRetryInterval — пауза перед повторной подпиской на события платежей
+	// после обрыва потока.
+	paymentEventsRetryInterval = 5 * time.Second
 )
 
-// OrderStorage представляет потокобезопасное хранилище данных о заказах
-type OrderStorage struct {
-	mu     sync.RWMutex
-	orders map[string]*orderv1.Order
-}
-
-func NewOrderStorage() *OrderStorage {
-	return &OrderStorage{
-		orders: make(map[string]*orderv1.Order),
-	}
-}
-
-type OrderHandler struct {
-	storage         *OrderStorage
-	inventoryClient inventoryv1.InventoryServiceClient
-	paymentClient   paymentv1.PaymentServiceClient
-}
-
-func NewOrderHandler(storage *OrderStorage, invClient inventoryv1.InventoryServiceClient, payClient paymentv1.PaymentServiceClient) *OrderHandler {
-	return &OrderHandler{
-		storage:         storage,
-		inventoryClient: invClient,
-		paymentClient:   payClient,
-	}
-}
-
-// CancelOrder implements cancelOrder operation.
-//
-// Отменить заказ.
-//
-// POST /orders/{order_uuid}/cancel
-func (s *OrderHandler) CancelOrder(ctx context.Context, params orderv1.CancelOrderParams) (orderv1.CancelOrderRes, error) {
-	s.storage.mu.Lock() // Lock for the entire read-modify-write operation
-	defer s.storage.mu.Unlock()
-
-	orderUUID := params.OrderUUID.String()
-	order, ok := s.storage.orders[orderUUID]
-	if !ok {
-		return &orderv1.CancelOrderNotFound{}, nil
-	}
-
-	// Contract: If an order is already PAID, it cannot be cancelled.
-	if order.Status == orderv1.OrderStatusPAID {
-		return &orderv1.CancelOrderConflict{}, nil
-	}
-
-	// If the order is PENDING_PAYMENT, update its status to CANCELLED.
-	order.Status = orderv1.OrderStatusCANCELLED
-	s.storage.orders[orderUUID] = order
-
-	return &orderv1.CancelOrderNoContent{}, nil
-}
-
-// CreateOrder implements createOrder operation.
-//
-// Создать заказ.
-//
-// POST /orders
-func (s *OrderHandler) CreateOrder(ctx context.Context, req *orderv1.CreateOrderRequest) (orderv1.CreateOrderRes, error) {
-	// 1. Call InventoryService to get part details
-	partUUIDsStrings := make([]string, len(req.PartUuids))
-	for i, u := range req.PartUuids {
-		partUUIDsStrings[i] = u.String()
-	}
-
-	inventoryResp, err := s.inventoryClient.ListParts(ctx, &inventoryv1.ListPartsRequest{
-		Filter: &inventoryv1.PartsFilter{
-			Uuids: partUUIDsStrings,
-		},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to communicate with inventory service: %w", err)
-	}
-
-	// 2. Validate that all requested parts were found
-	if len(inventoryResp.GetParts()) != len(req.PartUuids) {
-		return nil, fmt.Errorf("one or more requested parts do not exist")
-	}
-
-	// 3. Calculate total price
-	var totalPrice float64
-	for _, part := range inventoryResp.GetParts() {
-		totalPrice += part.GetPrice()
-	}
-
-	// 4. Create and save the new order
-	order := &orderv1.Order{
-		OrderUUID:       uuid.New(),
-		UserUUID:        req.UserUUID,
-		PartUuids:       req.PartUuids,
-		TotalPrice:      totalPrice,
-		TransactionUUID: orderv1.OptNilUUID{},
-		PaymentMethod:   orderv1.OptPaymentMethod{},
-		Status:          orderv1.OrderStatusPENDINGPAYMENT,
-	}
-
-	s.storage.mu.Lock()
-	defer s.storage.mu.Unlock()
-	s.storage.orders[order.OrderUUID.String()] = order
-
-	// 5. Return the successful response
-	return &orderv1.CreateOrderResponse{
-		OrderUUID:  order.OrderUUID,
-		TotalPrice: order.TotalPrice,
-	}, nil
-}
-
-// GetOrder implements getOrder operation.
-//
-// Получить заказ по UUID.
-//
-// GET /orders/{order_uuid}
-func (s *OrderHandler) GetOrder(ctx context.Context, params orderv1.GetOrderParams) (orderv1.GetOrderRes, error) {
-	orderUUID := params.OrderUUID.String()
-
-	s.storage.mu.RLock()
-	defer s.storage.mu.RUnlock()
-
-	order, ok := s.storage.orders[orderUUID]
-	if !ok {
-		return &orderv1.GetOrderNotFound{}, nil
-	}
-
-	return order, nil
-}
-
-// PayOrder implements payOrder operation.
-// POST /orders/{order_uuid}/pay
-func (s *OrderHandler) PayOrder(ctx context.Context, req *orderv1.PayOrderRequest, params orderv1.PayOrderParams) (orderv1.PayOrderRes, error) {
-	s.storage.mu.Lock() // Lock for the entire read-modify-write operation
-	defer s.storage.mu.Unlock()
-
-	orderUUID := params.OrderUUID.String()
-	order, ok := s.storage.orders[orderUUID]
-	if !ok {
-		return &orderv1.PayOrderNotFound{}, nil
-	}
-
-	// Map OpenAPI PaymentMethod to gRPC PaymentMethod
-
-	paymentMethod, err := toGRPCPaymentMethod(req.PaymentMethod)
-	if err != nil {
-		return nil, fmt.Errorf("bad request: %w", err)
-	}
-
-	// Call PaymentService to process the payment
-
-	paymentResp, err := s.paymentClient.PayOrder(ctx, &paymentv1.PayOrderRequest{
-		OrderUuid:     order.OrderUUID.String(),
-		UserUuid:      order.UserUUID.String(),
-		PaymentMethod: paymentMethod,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to call payment service: %w", err)
-	}
-
-	// Parse the transaction UUID from the payment service response
-
-	transactionUUID, err := uuid.Parse(paymentResp.GetTransactionUuid())
-	if err != nil {
-		return nil, fmt.Errorf("payment service returned invalid transaction UUID: %w", err)
-	}
-
-	// Update order details with payment information
-
-	order.Status = orderv1.OrderStatusPAID
-	order.TransactionUUID = orderv1.NewOptNilUUID(transactionUUID)
-	order.PaymentMethod = orderv1.NewOptPaymentMethod(req.PaymentMethod)
-	s.storage.orders[orderUUID] = order
-
-	return &orderv1.PayOrderResponse{
-		TransactionUUID: transactionUUID,
-	}, nil
-}
-
-// toGRPCPaymentMethod converts an OpenAPI payment method to a gRPC payment method.
-
-func toGRPCPaymentMethod(method orderv1.PaymentMethod) (paymentv1.PaymentMethod, error) {
-	switch method {
-	case orderv1.PaymentMethodCARD:
-		return paymentv1.PaymentMethod_PAYMENT_METHOD_CARD, nil
-	case orderv1.PaymentMethodSBP:
-		return paymentv1.PaymentMethod_PAYMENT_METHOD_SBP, nil
-	case orderv1.PaymentMethodCREDITCARD:
-		return paymentv1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD, nil
-	case orderv1.PaymentMethodINVESTORMONEY:
-		return paymentv1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY, nil
-	default:
-		return paymentv1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED, fmt.Errorf("unknown payment method: %s", method)
-	}
-}
-
 func main() {
 	if err := run(); err != nil {
 		log.Printf("error: %v", err)
@@ -259,26 +80,47 @@ func run() error {
 			log.Printf("Error closing payment connection: %v", cerr)
 		}
 	}()
-	paymentClient := paymentv1.NewPaymentServiceClient(payConn)
+	paymentClient := paymentv1.New(payConn)
 	// --- End gRPC Client Setup ---
 
 	// Регистрируем наш сервис
+	rates, err := newRates()
+	if err != nil {
+		return err
+	}
+
 	repo := orderRepo.NewOrderStorage()
-	service := orderService.NewOrderService(repo, inventoryClient, paymentClient)
+	service := orderService.NewOrderService(repo, inventoryClient, paymentClient, rates)
 	api := orderApiV1.NewAPI(service)
+	apiV2 := orderApiV2.NewAPI(service)
 
-	//storage := NewOrderStorage()
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
 
-	//orderHandler := NewOrderHandler(storage, inventoryClient, paymentClient)
+	// Заказы, оплату которых опротестовал банк покупателя, отмечаются по событиям PaymentService.
+	go service.RunPaymentEventTracking(jobCtx, paymentEventsRetryInterval)
+
+	if dir := os.Getenv(reconciliationDirEnv); dir != "" {
+		interval := defaultReconciliationInterval
+		if value := os.Getenv(reconciliationIntervalEnv); value != "" {
+			interval, err = time.ParseDuration(value)
+			if err != nil || interval <= 0 {
+				return fmt.Errorf("invalid %s: %q must be a positive duration", reconciliationIntervalEnv, value)
+			}
+		}
+		log.Printf("reconciliation reports are written to %s every %s", dir, interval)
+		go service.RunReconciliation(jobCtx, interval, dir)
+	}
 
-	//srv, err := orderv1.NewServer(orderHandler, orderv1.WithPathPrefix("/api/v1"))
-	//if err != nil {
-	//	return err
-	//}
 	srv, err := orderv1.NewServer(api, orderv1.WithPathPrefix("/api/v1"))
 	if err != nil {
 		return err
 	}
+	// API v2 отдаёт суммы точными десятичными строками, v1 оставлен для совместимости.
+	srvV2, err := orderv2.NewServer(apiV2, orderv2.WithPathPrefix("/api/v2"))
+	if err != nil {
+		return err
+	}
 
 	// Инициализируем роутер Chi
 	r := chi.NewRouter()
@@ -289,7 +131,8 @@ func run() error {
 	r.Use(middleware.Timeout(10 * time.Second))
 
 	// Монтируем обработчики OpenAPI
-	r.Mount("/", srv)
+	r.Mount("/api/v1", srv)
+	r.Mount("/api/v2", srvV2)
 
 	// Запускаем HTTP-сервер
 	server := &http.Server{
@@ -330,3 +173,18 @@ func run() error {
 
 	return nil
 }
+
+// newRates загружает таблицу курсов из ORDER_RATES_FILE.
+func newRates() (*currency.Rates, error) {
+	path := os.Getenv(ratesFileEnv)
+	if path == "" {
+		return currency.NewRates(model.OrderCurrency), nil
+	}
+
+	rates, err := currency.LoadRates(path)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("exchange rates are loaded from %s, base currency %s", path, rates.Base())
+	return rates, nil
+}
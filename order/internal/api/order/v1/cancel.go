@@ -5,19 +5,21 @@ import (
 	"errors"
 
 	"github.com/Denisz0785/spaceyard/order/internal/model"
-
 	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
 )
 
 func (a *api) CancelOrder(ctx context.Context, params orderv1.CancelOrderParams) (orderv1.CancelOrderRes, error) {
 	err := a.orderService.CancelOrder(ctx, params.OrderUUID)
 	if err != nil {
-		if errors.Is(err, model.ErrUpdateOrder) {
-			return &orderv1.CancelOrderConflict{}, nil
-		} else if errors.Is(err, model.ErrOrderNotFound) {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
 			return &orderv1.CancelOrderNotFound{}, nil
-		} else if errors.Is(err, model.ErrCancelOrder) {
+		case errors.Is(err, model.ErrCancelOrder), errors.Is(err, model.ErrUpdateOrder), errors.Is(err, model.ErrConflict):
 			return &orderv1.CancelOrderConflict{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv1.CancelOrderServiceUnavailable{}, nil
+		default:
+			return nil, err
 		}
 	}
 
@@ -2,14 +2,26 @@ package v1
 
 import (
 	"context"
+	"errors"
+
 	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
 	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
 )
 
 func (a *api) CreateOrder(ctx context.Context, req *orderv1.CreateOrderRequest) (orderv1.CreateOrderRes, error) {
 	resp, err := a.orderService.CreateOrder(ctx, converter.OrderInfoToModel(req))
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, model.ErrPartNotFound), errors.Is(err, model.ErrNotFound):
+			return &orderv1.CreateOrderNotFound{}, nil
+		case errors.Is(err, model.ErrInvalidArgument), errors.Is(err, model.ErrUnsupportedCurrency):
+			return &orderv1.CreateOrderBadRequest{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv1.CreateOrderServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
 	}
 
 	return converter.ModelToCreateOrderResponse(*resp), nil
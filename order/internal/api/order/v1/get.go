@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrder(ctx context.Context, params orderv1.GetOrderParams) (orderv1.GetOrderRes, error) {
+	order, err := a.orderService.GetOrder(ctx, params.OrderUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &orderv1.GetOrderNotFound{}, nil
+		}
+		return nil, err
+	}
+
+	result := converter.ModelToOrder(order)
+	if displayCurrency, ok := params.Currency.Get(); ok {
+		price, err := a.orderService.ConvertOrderTotal(ctx, order, displayCurrency)
+		if err != nil {
+			if errors.Is(err, model.ErrUnsupportedCurrency) {
+				return &orderv1.GetOrderBadRequest{}, nil
+			}
+			return nil, err
+		}
+		result.DisplayPrice = converter.DisplayPriceToOrder(price)
+	}
+
+	return result, nil
+}
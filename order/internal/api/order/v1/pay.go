@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) PayOrder(ctx context.Context, req *orderv1.PayOrderRequest, params orderv1.PayOrderParams) (orderv1.PayOrderRes, error) {
+	payment, err := a.orderService.PayOrder(ctx, params.OrderUUID, converter.PaymentMethodToModel(req.PaymentMethod))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv1.PayOrderNotFound{}, nil
+		case errors.Is(err, model.ErrInvalidArgument):
+			return &orderv1.PayOrderBadRequest{}, nil
+		case errors.Is(err, model.ErrPaymentDeclined):
+			return &orderv1.PayOrderPaymentRequired{}, nil
+		case errors.Is(err, model.ErrPaymentBlocked):
+			return converter.PaymentBlockedFromError(err), nil
+		case errors.Is(err, model.ErrPayOrder), errors.Is(err, model.ErrConflict), errors.Is(err, model.ErrPartNotFound):
+			return &orderv1.PayOrderConflict{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv1.PayOrderServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.ModelToPayOrderResponse(payment), nil
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
+)
+
+func (a *api) GetOrderReceipt(ctx context.Context, params orderv1.GetOrderReceiptParams) (orderv1.GetOrderReceiptRes, error) {
+	receipt, err := a.orderService.GetOrderReceipt(ctx, params.OrderUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound), errors.Is(err, model.ErrReceiptNotFound):
+			return &orderv1.GetOrderReceiptNotFound{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv1.GetOrderReceiptServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.ModelToReceipt(receipt), nil
+}
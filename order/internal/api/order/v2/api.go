@@ -0,0 +1,15 @@
+package v2
+
+import (
+	"github.com/Denisz0785/spaceyard/order/internal/service"
+)
+
+type api struct {
+	orderService service.OrderService
+}
+
+func NewAPI(orderService service.OrderService) *api {
+	return &api{
+		orderService: orderService,
+	}
+}
@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
+)
+
+func (a *api) CancelOrder(ctx context.Context, params orderv2.CancelOrderParams) (orderv2.CancelOrderRes, error) {
+	err := a.orderService.CancelOrder(ctx, params.OrderUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv2.CancelOrderNotFound{}, nil
+		case errors.Is(err, model.ErrCancelOrder), errors.Is(err, model.ErrUpdateOrder), errors.Is(err, model.ErrConflict):
+			return &orderv2.CancelOrderConflict{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv2.CancelOrderServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return &orderv2.CancelOrderNoContent{}, nil
+}
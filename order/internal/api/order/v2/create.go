@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
+)
+
+func (a *api) CreateOrder(ctx context.Context, req *orderv2.CreateOrderRequest) (orderv2.CreateOrderRes, error) {
+	resp, err := a.orderService.CreateOrder(ctx, converter.OrderInfoV2ToModel(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound), errors.Is(err, model.ErrNotFound):
+			return &orderv2.CreateOrderNotFound{}, nil
+		case errors.Is(err, model.ErrInvalidArgument), errors.Is(err, model.ErrUnsupportedCurrency):
+			return &orderv2.CreateOrderBadRequest{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv2.CreateOrderServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.ModelToCreateOrderResponseV2(*resp), nil
+}
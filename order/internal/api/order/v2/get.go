@@ -0,0 +1,34 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
+)
+
+func (a *api) GetOrder(ctx context.Context, params orderv2.GetOrderParams) (orderv2.GetOrderRes, error) {
+	order, err := a.orderService.GetOrder(ctx, params.OrderUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return &orderv2.GetOrderNotFound{}, nil
+		}
+		return nil, err
+	}
+
+	result := converter.ModelToOrderV2(order)
+	if displayCurrency, ok := params.Currency.Get(); ok {
+		price, err := a.orderService.ConvertOrderTotal(ctx, order, displayCurrency)
+		if err != nil {
+			if errors.Is(err, model.ErrUnsupportedCurrency) {
+				return &orderv2.GetOrderBadRequest{}, nil
+			}
+			return nil, err
+		}
+		result.DisplayPrice = converter.DisplayPriceToOrderV2(price)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
+)
+
+func (a *api) PayOrder(ctx context.Context, req *orderv2.PayOrderRequest, params orderv2.PayOrderParams) (orderv2.PayOrderRes, error) {
+	payment, err := a.orderService.PayOrder(ctx, params.OrderUUID, converter.PaymentMethodV2ToModel(req.PaymentMethod))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound):
+			return &orderv2.PayOrderNotFound{}, nil
+		case errors.Is(err, model.ErrInvalidArgument):
+			return &orderv2.PayOrderBadRequest{}, nil
+		case errors.Is(err, model.ErrPaymentDeclined):
+			return &orderv2.PayOrderPaymentRequired{}, nil
+		case errors.Is(err, model.ErrPaymentBlocked):
+			return converter.PaymentBlockedV2FromError(err), nil
+		case errors.Is(err, model.ErrPayOrder), errors.Is(err, model.ErrConflict), errors.Is(err, model.ErrPartNotFound):
+			return &orderv2.PayOrderConflict{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv2.PayOrderServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.ModelToPayOrderResponseV2(payment), nil
+}
@@ -0,0 +1,26 @@
+package v2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/order/internal/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
+)
+
+func (a *api) GetOrderReceipt(ctx context.Context, params orderv2.GetOrderReceiptParams) (orderv2.GetOrderReceiptRes, error) {
+	receipt, err := a.orderService.GetOrderReceipt(ctx, params.OrderUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrOrderNotFound), errors.Is(err, model.ErrReceiptNotFound):
+			return &orderv2.GetOrderReceiptNotFound{}, nil
+		case errors.Is(err, model.ErrServiceUnavailable):
+			return &orderv2.GetOrderReceiptServiceUnavailable{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return converter.ModelToReceiptV2(receipt), nil
+}
@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// Домены google.rpc.ErrorInfo в InventoryService и PaymentService.
+const (
+	inventoryErrorDomain = "inventory.spaceyard"
+	paymentErrorDomain   = "payment.spaceyard"
+)
+
+// ErrorFromStatus преобразует ошибку gRPC-вызова в доменную ошибку *model.RemoteError.
+// Ошибки, не являющиеся gRPC-статусом, возвращаются без изменений.
+func ErrorFromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	remoteErr := &model.RemoteError{
+		Kind:    kindFromCode(st.Code()),
+		Message: st.Message(),
+	}
+	if remoteErr.Kind == nil {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			remoteErr.Domain = d.GetDomain()
+			remoteErr.Reason = d.GetReason()
+			remoteErr.Metadata = d.GetMetadata()
+		case *errdetails.ResourceInfo:
+			remoteErr.Resource = d.GetResourceName()
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				remoteErr.Violations = append(remoteErr.Violations, model.FieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		}
+	}
+
+	if remoteErr.Domain == inventoryErrorDomain &&
+		remoteErr.Reason == inventoryv1.ErrorReason_ERROR_REASON_PART_NOT_FOUND.String() {
+		remoteErr.Kind = model.ErrPartNotFound
+	}
+	if remoteErr.Domain == paymentErrorDomain &&
+		remoteErr.Reason == paymentv1.ErrorReason_ERROR_REASON_PAYMENT_DECLINED.String() {
+		remoteErr.Kind = model.ErrPaymentDeclined
+	}
+	if remoteErr.Domain == paymentErrorDomain &&
+		remoteErr.Reason == paymentv1.ErrorReason_ERROR_REASON_PAYMENT_BLOCKED.String() {
+		remoteErr.Kind = model.ErrPaymentBlocked
+	}
+
+	return remoteErr
+}
+
+// kindFromCode сводит gRPC-код к доменной ошибке. nil означает, что код не классифицируется.
+func kindFromCode(code codes.Code) error {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return model.ErrInvalidArgument
+	case codes.PermissionDenied:
+		return model.ErrPermissionDenied
+	case codes.NotFound:
+		return model.ErrNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return model.ErrConflict
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return model.ErrServiceUnavailable
+	default:
+		return nil
+	}
+}
@@ -1,7 +1,10 @@
 package converter
 
 import (
+	"fmt"
+
 	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
 	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
 )
 
@@ -30,7 +33,7 @@ func PartFromProto(part *inventoryv1.Part) (model.Part, error) {
 		UUID:          part.GetUuid(), // Прямое присваивание, так как оба - строки
 		Name:          part.GetName(),
 		Description:   part.GetDescription(),
-		Price:         part.GetPrice(),
+		Price:         money.FromProto(part.GetPrice()),
 		StockQuantity: part.GetStockQuantity(),
 		Category:      model.Category(part.GetCategory()), // Простое приведение типов для enum
 		Dimensions:    dims,
@@ -69,6 +72,19 @@ func DimensionsFromProto(dims *inventoryv1.Dimensions) model.Dimensions {
 	}
 }
 
+// PhysicalSummaryFromProto преобразует сводку InventoryService в доменную модель.
+// Сводка запрашивается в метрической системе, другие единицы в ответе — ошибка.
+func PhysicalSummaryFromProto(resp *inventoryv1.AggregatePhysicalPropertiesResponse) (model.PhysicalSummary, error) {
+	if resp.GetMassUnit() != inventoryv1.MassUnit_MASS_UNIT_KILOGRAM || resp.GetLengthUnit() != inventoryv1.LengthUnit_LENGTH_UNIT_METER {
+		return model.PhysicalSummary{}, fmt.Errorf("inventory returned physical summary in %s and %s, want kilograms and meters",
+			resp.GetMassUnit(), resp.GetLengthUnit())
+	}
+	return model.PhysicalSummary{
+		TotalMassKg:   resp.GetTotalMass(),
+		TotalVolumeM3: resp.GetTotalVolume(),
+	}, nil
+}
+
 // ManufacturerFromProto преобразует protobuf-модель Manufacturer в доменную.
 func ManufacturerFromProto(m *inventoryv1.Manufacturer) model.Manufacturer {
 	if m == nil {
@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// PaymentMethodToProto преобразует доменный способ оплаты в protobuf-enum.
+// Неизвестные значения становятся PAYMENT_METHOD_UNSPECIFIED, их отклонит PaymentService.
+func PaymentMethodToProto(method model.PaymentMethod) paymentv1.PaymentMethod {
+	switch method {
+	case model.PaymentMethodCARD:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.PaymentMethodSBP:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.PaymentMethodCREDITCARD:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.PaymentMethodINVESTORMONEY:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return paymentv1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+// TransactionFromProto преобразует транзакцию PaymentService в модель для сверки с заказами.
+func TransactionFromProto(t *paymentv1.Transaction) (model.PaymentTransaction, error) {
+	transactionUUID, err := uuid.Parse(t.GetUuid())
+	if err != nil {
+		return model.PaymentTransaction{}, fmt.Errorf("invalid transaction UUID: %w", err)
+	}
+	orderUUID, err := uuid.Parse(t.GetOrderUuid())
+	if err != nil {
+		return model.PaymentTransaction{}, fmt.Errorf("invalid order UUID of transaction %s: %w", transactionUUID, err)
+	}
+
+	transaction := model.PaymentTransaction{
+		UUID:      transactionUUID,
+		OrderUUID: orderUUID,
+		Status:    model.TransactionStatus(strings.TrimPrefix(t.GetStatus().String(), "TRANSACTION_STATUS_")),
+		Amount:    money.FromProto(t.GetAmount()),
+		CreatedAt: t.GetCreatedAt().AsTime(),
+	}
+	if t.GetCapturedAt() != nil {
+		transaction.CapturedAt = t.GetCapturedAt().AsTime()
+	}
+
+	return transaction, nil
+}
+
+// SBPPaymentFromProto преобразует ожидающий платёж СБП.
+func SBPPaymentFromProto(intent *paymentv1.SbpPaymentIntent) (model.SBPPayment, error) {
+	transactionUUID, err := uuid.Parse(intent.GetTransaction().GetUuid())
+	if err != nil {
+		return model.SBPPayment{}, fmt.Errorf("invalid transaction UUID of SBP payment: %w", err)
+	}
+
+	return model.SBPPayment{
+		TransactionUUID:    transactionUUID,
+		Payload:            intent.GetPayload(),
+		QRImage:            intent.GetQrImage(),
+		QRImageContentType: intent.GetQrImageContentType(),
+		ExpiresAt:          intent.GetTransaction().GetAuthorizationExpiresAt().AsTime(),
+	}, nil
+}
+
+// PaymentEventFromProto преобразует событие потока PaymentService.
+func PaymentEventFromProto(e *paymentv1.PaymentEvent) (model.PaymentEvent, error) {
+	transactionUUID, err := uuid.Parse(e.GetTransactionUuid())
+	if err != nil {
+		return model.PaymentEvent{}, fmt.Errorf("invalid transaction UUID of event %d: %w", e.GetSequence(), err)
+	}
+	orderUUID, err := uuid.Parse(e.GetOrderUuid())
+	if err != nil {
+		return model.PaymentEvent{}, fmt.Errorf("invalid order UUID of event %d: %w", e.GetSequence(), err)
+	}
+
+	return model.PaymentEvent{
+		Sequence:          e.GetSequence(),
+		Type:              model.PaymentEventType(strings.TrimPrefix(e.GetType().String(), "PAYMENT_EVENT_TYPE_")),
+		TransactionUUID:   transactionUUID,
+		OrderUUID:         orderUUID,
+		TransactionStatus: model.TransactionStatus(strings.TrimPrefix(e.GetTransactionStatus().String(), "TRANSACTION_STATUS_")),
+	}, nil
+}
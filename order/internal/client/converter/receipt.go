@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// LineItemsToProto передаёт детали заказа строками чека: каждая деталь — одна единица,
+// её UUID служит артикулом.
+func LineItemsToProto(items []model.OrderItem) []*paymentv1.LineItem {
+	result := make([]*paymentv1.LineItem, 0, len(items))
+	for _, item := range items {
+		name := item.Name
+		if name == "" {
+			name = "Деталь " + item.PartUUID.String()
+		}
+		result = append(result, &paymentv1.LineItem{
+			Sku:       item.PartUUID.String(),
+			Name:      name,
+			Quantity:  1,
+			UnitPrice: money.ToProto(item.Price),
+		})
+	}
+	return result
+}
+
+// PaymentMethodFromProto преобразует protobuf-enum в доменный способ оплаты.
+func PaymentMethodFromProto(method paymentv1.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_CARD:
+		return model.PaymentMethodCARD
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_SBP:
+		return model.PaymentMethodSBP
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return model.PaymentMethodCREDITCARD
+	case paymentv1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return model.PaymentMethodINVESTORMONEY
+	default:
+		return model.PaymentMethodUNKNOWN
+	}
+}
+
+// ReceiptFromProto преобразует чек PaymentService в доменную модель.
+func ReceiptFromProto(r *paymentv1.Receipt) (model.Receipt, error) {
+	receiptUUID, err := uuid.Parse(r.GetUuid())
+	if err != nil {
+		return model.Receipt{}, fmt.Errorf("invalid receipt UUID: %w", err)
+	}
+	orderUUID, err := uuid.Parse(r.GetOrderUuid())
+	if err != nil {
+		return model.Receipt{}, fmt.Errorf("invalid order UUID of receipt %s: %w", receiptUUID, err)
+	}
+	transactionUUID, err := uuid.Parse(r.GetTransactionUuid())
+	if err != nil {
+		return model.Receipt{}, fmt.Errorf("invalid transaction UUID of receipt %s: %w", receiptUUID, err)
+	}
+
+	items := make([]model.ReceiptItem, 0, len(r.GetItems()))
+	for _, item := range r.GetItems() {
+		items = append(items, model.ReceiptItem{
+			SKU:                item.GetSku(),
+			Name:               item.GetName(),
+			Quantity:           int(item.GetQuantity()),
+			UnitPrice:          money.FromProto(item.GetUnitPrice()),
+			Amount:             money.FromProto(item.GetAmount()),
+			TaxRateBasisPoints: item.GetTaxRateBasisPoints(),
+			Tax:                money.FromProto(item.GetTax()),
+		})
+	}
+
+	return model.Receipt{
+		UUID:             receiptUUID,
+		OrderUUID:        orderUUID,
+		TransactionUUID:  transactionUUID,
+		PaymentMethod:    PaymentMethodFromProto(r.GetPaymentMethod()),
+		MaskedCardNumber: r.GetMaskedCardNumber(),
+		Items:            items,
+		Total:            money.FromProto(r.GetTotal()),
+		CreditAmount:     money.FromProto(r.GetCreditAmount()),
+		TaxTotal:         money.FromProto(r.GetTaxTotal()),
+		PaidAt:           r.GetPaidAt().AsTime(),
+		HTML:             r.GetHtml(),
+		Text:             r.GetText(),
+	}, nil
+}
@@ -5,12 +5,35 @@ import (
 
 	"github.com/Denisz0785/spaceyard/order/internal/model"
 	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
 )
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, partUUIDs []string) ([]model.Part, error)
+	// AggregatePhysicalProperties возвращает суммарную массу и объём деталей; деталь,
+	// указанная несколько раз, учитывается столько же раз.
+	AggregatePhysicalProperties(ctx context.Context, partUUIDs []string) (model.PhysicalSummary, error)
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod) (transactionUUID uuid.UUID, err error)
+	// AuthorizePayment удерживает сумму заказа до списания или отмены. Детали заказа
+	// передаются строками чека; без них чек содержит одну строку на всю сумму.
+	AuthorizePayment(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod, amount money.Money, items []model.OrderItem) (transactionUUID uuid.UUID, err error)
+	// CreateSBPPaymentIntent создаёт платёж СБП на сумму заказа и возвращает его QR-код.
+	// Деньги поступят, когда покупатель оплатит QR-код, об этом сообщит событие CAPTURED.
+	CreateSBPPaymentIntent(ctx context.Context, orderUUID, userUUID uuid.UUID, amount money.Money, items []model.OrderItem) (model.SBPPayment, error)
+	// CapturePayment списывает всю авторизованную сумму.
+	CapturePayment(ctx context.Context, transactionUUID uuid.UUID) error
+	// VoidAuthorization снимает удержание без списания.
+	VoidAuthorization(ctx context.Context, transactionUUID uuid.UUID) error
+	// RefundPayment возвращает весь остаток оплаты по транзакции при отмене заказа.
+	RefundPayment(ctx context.Context, transactionUUID uuid.UUID) error
+	// ListTransactions возвращает все транзакции в порядке создания.
+	ListTransactions(ctx context.Context) ([]model.PaymentTransaction, error)
+	// GetReceipt возвращает чек списанной транзакции.
+	GetReceipt(ctx context.Context, transactionUUID uuid.UUID) (model.Receipt, error)
+	// SubscribePaymentEvents передаёт handle события платежей с номерами больше afterSequence,
+	// сначала сохранённые, затем новые. Возвращается при отмене ctx, обрыве потока или ошибке handle.
+	SubscribePaymentEvents(ctx context.Context, afterSequence int64, handle func(model.PaymentEvent) error) error
 }
@@ -36,8 +36,20 @@ func (c *inventoryClient) ListParts(ctx context.Context, partUUIDs []string) ([]
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("inventory client: failed to list parts: %w", err)
+		return nil, fmt.Errorf("inventory client: failed to list parts: %w", converter.ErrorFromStatus(err))
 	}
 
 	return converter.PartsFromProto(resp.GetParts())
 }
+
+func (c *inventoryClient) AggregatePhysicalProperties(ctx context.Context, partUUIDs []string) (model.PhysicalSummary, error) {
+	resp, err := c.grpcClient.AggregatePhysicalProperties(ctx, &inventoryv1.AggregatePhysicalPropertiesRequest{
+		Uuids:      partUUIDs,
+		UnitSystem: inventoryv1.UnitSystem_UNIT_SYSTEM_METRIC,
+	})
+	if err != nil {
+		return model.PhysicalSummary{}, fmt.Errorf("inventory client: failed to aggregate physical properties: %w", converter.ErrorFromStatus(err))
+	}
+
+	return converter.PhysicalSummaryFromProto(resp)
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+func (c *inventoryClient) AggregatePhysicalProperties(ctx context.Context, partUUIDs []string) (model.PhysicalSummary, error) {
+	resp, err := c.grpcClient.AggregatePhysicalProperties(ctx, &inventoryv1.AggregatePhysicalPropertiesRequest{
+		Uuids:      partUUIDs,
+		UnitSystem: inventoryv1.UnitSystem_UNIT_SYSTEM_METRIC,
+	})
+	if err != nil {
+		return model.PhysicalSummary{}, fmt.Errorf("inventory client: failed to aggregate physical properties: %w", converter.ErrorFromStatus(err))
+	}
+
+	return converter.PhysicalSummaryFromProto(resp)
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
+)
+
+// stubInventoryService отвечает сводкой resp и запоминает запрос.
+type stubInventoryService struct {
+	inventoryv1.InventoryServiceClient
+	resp *inventoryv1.AggregatePhysicalPropertiesResponse
+	req  *inventoryv1.AggregatePhysicalPropertiesRequest
+}
+
+func (s *stubInventoryService) AggregatePhysicalProperties(
+	_ context.Context,
+	req *inventoryv1.AggregatePhysicalPropertiesRequest,
+	_ ...grpc.CallOption,
+) (*inventoryv1.AggregatePhysicalPropertiesResponse, error) {
+	s.req = req
+	return s.resp, nil
+}
+
+func TestAggregatePhysicalProperties(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    *inventoryv1.AggregatePhysicalPropertiesResponse
+		want    model.PhysicalSummary
+		wantErr bool
+	}{
+		{
+			name: "metric",
+			resp: &inventoryv1.AggregatePhysicalPropertiesResponse{
+				TotalMass:   1250.5,
+				TotalVolume: 3.75,
+				MassUnit:    inventoryv1.MassUnit_MASS_UNIT_KILOGRAM,
+				LengthUnit:  inventoryv1.LengthUnit_LENGTH_UNIT_METER,
+				PartsCount:  2,
+			},
+			want: model.PhysicalSummary{TotalMassKg: 1250.5, TotalVolumeM3: 3.75},
+		},
+		{
+			name: "other units",
+			resp: &inventoryv1.AggregatePhysicalPropertiesResponse{
+				TotalMass:  2756.9,
+				MassUnit:   inventoryv1.MassUnit_MASS_UNIT_POUND,
+				LengthUnit: inventoryv1.LengthUnit_LENGTH_UNIT_FOOT,
+			},
+			wantErr: true,
+		},
+	}
+
+	partUUIDs := []string{"111e2222-e89b-12d3-a456-426614174001", "111e2222-e89b-12d3-a456-426614174001"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubInventoryService{resp: tt.resp}
+			c := &inventoryClient{grpcClient: stub}
+
+			got, err := c.AggregatePhysicalProperties(context.Background(), partUUIDs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AggregatePhysicalProperties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("AggregatePhysicalProperties() = %+v, want %+v", got, tt.want)
+			}
+			if stub.req.GetUnitSystem() != inventoryv1.UnitSystem_UNIT_SYSTEM_METRIC || len(stub.req.GetUuids()) != len(partUUIDs) {
+				t.Fatalf("request = %v, want metric summary of %d parts", stub.req, len(partUUIDs))
+			}
+		})
+	}
+}
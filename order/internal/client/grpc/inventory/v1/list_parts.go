@@ -3,8 +3,8 @@ package v1
 import (
 	"context"
 	"fmt"
-	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
 
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
 	"github.com/Denisz0785/spaceyard/order/internal/model"
 	inventoryv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1"
 )
@@ -16,7 +16,7 @@ func (c *inventoryClient) ListParts(ctx context.Context, partUUIDs []string) ([]
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("inventory client: failed to list parts: %w", err)
+		return nil, fmt.Errorf("inventory client: failed to list parts: %w", converter.ErrorFromStatus(err))
 	}
 
 	return converter.PartsFromProto(resp.GetParts())
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) AuthorizePayment(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod model.PaymentMethod, amount money.Money, items []model.OrderItem) (uuid.UUID, error) {
+	resp, err := c.grpcClient.AuthorizePayment(ctx, &paymentv1.AuthorizePaymentRequest{
+		OrderUuid:     orderUUID.String(),
+		UserUuid:      userUUID.String(),
+		PaymentMethod: converter.PaymentMethodToProto(paymentMethod),
+		Amount:        money.ToProto(amount),
+		LineItems:     converter.LineItemsToProto(items),
+		// Заказ оплачивается один раз, поэтому его UUID служит ключом идемпотентности:
+		// повтор после таймаута вернёт ту же транзакцию, а не удержит деньги ещё раз.
+		// После отмены удержания PaymentService освобождает ключ, и заказ можно оплатить снова.
+		IdempotencyKey: orderUUID.String(),
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("payment client: failed to authorize payment: %w", converter.ErrorFromStatus(err))
+	}
+
+	transactionUUID, err := uuid.Parse(resp.GetTransaction().GetUuid())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("payment client: invalid transaction UUID: %w", err)
+	}
+
+	return transactionUUID, nil
+}
@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) CapturePayment(ctx context.Context, transactionUUID uuid.UUID) error {
+	_, err := c.grpcClient.CapturePayment(ctx, &paymentv1.CapturePaymentRequest{
+		TransactionUuid: transactionUUID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("payment client: failed to capture payment: %w", converter.ErrorFromStatus(err))
+	}
+
+	return nil
+}
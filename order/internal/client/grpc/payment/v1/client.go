@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"google.golang.org/grpc"
+
+	clientGrpc "github.com/Denisz0785/spaceyard/order/internal/client/grpc"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// Проверяем на этапе компиляции, что наша реализация удовлетворяет интерфейсу.
+var _ clientGrpc.PaymentClient = (*paymentClient)(nil)
+
+type paymentClient struct {
+	grpcClient paymentv1.PaymentServiceClient
+}
+
+func New(conn *grpc.ClientConn) *paymentClient {
+	return &paymentClient{
+		grpcClient: paymentv1.NewPaymentServiceClient(conn),
+	}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) CreateSBPPaymentIntent(ctx context.Context, orderUUID, userUUID uuid.UUID, amount money.Money, items []model.OrderItem) (model.SBPPayment, error) {
+	resp, err := c.grpcClient.CreateSbpPaymentIntent(ctx, &paymentv1.CreateSbpPaymentIntentRequest{
+		OrderUuid: orderUUID.String(),
+		UserUuid:  userUUID.String(),
+		Amount:    money.ToProto(amount),
+		LineItems: converter.LineItemsToProto(items),
+		// Повтор оплаты заказа через СБП возвращает тот же QR-код, пока он не истёк.
+		IdempotencyKey: orderUUID.String(),
+	})
+	if err != nil {
+		return model.SBPPayment{}, fmt.Errorf("payment client: failed to create SBP payment: %w", converter.ErrorFromStatus(err))
+	}
+
+	payment, err := converter.SBPPaymentFromProto(resp.GetIntent())
+	if err != nil {
+		return model.SBPPayment{}, fmt.Errorf("payment client: %w", err)
+	}
+
+	return payment, nil
+}
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) GetReceipt(ctx context.Context, transactionUUID uuid.UUID) (model.Receipt, error) {
+	resp, err := c.grpcClient.GetReceipt(ctx, &paymentv1.GetReceiptRequest{
+		TransactionUuid: transactionUUID.String(),
+	})
+	if err != nil {
+		return model.Receipt{}, fmt.Errorf("payment client: failed to get receipt: %w", converter.ErrorFromStatus(err))
+	}
+
+	receipt, err := converter.ReceiptFromProto(resp.GetReceipt())
+	if err != nil {
+		return model.Receipt{}, fmt.Errorf("payment client: %w", err)
+	}
+
+	return receipt, nil
+}
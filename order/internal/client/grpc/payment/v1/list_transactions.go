@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) ListTransactions(ctx context.Context) ([]model.PaymentTransaction, error) {
+	resp, err := c.grpcClient.ListTransactions(ctx, &paymentv1.ListTransactionsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("payment client: failed to list transactions: %w", converter.ErrorFromStatus(err))
+	}
+
+	transactions := make([]model.PaymentTransaction, 0, len(resp.GetTransactions()))
+	for _, t := range resp.GetTransactions() {
+		transaction, err := converter.TransactionFromProto(t)
+		if err != nil {
+			return nil, fmt.Errorf("payment client: %w", err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
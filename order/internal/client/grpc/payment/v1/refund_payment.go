@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) RefundPayment(ctx context.Context, transactionUUID uuid.UUID) error {
+	_, err := c.grpcClient.RefundPayment(ctx, &paymentv1.RefundPaymentRequest{
+		TransactionUuid: transactionUUID.String(),
+		Reason:          paymentv1.RefundReason_REFUND_REASON_ORDER_CANCELLED,
+	})
+	if err == nil {
+		return nil
+	}
+
+	err = converter.ErrorFromStatus(err)
+
+	var remoteErr *model.RemoteError
+	if !errors.As(err, &remoteErr) ||
+		remoteErr.Reason != paymentv1.ErrorReason_ERROR_REASON_TRANSACTION_NOT_REFUNDABLE.String() {
+		return fmt.Errorf("payment client: failed to refund payment: %w", err)
+	}
+
+	// Вернуть нельзя и отменённую, истёкшую, оспоренную или ещё не оплаченную транзакцию.
+	// Уже возвращённой, например при повторе после таймаута, она считается, только
+	// если это подтверждает её статус.
+	resp, getErr := c.grpcClient.GetTransaction(ctx, &paymentv1.GetTransactionRequest{
+		TransactionUuid: transactionUUID.String(),
+	})
+	if getErr != nil {
+		return fmt.Errorf("payment client: failed to refund payment: %w (status check failed: %w)",
+			err, converter.ErrorFromStatus(getErr))
+	}
+	if status := resp.GetTransaction().GetStatus(); status != paymentv1.TransactionStatus_TRANSACTION_STATUS_REFUNDED {
+		return fmt.Errorf("payment client: failed to refund payment in status %s: %w", status, err)
+	}
+
+	return fmt.Errorf("payment client: %w: %w", model.ErrAlreadyRefunded, err)
+}
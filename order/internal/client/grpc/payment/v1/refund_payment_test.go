@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// stubPaymentService отклоняет возврат ошибкой refundErr и отдаёт транзакцию в статусе transactionStatus.
+type stubPaymentService struct {
+	paymentv1.PaymentServiceClient
+	refundErr         error
+	transactionStatus paymentv1.TransactionStatus
+}
+
+func (s stubPaymentService) RefundPayment(context.Context, *paymentv1.RefundPaymentRequest, ...grpc.CallOption) (*paymentv1.RefundPaymentResponse, error) {
+	return nil, s.refundErr
+}
+
+func (s stubPaymentService) GetTransaction(_ context.Context, req *paymentv1.GetTransactionRequest, _ ...grpc.CallOption) (*paymentv1.GetTransactionResponse, error) {
+	return &paymentv1.GetTransactionResponse{
+		Transaction: &paymentv1.Transaction{Uuid: req.GetTransactionUuid(), Status: s.transactionStatus},
+	}, nil
+}
+
+func notRefundable(t *testing.T) error {
+	t.Helper()
+
+	st, err := status.New(codes.FailedPrecondition, "transaction cannot be refunded").WithDetails(&errdetails.ErrorInfo{
+		Reason: paymentv1.ErrorReason_ERROR_REASON_TRANSACTION_NOT_REFUNDABLE.String(),
+	})
+	if err != nil {
+		t.Fatalf("failed to attach error details: %v", err)
+	}
+	return st.Err()
+}
+
+func TestRefundPaymentAlreadyRefunded(t *testing.T) {
+	tests := []struct {
+		name              string
+		transactionStatus paymentv1.TransactionStatus
+		wantRefunded      bool
+	}{
+		{name: "refunded", transactionStatus: paymentv1.TransactionStatus_TRANSACTION_STATUS_REFUNDED, wantRefunded: true},
+		{name: "voided", transactionStatus: paymentv1.TransactionStatus_TRANSACTION_STATUS_VOIDED},
+		{name: "expired", transactionStatus: paymentv1.TransactionStatus_TRANSACTION_STATUS_EXPIRED},
+		{name: "charged back", transactionStatus: paymentv1.TransactionStatus_TRANSACTION_STATUS_CHARGED_BACK},
+		{name: "pending", transactionStatus: paymentv1.TransactionStatus_TRANSACTION_STATUS_PENDING},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &paymentClient{grpcClient: stubPaymentService{
+				refundErr:         notRefundable(t),
+				transactionStatus: tt.transactionStatus,
+			}}
+
+			err := c.RefundPayment(context.Background(), uuid.New())
+			if err == nil {
+				t.Fatal("RefundPayment() error = nil")
+			}
+			if got := errors.Is(err, model.ErrAlreadyRefunded); got != tt.wantRefunded {
+				t.Fatalf("RefundPayment() error = %v, already refunded %v, want %v", err, got, tt.wantRefunded)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Denisz0785/spaceyard/order/internal/client/converter"
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func (c *paymentClient) SubscribePaymentEvents(ctx context.Context, afterSequence int64, handle func(model.PaymentEvent) error) error {
+	stream, err := c.grpcClient.SubscribePaymentEvents(ctx, &paymentv1.SubscribePaymentEventsRequest{
+		AfterSequence: afterSequence,
+	})
+	if err != nil {
+		return fmt.Errorf("payment client: failed to subscribe to payment events: %w", converter.ErrorFromStatus(err))
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("payment client: payment events stream is broken: %w", converter.ErrorFromStatus(err))
+		}
+
+		event, err := converter.PaymentEventFromProto(resp.GetEvent())
+		if err != nil {
+			return fmt.Errorf("payment client: %w", err)
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+}
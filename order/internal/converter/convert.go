@@ -1,6 +1,9 @@
 package converter
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/Denisz0785/spaceyard/order/internal/model"
 	orderv1 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v1"
 )
@@ -32,6 +35,120 @@ func OrderInfoToModel(req *orderv1.CreateOrderRequest) *model.CreateOrderInfo {
 func ModelToCreateOrderResponse(resp model.CreateOrderResponse) *orderv1.CreateOrderResponse {
 	return &orderv1.CreateOrderResponse{
 		OrderUUID:  resp.OrderUUID,
-		TotalPrice: resp.TotalPrice,
+		TotalPrice: resp.TotalPrice.Float64(),
+		Currency:   resp.TotalPrice.CurrencyCode,
+	}
+}
+
+func ModelToOrder(o model.Order) *orderv1.Order {
+	order := &orderv1.Order{
+		OrderUUID:     o.OrderUUID,
+		UserUUID:      o.UserUUID,
+		PartUuids:     o.PartUuids,
+		TotalPrice:    o.TotalPrice.Float64(),
+		Currency:      o.TotalPrice.CurrencyCode,
+		TotalMassKg:   orderv1.NewOptFloat64(o.Physical.TotalMassKg),
+		TotalVolumeM3: orderv1.NewOptFloat64(o.Physical.TotalVolumeM3),
+		Status:        orderv1.OrderStatus(o.Status),
+	}
+	if o.TransactionUUID != nil {
+		order.TransactionUUID = orderv1.NewOptNilUUID(*o.TransactionUUID)
+	}
+	if o.PaymentMethod != nil {
+		order.PaymentMethod = orderv1.NewOptPaymentMethod(orderv1.PaymentMethod(*o.PaymentMethod))
+	}
+
+	return order
+}
+
+func DisplayPriceToOrder(price model.DisplayPrice) orderv1.OptDisplayPrice {
+	result := orderv1.DisplayPrice{
+		TotalPrice:   price.TotalPrice.Float64(),
+		Currency:     price.TotalPrice.CurrencyCode,
+		ExchangeRate: price.ExchangeRate,
+	}
+	if !price.RateEffectiveFrom.IsZero() {
+		result.RateEffectiveFrom = orderv1.NewOptDate(price.RateEffectiveFrom)
+	}
+	return orderv1.NewOptDisplayPrice(result)
+}
+
+func PaymentMethodToModel(method orderv1.PaymentMethod) model.PaymentMethod {
+	return model.PaymentMethod(method)
+}
+
+// PaymentBlockedFromError описывает для клиента блокировку оплаты антифродом платёжного сервиса.
+func ModelToPayOrderResponse(payment model.OrderPayment) *orderv1.PayOrderResponse {
+	resp := &orderv1.PayOrderResponse{
+		TransactionUUID: payment.TransactionUUID,
+	}
+	if payment.SBP != nil {
+		resp.SbpPayment = orderv1.NewOptSbpPayment(orderv1.SbpPayment{
+			Payload:            payment.SBP.Payload,
+			QrImage:            payment.SBP.QRImage,
+			QrImageContentType: payment.SBP.QRImageContentType,
+			ExpiresAt:          payment.SBP.ExpiresAt,
+		})
 	}
+
+	return resp
+}
+
+func PaymentBlockedFromError(err error) *orderv1.PaymentBlocked {
+	result := &orderv1.PaymentBlocked{Reason: orderv1.FraudReasonUNKNOWN}
+
+	var remoteErr *model.RemoteError
+	if !errors.As(err, &remoteErr) {
+		return result
+	}
+
+	reason := orderv1.FraudReason(strings.TrimPrefix(remoteErr.Metadata["fraud_reason"], "FRAUD_REASON_"))
+	if reason.Validate() == nil {
+		result.Reason = reason
+	}
+	if rule := remoteErr.Metadata["rule"]; rule != "" {
+		result.Rule = orderv1.NewOptString(rule)
+	}
+
+	return result
+}
+
+func ModelToReceipt(r model.Receipt) *orderv1.Receipt {
+	items := make([]orderv1.ReceiptItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		result := orderv1.ReceiptItem{
+			Name:               item.Name,
+			Quantity:           int32(item.Quantity), // #nosec G115 -- количество приходит из int32
+			UnitPrice:          item.UnitPrice.Float64(),
+			Amount:             item.Amount.Float64(),
+			TaxRateBasisPoints: item.TaxRateBasisPoints,
+			Tax:                item.Tax.Float64(),
+		}
+		if item.SKU != "" {
+			result.Sku = orderv1.NewOptString(item.SKU)
+		}
+		items = append(items, result)
+	}
+
+	receipt := &orderv1.Receipt{
+		ReceiptUUID:     r.UUID,
+		OrderUUID:       r.OrderUUID,
+		TransactionUUID: r.TransactionUUID,
+		PaymentMethod:   orderv1.PaymentMethod(r.PaymentMethod),
+		Items:           items,
+		TotalPrice:      r.Total.Float64(),
+		TaxTotal:        r.TaxTotal.Float64(),
+		Currency:        r.Total.CurrencyCode,
+		PaidAt:          r.PaidAt,
+		HTML:            r.HTML,
+		Text:            r.Text,
+	}
+	if r.MaskedCardNumber != "" {
+		receipt.MaskedCardNumber = orderv1.NewOptString(r.MaskedCardNumber)
+	}
+	if r.CreditAmount.IsPositive() {
+		receipt.CreditAmount = orderv1.NewOptFloat64(r.CreditAmount.Float64())
+	}
+
+	return receipt
 }
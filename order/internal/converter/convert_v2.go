@@ -0,0 +1,145 @@
+package converter
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	orderv2 "github.com/Denisz0785/spaceyard/shared/pkg/openapi/order/v2"
+)
+
+// Конвертеры API v2: суммы передаются точными десятичными строками, а не числами double.
+
+func OrderInfoV2ToModel(req *orderv2.CreateOrderRequest) *model.CreateOrderInfo {
+	return &model.CreateOrderInfo{
+		UserUUID:  req.UserUUID,
+		PartUuids: req.PartUuids,
+	}
+}
+
+func ModelToCreateOrderResponseV2(resp model.CreateOrderResponse) *orderv2.CreateOrderResponse {
+	return &orderv2.CreateOrderResponse{
+		OrderUUID:  resp.OrderUUID,
+		TotalPrice: DecimalToV2(resp.TotalPrice),
+		Currency:   resp.TotalPrice.CurrencyCode,
+	}
+}
+
+func ModelToOrderV2(o model.Order) *orderv2.Order {
+	order := &orderv2.Order{
+		OrderUUID:     o.OrderUUID,
+		UserUUID:      o.UserUUID,
+		PartUuids:     o.PartUuids,
+		TotalPrice:    DecimalToV2(o.TotalPrice),
+		Currency:      o.TotalPrice.CurrencyCode,
+		TotalMassKg:   orderv2.NewOptFloat64(o.Physical.TotalMassKg),
+		TotalVolumeM3: orderv2.NewOptFloat64(o.Physical.TotalVolumeM3),
+		Status:        orderv2.OrderStatus(o.Status),
+	}
+	if o.TransactionUUID != nil {
+		order.TransactionUUID = orderv2.NewOptNilUUID(*o.TransactionUUID)
+	}
+	if o.PaymentMethod != nil {
+		order.PaymentMethod = orderv2.NewOptPaymentMethod(orderv2.PaymentMethod(*o.PaymentMethod))
+	}
+
+	return order
+}
+
+func DisplayPriceToOrderV2(price model.DisplayPrice) orderv2.OptDisplayPrice {
+	result := orderv2.DisplayPrice{
+		TotalPrice:   DecimalToV2(price.TotalPrice),
+		Currency:     price.TotalPrice.CurrencyCode,
+		ExchangeRate: price.ExchangeRate,
+	}
+	if !price.RateEffectiveFrom.IsZero() {
+		result.RateEffectiveFrom = orderv2.NewOptDate(price.RateEffectiveFrom)
+	}
+	return orderv2.NewOptDisplayPrice(result)
+}
+
+func ModelToReceiptV2(r model.Receipt) *orderv2.Receipt {
+	items := make([]orderv2.ReceiptItem, 0, len(r.Items))
+	for _, item := range r.Items {
+		result := orderv2.ReceiptItem{
+			Name:               item.Name,
+			Quantity:           int32(item.Quantity), // #nosec G115 -- количество приходит из int32
+			UnitPrice:          DecimalToV2(item.UnitPrice),
+			Amount:             DecimalToV2(item.Amount),
+			TaxRateBasisPoints: item.TaxRateBasisPoints,
+			Tax:                DecimalToV2(item.Tax),
+		}
+		if item.SKU != "" {
+			result.Sku = orderv2.NewOptString(item.SKU)
+		}
+		items = append(items, result)
+	}
+
+	receipt := &orderv2.Receipt{
+		ReceiptUUID:     r.UUID,
+		OrderUUID:       r.OrderUUID,
+		TransactionUUID: r.TransactionUUID,
+		PaymentMethod:   orderv2.PaymentMethod(r.PaymentMethod),
+		Items:           items,
+		TotalPrice:      DecimalToV2(r.Total),
+		TaxTotal:        DecimalToV2(r.TaxTotal),
+		Currency:        r.Total.CurrencyCode,
+		PaidAt:          r.PaidAt,
+		HTML:            r.HTML,
+		Text:            r.Text,
+	}
+	if r.MaskedCardNumber != "" {
+		receipt.MaskedCardNumber = orderv2.NewOptString(r.MaskedCardNumber)
+	}
+	if r.CreditAmount.IsPositive() {
+		receipt.CreditAmount = orderv2.NewOptDecimal(DecimalToV2(r.CreditAmount))
+	}
+
+	return receipt
+}
+
+// DecimalToV2 записывает сумму десятичной строкой, например "1234.50".
+func DecimalToV2(amount money.Money) orderv2.Decimal {
+	return orderv2.Decimal(amount.Decimal())
+}
+
+func PaymentMethodV2ToModel(method orderv2.PaymentMethod) model.PaymentMethod {
+	return model.PaymentMethod(method)
+}
+
+// PaymentBlockedV2FromError описывает для клиента API v2 блокировку оплаты антифродом.
+func ModelToPayOrderResponseV2(payment model.OrderPayment) *orderv2.PayOrderResponse {
+	resp := &orderv2.PayOrderResponse{
+		TransactionUUID: payment.TransactionUUID,
+	}
+	if payment.SBP != nil {
+		resp.SbpPayment = orderv2.NewOptSbpPayment(orderv2.SbpPayment{
+			Payload:            payment.SBP.Payload,
+			QrImage:            payment.SBP.QRImage,
+			QrImageContentType: payment.SBP.QRImageContentType,
+			ExpiresAt:          payment.SBP.ExpiresAt,
+		})
+	}
+
+	return resp
+}
+
+func PaymentBlockedV2FromError(err error) *orderv2.PaymentBlocked {
+	result := &orderv2.PaymentBlocked{Reason: orderv2.FraudReasonUNKNOWN}
+
+	var remoteErr *model.RemoteError
+	if !errors.As(err, &remoteErr) {
+		return result
+	}
+
+	reason := orderv2.FraudReason(strings.TrimPrefix(remoteErr.Metadata["fraud_reason"], "FRAUD_REASON_"))
+	if reason.Validate() == nil {
+		result.Reason = reason
+	}
+	if rule := remoteErr.Metadata["rule"]; rule != "" {
+		result.Rule = orderv2.NewOptString(rule)
+	}
+
+	return result
+}
@@ -1,9 +1,71 @@
 package model
 
-import "github.com/go-faster/errors"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-faster/errors"
+)
 
 var (
 	ErrOrderNotFound = errors.New("Order is not found")
 	ErrCancelOrder   = errors.New("Error cancel order")
 	ErrUpdateOrder   = errors.New("Error update order")
+	ErrPayOrder      = errors.New("Order cannot be paid")
+	// ErrAlreadyRefunded — по транзакции заказа уже нечего возвращать.
+	ErrAlreadyRefunded = errors.New("Payment is already refunded")
+	// ErrPaymentDeclined — платёжный провайдер отказал в оплате.
+	ErrPaymentDeclined = errors.New("Payment is declined")
+	// ErrPaymentBlocked — антифрод платёжного сервиса заблокировал оплату.
+	ErrPaymentBlocked = errors.New("Payment is blocked")
+	// ErrUnsupportedCurrency — для валюты нет действующего курса в таблице курсов.
+	ErrUnsupportedCurrency = errors.New("Currency is not supported")
+	// ErrReceiptNotFound — заказ не оплачен, поэтому чека по нему нет.
+	ErrReceiptNotFound = errors.New("Receipt is not found")
+
+	// Ошибки внешних сервисов, к которым сводятся gRPC-статусы.
+	ErrNotFound           = errors.New("Resource is not found")
+	ErrPartNotFound       = errors.New("Part is not found")
+	ErrInvalidArgument    = errors.New("Invalid argument")
+	ErrPermissionDenied   = errors.New("Permission denied")
+	ErrConflict           = errors.New("Conflict with current state")
+	ErrServiceUnavailable = errors.New("Service is unavailable")
 )
+
+// FieldViolation описывает нарушение для конкретного поля запроса (google.rpc.BadRequest).
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// RemoteError — ошибка внешнего сервиса, разобранная из gRPC-статуса и его деталей.
+// Kind содержит одну из ошибок выше, поэтому для неё работает errors.Is.
+type RemoteError struct {
+	Kind   error
+	Domain string
+	Reason string
+	// Metadata — метаданные google.rpc.ErrorInfo, например причина блокировки оплаты.
+	Metadata   map[string]string
+	Message    string
+	Resource   string
+	Violations []FieldViolation
+}
+
+func (e *RemoteError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Kind.Error())
+	if e.Reason != "" {
+		fmt.Fprintf(&sb, " [%s/%s]", e.Domain, e.Reason)
+	}
+	if e.Message != "" {
+		sb.WriteString(": " + e.Message)
+	}
+	for _, v := range e.Violations {
+		fmt.Fprintf(&sb, "; %s: %s", v.Field, v.Description)
+	}
+	return sb.String()
+}
+
+func (e *RemoteError) Unwrap() error {
+	return e.Kind
+}
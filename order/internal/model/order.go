@@ -1,7 +1,11 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
 )
 
 type OrderStatus string
@@ -10,18 +14,49 @@ const (
 	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	// OrderStatusDISPUTED — банк покупателя опротестовал оплату, заказ ждёт решения по спору.
+	OrderStatusDISPUTED OrderStatus = "DISPUTED"
+	// OrderStatusCHARGEDBACK — спор проигран и банк вернул покупателю всю оплату.
+	OrderStatusCHARGEDBACK OrderStatus = "CHARGED_BACK"
 )
 
+type PaymentMethod string
+
+const (
+	PaymentMethodUNKNOWN       PaymentMethod = "UNKNOWN"
+	PaymentMethodCARD          PaymentMethod = "CARD"
+	PaymentMethodSBP           PaymentMethod = "SBP"
+	PaymentMethodCREDITCARD    PaymentMethod = "CREDIT_CARD"
+	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
+)
+
+// OrderCurrency — валюта суммы новых заказов. Цены деталей в других валютах
+// пересчитываются в неё по таблице курсов.
+const OrderCurrency = "RUB"
+
 type Order struct {
-	OrderUUID       uuid.UUID
-	UserUUID        uuid.UUID
-	PartUuids       []uuid.UUID
-	TotalPrice      float64
+	OrderUUID uuid.UUID
+	UserUUID  uuid.UUID
+	PartUuids []uuid.UUID
+	// TotalPrice — сумма заказа, в её валюте заказ оплачивается.
+	TotalPrice money.Money
+	// Items — детали заказа с ценами, из которых сложилась TotalPrice. Они передаются
+	// в PaymentService строками чека.
+	Items []OrderItem
+	// Physical — масса и объём деталей, посчитанные InventoryService при создании заказа.
+	Physical        PhysicalSummary
 	TransactionUUID *uuid.UUID
-	PaymentMethod   *string
+	PaymentMethod   *PaymentMethod
 	Status          OrderStatus
 }
 
+// OrderItem — деталь заказа по цене на момент создания заказа в его валюте.
+type OrderItem struct {
+	PartUUID uuid.UUID
+	Name     string
+	Price    money.Money
+}
+
 type CreateOrderInfo struct {
 	UserUUID  uuid.UUID
 	PartUuids []uuid.UUID
@@ -29,5 +64,14 @@ type CreateOrderInfo struct {
 
 type CreateOrderResponse struct {
 	OrderUUID  uuid.UUID
-	TotalPrice float64
+	TotalPrice money.Money
+}
+
+// DisplayPrice — сумма заказа, пересчитанная в валюту отображения.
+type DisplayPrice struct {
+	TotalPrice money.Money
+	// ExchangeRate — сколько единиц валюты отображения стоит единица валюты заказа.
+	ExchangeRate string
+	// RateEffectiveFrom — начало действия курса, нулевое для валюты самого заказа.
+	RateEffectiveFrom time.Time
 }
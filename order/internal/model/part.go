@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
 
 type PartsFilter struct {
 	Uuids                 []string
@@ -19,6 +23,13 @@ type Dimensions struct {
 	Weight float64
 }
 
+// PhysicalSummary — суммарная масса и габаритный объём деталей в метрических единицах.
+// По ним оценивается стоимость запуска заказа.
+type PhysicalSummary struct {
+	TotalMassKg   float64
+	TotalVolumeM3 float64
+}
+
 type Manufacturer struct {
 	Name    string
 	Country string
@@ -36,7 +47,7 @@ type Part struct {
 	UUID          string
 	Name          string
 	Description   string
-	Price         float64
+	Price         money.Money
 	StockQuantity int64
 	Category      Category
 	Dimensions    Dimensions
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrderPayment — итог запроса на оплату заказа.
+type OrderPayment struct {
+	TransactionUUID uuid.UUID
+	// SBP заполнен, если заказ оплачивается по QR-коду и деньги ещё не переведены.
+	SBP *SBPPayment
+}
+
+// SBPPayment — платёж СБП, ожидающий перевода: покупатель сканирует QR-код
+// и подтверждает оплату в приложении банка.
+type SBPPayment struct {
+	TransactionUUID uuid.UUID
+	// Payload — ссылка НСПК, закодированная в QR-коде.
+	Payload            string
+	QRImage            []byte
+	QRImageContentType string
+	ExpiresAt          time.Time
+}
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/google/uuid"
+
+type PaymentEventType string
+
+const (
+	PaymentEventTypeCAPTURED      PaymentEventType = "CAPTURED"
+	PaymentEventTypeDISPUTEOPENED PaymentEventType = "DISPUTE_OPENED"
+	PaymentEventTypeDISPUTEWON    PaymentEventType = "DISPUTE_WON"
+	PaymentEventTypeDISPUTELOST   PaymentEventType = "DISPUTE_LOST"
+)
+
+// PaymentEvent — событие потока PaymentService, на которое заказ меняет статус.
+type PaymentEvent struct {
+	// Sequence — номер события, с него поток продолжается после переподключения.
+	Sequence        int64
+	Type            PaymentEventType
+	TransactionUUID uuid.UUID
+	OrderUUID       uuid.UUID
+	// TransactionStatus — статус транзакции после события.
+	TransactionStatus TransactionStatus
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// Receipt — чек об оплате заказа, выписанный PaymentService при списании.
+type Receipt struct {
+	UUID             uuid.UUID
+	OrderUUID        uuid.UUID
+	TransactionUUID  uuid.UUID
+	PaymentMethod    PaymentMethod
+	MaskedCardNumber string
+	Items            []ReceiptItem
+	// Total — сумма, списанная при оплате, CreditAmount — остаток, который спишут
+	// платежи рассрочки, TaxTotal — НДС, включённый в стоимость позиций.
+	Total        money.Money
+	CreditAmount money.Money
+	TaxTotal     money.Money
+	PaidAt       time.Time
+	// HTML и Text — чек, отрисованный самостоятельным HTML-документом и простым текстом.
+	HTML string
+	Text string
+}
+
+// ReceiptItem — строка чека.
+type ReceiptItem struct {
+	SKU                string
+	Name               string
+	Quantity           int
+	UnitPrice          money.Money
+	Amount             money.Money
+	TaxRateBasisPoints int64
+	Tax                money.Money
+}
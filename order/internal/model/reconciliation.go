@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type TransactionStatus string
+
+const (
+	TransactionStatusPAID              TransactionStatus = "PAID"
+	TransactionStatusPARTIALLYREFUNDED TransactionStatus = "PARTIALLY_REFUNDED"
+	TransactionStatusCHARGEDBACK       TransactionStatus = "CHARGED_BACK"
+)
+
+// PaymentTransaction — транзакция PaymentService, как её видит сверка.
+type PaymentTransaction struct {
+	UUID      uuid.UUID
+	OrderUUID uuid.UUID
+	Status    TransactionStatus
+	// Amount — списанная или удерживаемая сумма.
+	Amount     money.Money
+	CreatedAt  time.Time
+	CapturedAt time.Time
+}
+
+// IsCharged сообщает, что по транзакции у покупателя списаны деньги и они не возвращены полностью.
+func (t PaymentTransaction) IsCharged() bool {
+	return t.Status == TransactionStatusPAID || t.Status == TransactionStatusPARTIALLYREFUNDED
+}
+
+type ReconciliationIssueKind string
+
+const (
+	// ReconciliationIssuePaidOrderWithoutTransaction — оплаченный заказ без списанной транзакции.
+	ReconciliationIssuePaidOrderWithoutTransaction ReconciliationIssueKind = "PAID_ORDER_WITHOUT_TRANSACTION"
+	// ReconciliationIssueTransactionWithoutOrder — списанная транзакция, на которую не ссылается оплаченный заказ.
+	ReconciliationIssueTransactionWithoutOrder ReconciliationIssueKind = "TRANSACTION_WITHOUT_ORDER"
+	// ReconciliationIssueAmountMismatch — сумма транзакции не совпадает с суммой заказа.
+	ReconciliationIssueAmountMismatch ReconciliationIssueKind = "AMOUNT_MISMATCH"
+	// ReconciliationIssueDuplicateCharge — ещё одна списанная транзакция того же заказа.
+	ReconciliationIssueDuplicateCharge ReconciliationIssueKind = "DUPLICATE_CHARGE"
+)
+
+// ReconciliationIssue — расхождение между заказом и транзакцией. Пустые поля не относятся к расхождению.
+type ReconciliationIssue struct {
+	Kind              ReconciliationIssueKind
+	OrderUUID         uuid.UUID
+	TransactionUUID   *uuid.UUID
+	TransactionStatus TransactionStatus
+	OrderAmount       string
+	TransactionAmount string
+	Currency          string
+	Details           string
+}
+
+// ReconciliationReport — результат сверки оплаченных заказов с транзакциями PaymentService.
+type ReconciliationReport struct {
+	GeneratedAt time.Time
+	// TrackedSince — с какого момента сервис знает о заказах. Транзакции, созданные раньше,
+	// не проверяются на наличие заказа.
+	TrackedSince time.Time
+	// PaidOrders и Transactions — сколько заказов и транзакций участвовало в сверке.
+	PaidOrders   int
+	Transactions int
+	Issues       []ReconciliationIssue
+}
@@ -0,0 +1,123 @@
+package reconciliation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+)
+
+// Compare сверяет оплаченные заказы paidOrders с транзакциями transactions, перечисленными
+// в порядке создания. Списанная транзакция считается лишней только если она создана не раньше
+// trackedSince и списана раньше settledBefore. Заказы хранятся в памяти и теряются при
+// перезапуске, поэтому транзакции, созданные до trackedSince, сверять не с чем. А заказ,
+// оплаченный прямо во время сверки, мог ещё не получить статус PAID.
+func Compare(
+	paidOrders []model.Order,
+	transactions []model.PaymentTransaction,
+	trackedSince, settledBefore time.Time,
+) []model.ReconciliationIssue {
+	byUUID := make(map[uuid.UUID]model.PaymentTransaction, len(transactions))
+	charged := make(map[uuid.UUID][]model.PaymentTransaction)
+	for _, transaction := range transactions {
+		byUUID[transaction.UUID] = transaction
+		if transaction.IsCharged() {
+			charged[transaction.OrderUUID] = append(charged[transaction.OrderUUID], transaction)
+		}
+	}
+
+	var issues []model.ReconciliationIssue
+
+	// paidBy — транзакции, на которые ссылаются оплаченные заказы.
+	paidBy := make(map[uuid.UUID]model.Order, len(paidOrders))
+	for _, order := range paidOrders {
+		if order.TransactionUUID != nil {
+			paidBy[*order.TransactionUUID] = order
+		}
+		issues = append(issues, compareOrder(order, byUUID)...)
+	}
+
+	for _, transaction := range transactions {
+		if !transaction.IsCharged() || transaction.CreatedAt.Before(trackedSince) ||
+			!transaction.CapturedAt.Before(settledBefore) {
+			continue
+		}
+		if _, ok := paidBy[transaction.UUID]; ok {
+			continue
+		}
+
+		issue := transactionIssue(transaction)
+		if kept := keptTransaction(charged[transaction.OrderUUID], paidBy); kept.UUID != transaction.UUID {
+			issue.Kind = model.ReconciliationIssueDuplicateCharge
+			issue.Details = fmt.Sprintf("order is also charged by transaction %s", kept.UUID)
+		} else {
+			issue.Kind = model.ReconciliationIssueTransactionWithoutOrder
+			issue.Details = "no paid order refers to the transaction"
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// compareOrder проверяет, что транзакция оплаченного заказа списана на сумму заказа.
+func compareOrder(order model.Order, transactions map[uuid.UUID]model.PaymentTransaction) []model.ReconciliationIssue {
+	issue := model.ReconciliationIssue{
+		Kind:            model.ReconciliationIssuePaidOrderWithoutTransaction,
+		OrderUUID:       order.OrderUUID,
+		TransactionUUID: order.TransactionUUID,
+		OrderAmount:     order.TotalPrice.Decimal(),
+		Currency:        order.TotalPrice.CurrencyCode,
+	}
+
+	if order.TransactionUUID == nil {
+		issue.Details = "order has no transaction"
+		return []model.ReconciliationIssue{issue}
+	}
+
+	transaction, ok := transactions[*order.TransactionUUID]
+	if !ok {
+		issue.Details = "transaction is not found in PaymentService"
+		return []model.ReconciliationIssue{issue}
+	}
+
+	issue.TransactionStatus = transaction.Status
+	issue.TransactionAmount = transaction.Amount.Decimal()
+	issue.Currency = transaction.Amount.CurrencyCode
+	switch {
+	case transaction.OrderUUID != order.OrderUUID:
+		issue.Details = fmt.Sprintf("transaction belongs to order %s", transaction.OrderUUID)
+	case !transaction.IsCharged():
+		issue.Details = fmt.Sprintf("transaction is %s", transaction.Status)
+	case transaction.Amount.CurrencyCode != order.TotalPrice.CurrencyCode || transaction.Amount.Cmp(order.TotalPrice) != 0:
+		issue.Kind = model.ReconciliationIssueAmountMismatch
+		issue.Details = fmt.Sprintf("order total is %s, transaction amount is %s", order.TotalPrice, transaction.Amount)
+	default:
+		return nil
+	}
+
+	return []model.ReconciliationIssue{issue}
+}
+
+// keptTransaction выбирает из списанных транзакций заказа ту, что считается настоящей оплатой:
+// транзакцию оплаченного заказа, а если заказ на неё не ссылается — самую раннюю.
+func keptTransaction(charged []model.PaymentTransaction, paidBy map[uuid.UUID]model.Order) model.PaymentTransaction {
+	for _, transaction := range charged {
+		if _, ok := paidBy[transaction.UUID]; ok {
+			return transaction
+		}
+	}
+	return charged[0]
+}
+
+func transactionIssue(transaction model.PaymentTransaction) model.ReconciliationIssue {
+	return model.ReconciliationIssue{
+		OrderUUID:         transaction.OrderUUID,
+		TransactionUUID:   &transaction.UUID,
+		TransactionStatus: transaction.Status,
+		TransactionAmount: transaction.Amount.Decimal(),
+		Currency:          transaction.Amount.CurrencyCode,
+	}
+}
@@ -0,0 +1,139 @@
+package reconciliation
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func TestCompare(t *testing.T) {
+	now := time.Now()
+	trackedSince, settledBefore := now.Add(-time.Hour), now.Add(-time.Minute)
+	orderUUID := uuid.New()
+	price := money.Money{CurrencyCode: "RUB", Units: 450}
+
+	// transaction — транзакция заказа orderUUID, созданная и списанная в окне сверки.
+	transaction := func(status model.TransactionStatus, amount money.Money) model.PaymentTransaction {
+		return model.PaymentTransaction{
+			UUID:       uuid.New(),
+			OrderUUID:  orderUUID,
+			Status:     status,
+			Amount:     amount,
+			CreatedAt:  now.Add(-30 * time.Minute),
+			CapturedAt: now.Add(-30 * time.Minute),
+		}
+	}
+	paidBy := func(transaction model.PaymentTransaction) model.Order {
+		return model.Order{OrderUUID: orderUUID, TotalPrice: price, TransactionUUID: &transaction.UUID}
+	}
+
+	paid := transaction(model.TransactionStatusPAID, price)
+	refunded := transaction(model.TransactionStatusPARTIALLYREFUNDED, price)
+	chargedBack := transaction(model.TransactionStatusCHARGEDBACK, price)
+	underpaid := transaction(model.TransactionStatusPAID, money.Money{CurrencyCode: "RUB", Units: 449})
+	otherCurrency := transaction(model.TransactionStatusPAID, money.Money{CurrencyCode: "USD", Units: 450})
+	untracked := transaction(model.TransactionStatusPAID, price)
+	untracked.CreatedAt = trackedSince.Add(-time.Second)
+	settling := transaction(model.TransactionStatusPAID, price)
+	settling.CapturedAt = settledBefore
+	duplicate := transaction(model.TransactionStatusPAID, price)
+	duplicate.CreatedAt = paid.CreatedAt.Add(-time.Second)
+
+	tests := []struct {
+		name         string
+		paidOrders   []model.Order
+		transactions []model.PaymentTransaction
+		want         []model.ReconciliationIssueKind
+	}{
+		{
+			name:         "matched",
+			paidOrders:   []model.Order{paidBy(paid)},
+			transactions: []model.PaymentTransaction{paid},
+		},
+		{
+			name:         "partially refunded still matches",
+			paidOrders:   []model.Order{paidBy(refunded)},
+			transactions: []model.PaymentTransaction{refunded},
+		},
+		{
+			name:       "order without transaction",
+			paidOrders: []model.Order{{OrderUUID: orderUUID, TotalPrice: price}},
+			want:       []model.ReconciliationIssueKind{model.ReconciliationIssuePaidOrderWithoutTransaction},
+		},
+		{
+			name:       "transaction unknown to payment",
+			paidOrders: []model.Order{paidBy(paid)},
+			want:       []model.ReconciliationIssueKind{model.ReconciliationIssuePaidOrderWithoutTransaction},
+		},
+		{
+			name:         "transaction charged back",
+			paidOrders:   []model.Order{paidBy(chargedBack)},
+			transactions: []model.PaymentTransaction{chargedBack},
+			want:         []model.ReconciliationIssueKind{model.ReconciliationIssuePaidOrderWithoutTransaction},
+		},
+		{
+			name:         "amount mismatch",
+			paidOrders:   []model.Order{paidBy(underpaid)},
+			transactions: []model.PaymentTransaction{underpaid},
+			want:         []model.ReconciliationIssueKind{model.ReconciliationIssueAmountMismatch},
+		},
+		{
+			name:         "currency mismatch",
+			paidOrders:   []model.Order{paidBy(otherCurrency)},
+			transactions: []model.PaymentTransaction{otherCurrency},
+			want:         []model.ReconciliationIssueKind{model.ReconciliationIssueAmountMismatch},
+		},
+		{
+			name:         "transaction without order",
+			transactions: []model.PaymentTransaction{paid},
+			want:         []model.ReconciliationIssueKind{model.ReconciliationIssueTransactionWithoutOrder},
+		},
+		{
+			// Заказы до trackedSince потеряны при перезапуске, сверять не с чем.
+			name:         "created before tracking",
+			transactions: []model.PaymentTransaction{untracked},
+		},
+		{
+			// Заказ мог ещё не получить статус PAID.
+			name:         "captured during reconciliation",
+			transactions: []model.PaymentTransaction{settling},
+		},
+		{
+			name:         "duplicate charge",
+			paidOrders:   []model.Order{paidBy(paid)},
+			transactions: []model.PaymentTransaction{duplicate, paid},
+			want:         []model.ReconciliationIssueKind{model.ReconciliationIssueDuplicateCharge},
+		},
+		{
+			// Без оплаченного заказа настоящей оплатой считается самая ранняя транзакция.
+			name:         "duplicate charge without order",
+			transactions: []model.PaymentTransaction{duplicate, paid},
+			want: []model.ReconciliationIssueKind{
+				model.ReconciliationIssueTransactionWithoutOrder,
+				model.ReconciliationIssueDuplicateCharge,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := Compare(tt.paidOrders, tt.transactions, trackedSince, settledBefore)
+
+			var got []model.ReconciliationIssueKind
+			for _, issue := range issues {
+				if issue.OrderUUID != orderUUID {
+					t.Fatalf("issue %s refers to order %s, want %s", issue.Kind, issue.OrderUUID, orderUUID)
+				}
+				got = append(got, issue.Kind)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package reconciliation
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+)
+
+// fileTimeLayout — время сверки в именах файлов отчёта, например reconciliation-20260101T030000Z.json.
+const fileTimeLayout = "20060102T150405Z"
+
+// csvHeader — колонки CSV-отчёта, по строке на расхождение.
+var csvHeader = []string{
+	"kind", "order_uuid", "transaction_uuid", "transaction_status",
+	"order_amount", "transaction_amount", "currency", "details",
+}
+
+type jsonReport struct {
+	GeneratedAt  time.Time   `json:"generated_at"`
+	TrackedSince time.Time   `json:"tracked_since"`
+	PaidOrders   int         `json:"paid_orders"`
+	Transactions int         `json:"transactions"`
+	Issues       []jsonIssue `json:"issues"`
+}
+
+type jsonIssue struct {
+	Kind              string `json:"kind"`
+	OrderUUID         string `json:"order_uuid"`
+	TransactionUUID   string `json:"transaction_uuid,omitempty"`
+	TransactionStatus string `json:"transaction_status,omitempty"`
+	OrderAmount       string `json:"order_amount,omitempty"`
+	TransactionAmount string `json:"transaction_amount,omitempty"`
+	Currency          string `json:"currency,omitempty"`
+	Details           string `json:"details"`
+}
+
+// WriteJSON записывает отчёт в w в формате JSON.
+func WriteJSON(w io.Writer, report model.ReconciliationReport) error {
+	result := jsonReport{
+		GeneratedAt:  report.GeneratedAt.UTC(),
+		TrackedSince: report.TrackedSince.UTC(),
+		PaidOrders:   report.PaidOrders,
+		Transactions: report.Transactions,
+		Issues:       make([]jsonIssue, 0, len(report.Issues)),
+	}
+	for _, issue := range report.Issues {
+		row := issueRow(issue)
+		result.Issues = append(result.Issues, jsonIssue{
+			Kind:              row[0],
+			OrderUUID:         row[1],
+			TransactionUUID:   row[2],
+			TransactionStatus: row[3],
+			OrderAmount:       row[4],
+			TransactionAmount: row[5],
+			Currency:          row[6],
+			Details:           row[7],
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// WriteCSV записывает расхождения отчёта в w в формате CSV с заголовком csvHeader.
+func WriteCSV(w io.Writer, report model.ReconciliationReport) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, issue := range report.Issues {
+		if err := writer.Write(issueRow(issue)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Save записывает отчёт в каталог dir в формате JSON и CSV и возвращает пути файлов.
+func Save(dir string, report model.ReconciliationReport) (jsonPath, csvPath string, err error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", "", fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	name := "reconciliation-" + report.GeneratedAt.UTC().Format(fileTimeLayout)
+	jsonPath = filepath.Join(dir, name+".json")
+	csvPath = filepath.Join(dir, name+".csv")
+
+	if err := saveFile(jsonPath, report, WriteJSON); err != nil {
+		return "", "", err
+	}
+	if err := saveFile(csvPath, report, WriteCSV); err != nil {
+		return "", "", err
+	}
+
+	return jsonPath, csvPath, nil
+}
+
+// saveFile записывает отчёт во временный файл и переименовывает его в path,
+// чтобы читатель каталога не увидел наполовину записанный отчёт.
+func saveFile(path string, report model.ReconciliationReport, write func(io.Writer, model.ReconciliationReport) error) error {
+	var buf bytes.Buffer
+	if err := write(&buf, report); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// issueRow возвращает значения расхождения в порядке csvHeader.
+func issueRow(issue model.ReconciliationIssue) []string {
+	transactionUUID := ""
+	if issue.TransactionUUID != nil {
+		transactionUUID = issue.TransactionUUID.String()
+	}
+
+	return []string{
+		string(issue.Kind),
+		issue.OrderUUID.String(),
+		transactionUUID,
+		string(issue.TransactionStatus),
+		issue.OrderAmount,
+		issue.TransactionAmount,
+		issue.Currency,
+		issue.Details,
+	}
+}
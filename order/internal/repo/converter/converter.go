@@ -14,6 +14,8 @@ func OrderToRepoOrder(o *model.Order) *repoModel.Order {
 		UserUUID:        o.UserUUID,
 		PartUuids:       o.PartUuids,
 		TotalPrice:      o.TotalPrice,
+		Items:           o.Items,
+		Physical:        o.Physical,
 		TransactionUUID: o.TransactionUUID,
 		PaymentMethod:   o.PaymentMethod,
 		Status:          o.Status,
@@ -30,6 +32,8 @@ func RepoOrderToModel(o *repoModel.Order) *model.Order {
 		UserUUID:        o.UserUUID,
 		PartUuids:       o.PartUuids,
 		TotalPrice:      o.TotalPrice,
+		Items:           o.Items,
+		Physical:        o.Physical,
 		TransactionUUID: o.TransactionUUID,
 		PaymentMethod:   o.PaymentMethod,
 		Status:          o.Status,
@@ -3,14 +3,18 @@ package model
 import (
 	"github.com/Denisz0785/spaceyard/order/internal/model"
 	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
 )
 
 type Order struct {
 	OrderUUID       uuid.UUID
 	UserUUID        uuid.UUID
 	PartUuids       []uuid.UUID
-	TotalPrice      float64
+	TotalPrice      money.Money
+	Items           []model.OrderItem
+	Physical        model.PhysicalSummary
 	TransactionUUID *uuid.UUID
-	PaymentMethod   *string
+	PaymentMethod   *model.PaymentMethod
 	Status          model.OrderStatus
 }
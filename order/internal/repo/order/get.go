@@ -3,21 +3,20 @@ package order
 import (
 	"context"
 
-	"github.com/Denisz0785/spaceyard/order/internal/model"
-	repoModel "github.com/Denisz0785/spaceyard/order/internal/repo/model"
 	"github.com/google/uuid"
-)
 
-func (s *storage) Get(ctx context.Context, uuid uuid.UUID) (repoModel.Order, error) {
-	orderUUID := uuid.String()
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/order/internal/repo/converter"
+)
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *storage) Get(_ context.Context, orderUUID uuid.UUID) (model.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	order, ok := s.orders[orderUUID]
+	order, ok := s.orders[orderUUID.String()]
 	if !ok {
-		return nil, model.ErrOrderNotFound
+		return model.Order{}, model.ErrOrderNotFound
 	}
 
-	return order, nil
+	return *converter.RepoOrderToModel(order), nil
 }
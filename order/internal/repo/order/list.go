@@ -0,0 +1,28 @@
+package order
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/order/internal/repo/converter"
+)
+
+func (s *storage) List(_ context.Context, statuses ...model.OrderStatus) ([]model.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	orders := make([]model.Order, 0)
+	for _, order := range s.orders {
+		if slices.Contains(statuses, order.Status) {
+			orders = append(orders, *converter.RepoOrderToModel(order))
+		}
+	}
+
+	slices.SortFunc(orders, func(a, b model.Order) int {
+		return strings.Compare(a.OrderUUID.String(), b.OrderUUID.String())
+	})
+
+	return orders, nil
+}
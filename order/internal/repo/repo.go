@@ -9,6 +9,8 @@ import (
 
 type OrderRepository interface {
 	Create(ctx context.Context, order *model.Order) (uuid.UUID, error)
-	Get(ctx context.Context, uuuid uuid.UUID) (model.Order, error)
+	Get(ctx context.Context, orderUUID uuid.UUID) (model.Order, error)
 	Update(ctx context.Context, order *model.Order) error
+	// List возвращает заказы в любом из статусов statuses, упорядоченные по UUID.
+	List(ctx context.Context, statuses ...model.OrderStatus) ([]model.Order, error)
 }
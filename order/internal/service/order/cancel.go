@@ -2,24 +2,37 @@ package order
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
-	"github.com/Denisz0785/spaceyard/order/internal/model"
 	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
 )
 
 func (s *orderService) CancelOrder(ctx context.Context, orderUUID uuid.UUID) error {
-
 	order, err := s.repo.Get(ctx, orderUUID)
 	if err != nil {
 		return model.ErrOrderNotFound
 	}
 
-	// Contract: If an order is already PAID, it cannot be cancelled.
-	if order.Status == model.OrderStatusPAID {
+	switch order.Status {
+	case model.OrderStatusCANCELLED:
 		return model.ErrCancelOrder
+	case model.OrderStatusDISPUTED, model.OrderStatusCHARGEDBACK:
+		// Деньги по заказу вернёт или уже вернул банк покупателя по спору.
+		return fmt.Errorf("%w: payment of the order is disputed", model.ErrCancelOrder)
+	case model.OrderStatusPAID:
+		// Оплаченный заказ отменяется только после полного возврата денег.
+		if order.TransactionUUID == nil {
+			return fmt.Errorf("%w: paid order has no transaction", model.ErrCancelOrder)
+		}
+		err = s.paymentClient.RefundPayment(ctx, *order.TransactionUUID)
+		if err != nil && !errors.Is(err, model.ErrAlreadyRefunded) {
+			return fmt.Errorf("failed to refund order: %w", err)
+		}
 	}
 
-	// If the order is PENDING_PAYMENT, update its status to CANCELLED.
 	order.Status = model.OrderStatusCANCELLED
 
 	err = s.repo.Update(ctx, &order)
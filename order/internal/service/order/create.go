@@ -3,8 +3,12 @@ package order
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
 )
 
 func (s *orderService) CreateOrder(ctx context.Context, orderInfo *model.CreateOrderInfo) (*model.CreateOrderResponse, error) {
@@ -21,33 +25,68 @@ func (s *orderService) CreateOrder(ctx context.Context, orderInfo *model.CreateO
 
 	// 2. Validate that all requested parts were found
 	if len(inventoryResp) != len(orderInfo.PartUuids) {
-		return nil, fmt.Errorf("one or more requested parts do not exist")
+		return nil, fmt.Errorf("one or more requested parts do not exist: %w", model.ErrPartNotFound)
 	}
 
 	// 3. Calculate total price
-	var totalPrice float64
-	for _, part := range inventoryResp {
-		totalPrice += part.Price
+	items, totalPrice, err := s.priceParts(inventoryResp, model.OrderCurrency, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Aggregate mass and volume for the launch-cost estimate
+	physical, err := s.inventoryClient.AggregatePhysicalProperties(ctx, partUUIDsStrings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate physical properties of parts: %w", err)
 	}
 
 	order := &model.Order{
 		UserUUID:        orderInfo.UserUUID,
 		PartUuids:       orderInfo.PartUuids,
 		TotalPrice:      totalPrice,
+		Items:           items,
+		Physical:        physical,
 		TransactionUUID: nil,
 		PaymentMethod:   nil,
 		Status:          model.OrderStatusPENDINGPAYMENT,
 	}
 
-	uuid, err := s.repo.Create(ctx, order)
+	orderUUID, err := s.repo.Create(ctx, order)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
 	resp := &model.CreateOrderResponse{
-		OrderUUID:  uuid,
+		OrderUUID:  orderUUID,
 		TotalPrice: order.TotalPrice,
 	}
 
 	return resp, nil
 }
+
+// priceParts возвращает детали с ценами в валюте orderCurrency и их сумму. Цена
+// в другой валюте пересчитывается по курсу на момент at и округляется до копеек
+// до сложения, поэтому сумма заказа равна сумме цен, которые видит покупатель.
+func (s *orderService) priceParts(parts []model.Part, orderCurrency string, at time.Time) ([]model.OrderItem, money.Money, error) {
+	items := make([]model.OrderItem, 0, len(parts))
+	total := money.Zero(orderCurrency)
+	for _, part := range parts {
+		conversion, err := s.rates.Convert(part.Price.Rat(), part.Price.CurrencyCode, orderCurrency, at)
+		if err != nil {
+			return nil, money.Money{}, fmt.Errorf("failed to convert price of part %s: %w: %w", part.UUID, model.ErrUnsupportedCurrency, err)
+		}
+		partUUID, err := uuid.Parse(part.UUID)
+		if err != nil {
+			return nil, money.Money{}, fmt.Errorf("inventory returned invalid part UUID %q: %w", part.UUID, err)
+		}
+		price := money.FromRat(orderCurrency, conversion.Amount)
+		items = append(items, model.OrderItem{
+			PartUUID: partUUID,
+			Name:     part.Name,
+			Price:    price,
+		})
+		total = total.Add(price)
+	}
+
+	return items, total, nil
+}
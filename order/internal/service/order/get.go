@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func (s *orderService) GetOrder(ctx context.Context, orderUUID uuid.UUID) (model.Order, error) {
+	order, err := s.repo.Get(ctx, orderUUID)
+	if err != nil {
+		return model.Order{}, err
+	}
+
+	return order, nil
+}
+
+// ConvertOrderTotal пересчитывает сумму заказа в валюту displayCurrency по курсу, действующему сейчас.
+func (s *orderService) ConvertOrderTotal(_ context.Context, order model.Order, displayCurrency string) (model.DisplayPrice, error) {
+	total := order.TotalPrice
+	conversion, err := s.rates.Convert(total.Rat(), total.CurrencyCode, displayCurrency, time.Now())
+	if err != nil {
+		return model.DisplayPrice{}, fmt.Errorf("%w: %w", model.ErrUnsupportedCurrency, err)
+	}
+
+	return model.DisplayPrice{
+		TotalPrice:        money.FromRat(displayCurrency, conversion.Amount),
+		ExchangeRate:      currency.FormatDecimal(conversion.Rate),
+		RateEffectiveFrom: conversion.EffectiveFrom,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+)
+
+// PayOrder оплачивает заказ в два шага: сначала удерживает сумму, затем, когда склад
+// подтвердил детали заказа, списывает её. Если детали не подтверждены или списание
+// не прошло, удержание снимается. Оплата СБП удержания не знает: заказ получает
+// QR-код и остаётся неоплаченным, пока покупатель не переведёт деньги.
+func (s *orderService) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (model.OrderPayment, error) {
+	order, err := s.repo.Get(ctx, orderUUID)
+	if err != nil {
+		return model.OrderPayment{}, err
+	}
+
+	// Оплатить можно только заказ, ожидающий оплаты.
+	if order.Status != model.OrderStatusPENDINGPAYMENT {
+		return model.OrderPayment{}, model.ErrPayOrder
+	}
+
+	if paymentMethod == model.PaymentMethodSBP {
+		return s.payOrderBySBP(ctx, order)
+	}
+
+	transactionUUID, err := s.paymentClient.AuthorizePayment(ctx, order.OrderUUID, order.UserUUID, paymentMethod, order.TotalPrice, order.Items)
+	if err != nil {
+		return model.OrderPayment{}, fmt.Errorf("failed to pay order: %w", err)
+	}
+
+	if err := s.confirmParts(ctx, order); err != nil {
+		if voidErr := s.paymentClient.VoidAuthorization(ctx, transactionUUID); voidErr != nil {
+			log.Printf("failed to void authorization %s: %v", transactionUUID, voidErr)
+		}
+		return model.OrderPayment{}, err
+	}
+
+	if err := s.paymentClient.CapturePayment(ctx, transactionUUID); err != nil {
+		// Заказ остаётся неоплаченным, поэтому удержание не должно висеть до истечения авторизации.
+		if voidErr := s.paymentClient.VoidAuthorization(ctx, transactionUUID); voidErr != nil {
+			log.Printf("failed to void authorization %s: %v", transactionUUID, voidErr)
+		}
+		return model.OrderPayment{}, fmt.Errorf("failed to pay order: %w", err)
+	}
+
+	order.Status = model.OrderStatusPAID
+	order.TransactionUUID = &transactionUUID
+	order.PaymentMethod = &paymentMethod
+
+	err = s.repo.Update(ctx, &order)
+	if err != nil {
+		return model.OrderPayment{}, model.ErrUpdateOrder
+	}
+
+	return model.OrderPayment{TransactionUUID: transactionUUID}, nil
+}
+
+// payOrderBySBP создаёт платёж СБП и запоминает его транзакцию в заказе. Заказ станет
+// оплаченным по событию CAPTURED, когда покупатель оплатит QR-код. Повторный запрос
+// возвращает тот же QR-код, пока он действует.
+func (s *orderService) payOrderBySBP(ctx context.Context, order model.Order) (model.OrderPayment, error) {
+	if err := s.confirmParts(ctx, order); err != nil {
+		return model.OrderPayment{}, err
+	}
+
+	payment, err := s.paymentClient.CreateSBPPaymentIntent(ctx, order.OrderUUID, order.UserUUID, order.TotalPrice, order.Items)
+	if err != nil {
+		return model.OrderPayment{}, fmt.Errorf("failed to pay order: %w", err)
+	}
+
+	paymentMethod := model.PaymentMethodSBP
+	order.TransactionUUID = &payment.TransactionUUID
+	order.PaymentMethod = &paymentMethod
+
+	if err := s.repo.Update(ctx, &order); err != nil {
+		return model.OrderPayment{}, model.ErrUpdateOrder
+	}
+
+	return model.OrderPayment{TransactionUUID: payment.TransactionUUID, SBP: &payment}, nil
+}
+
+// confirmParts проверяет, что все детали заказа всё ещё есть на складе.
+func (s *orderService) confirmParts(ctx context.Context, order model.Order) error {
+	partUUIDs := make([]string, len(order.PartUuids))
+	for i, u := range order.PartUuids {
+		partUUIDs[i] = u.String()
+	}
+
+	parts, err := s.inventoryClient.ListParts(ctx, partUUIDs)
+	if err != nil {
+		return fmt.Errorf("failed to confirm order parts: %w", err)
+	}
+	if len(parts) != len(order.PartUuids) {
+		return fmt.Errorf("one or more order parts are no longer available: %w", model.ErrPartNotFound)
+	}
+
+	return nil
+}
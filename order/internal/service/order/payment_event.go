@@ -0,0 +1,104 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+)
+
+// RunPaymentEventTracking следит за потоком событий PaymentService до отмены ctx: отмечает
+// оплаченными заказы, чей платёж СБП поступил, и заказы, оплату которых опротестовал банк
+// покупателя. После обрыва потока подписка возобновляется через retryInterval
+// с последнего обработанного события.
+func (s *orderService) RunPaymentEventTracking(ctx context.Context, retryInterval time.Duration) {
+	// Заказы хранятся в памяти, поэтому после перезапуска поток читается с начала.
+	var lastSequence int64
+
+	for {
+		err := s.paymentClient.SubscribePaymentEvents(ctx, lastSequence, func(event model.PaymentEvent) error {
+			if err := s.applyPaymentEvent(ctx, event); err != nil {
+				return err
+			}
+			lastSequence = event.Sequence
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("payment events subscription stopped after event %d: %v", lastSequence, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// applyPaymentEvent меняет статус заказа по событию оплаты или спора. События других заказов
+// и транзакций и повторно полученные события не меняют ничего.
+func (s *orderService) applyPaymentEvent(ctx context.Context, event model.PaymentEvent) error {
+	var from, to model.OrderStatus
+	switch event.Type {
+	case model.PaymentEventTypeCAPTURED:
+		// Списание по удержанию заказ отмечает сам, событие нужно платежам СБП.
+		from, to = model.OrderStatusPENDINGPAYMENT, model.OrderStatusPAID
+	case model.PaymentEventTypeDISPUTEOPENED:
+		from, to = model.OrderStatusPAID, model.OrderStatusDISPUTED
+	case model.PaymentEventTypeDISPUTEWON:
+		from, to = model.OrderStatusDISPUTED, model.OrderStatusPAID
+	case model.PaymentEventTypeDISPUTELOST:
+		// Спор на часть суммы оставляет заказ оплаченным.
+		from, to = model.OrderStatusDISPUTED, model.OrderStatusPAID
+		if event.TransactionStatus == model.TransactionStatusCHARGEDBACK {
+			to = model.OrderStatusCHARGEDBACK
+		}
+	default:
+		return nil
+	}
+
+	order, err := s.repo.Get(ctx, event.OrderUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return nil
+		}
+		return err
+	}
+	if order.TransactionUUID == nil || *order.TransactionUUID != event.TransactionUUID {
+		return nil
+	}
+	if event.Type == model.PaymentEventTypeCAPTURED && order.Status == model.OrderStatusCANCELLED {
+		return s.refundCancelledOrder(ctx, order)
+	}
+	if order.Status != from {
+		return nil
+	}
+
+	order.Status = to
+	if err := s.repo.Update(ctx, &order); err != nil {
+		return err
+	}
+
+	log.Printf("Статус заказа изменён событием оплаты, order_uuid: %s, status: %s", order.OrderUUID, order.Status)
+	return nil
+}
+
+// refundCancelledOrder возвращает деньги, если покупатель оплатил QR-код СБП уже после отмены заказа.
+func (s *orderService) refundCancelledOrder(ctx context.Context, order model.Order) error {
+	err := s.paymentClient.RefundPayment(ctx, *order.TransactionUUID)
+	switch {
+	case errors.Is(err, model.ErrServiceUnavailable):
+		// Событие обработается снова после переподключения.
+		return err
+	case err != nil && !errors.Is(err, model.ErrAlreadyRefunded):
+		// Повтор не поможет, а остановка потока задержала бы события других заказов.
+		log.Printf("failed to refund cancelled order %s: %v", order.OrderUUID, err)
+		return nil
+	}
+
+	log.Printf("Оплата отменённого заказа возвращена, order_uuid: %s, transaction_uuid: %s", order.OrderUUID, *order.TransactionUUID)
+	return nil
+}
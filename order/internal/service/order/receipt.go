@@ -0,0 +1,34 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+)
+
+// GetOrderReceipt возвращает чек, который PaymentService выписал при оплате заказа.
+// Чек остаётся доступным и после отмены оплаченного заказа.
+func (s *orderService) GetOrderReceipt(ctx context.Context, orderUUID uuid.UUID) (model.Receipt, error) {
+	order, err := s.repo.Get(ctx, orderUUID)
+	if err != nil {
+		return model.Receipt{}, err
+	}
+
+	if order.TransactionUUID == nil {
+		return model.Receipt{}, model.ErrReceiptNotFound
+	}
+
+	receipt, err := s.paymentClient.GetReceipt(ctx, *order.TransactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.Receipt{}, fmt.Errorf("%w: %w", model.ErrReceiptNotFound, err)
+		}
+		return model.Receipt{}, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,68 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
+	"github.com/Denisz0785/spaceyard/order/internal/reconciliation"
+)
+
+// reconciliationSettleWindow — сколько после списания транзакция может ждать, пока её заказ
+// получит статус PAID. Более свежие списания без заказа попадут в следующую сверку.
+const reconciliationSettleWindow = time.Minute
+
+// Reconcile сверяет оплаченные заказы с транзакциями PaymentService.
+func (s *orderService) Reconcile(ctx context.Context) (model.ReconciliationReport, error) {
+	now := time.Now()
+
+	// Транзакции читаются после заказов: заказ не может стать оплаченным без уже списанной транзакции.
+	// Пока спор не решён, деньги заказа остаются списанными, и он сверяется как оплаченный.
+	orders, err := s.repo.List(ctx, model.OrderStatusPAID, model.OrderStatusDISPUTED)
+	if err != nil {
+		return model.ReconciliationReport{}, err
+	}
+	transactions, err := s.paymentClient.ListTransactions(ctx)
+	if err != nil {
+		return model.ReconciliationReport{}, fmt.Errorf("failed to reconcile orders: %w", err)
+	}
+
+	return model.ReconciliationReport{
+		GeneratedAt:  now,
+		TrackedSince: s.startedAt,
+		PaidOrders:   len(orders),
+		Transactions: len(transactions),
+		Issues:       reconciliation.Compare(orders, transactions, s.startedAt, now.Add(-reconciliationSettleWindow)),
+	}, nil
+}
+
+// RunReconciliation раз в interval сверяет заказы с платежами и сохраняет отчёт в каталог dir
+// до отмены ctx.
+func (s *orderService) RunReconciliation(ctx context.Context, interval time.Duration, dir string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := s.Reconcile(ctx)
+			if err != nil {
+				log.Printf("failed to reconcile orders with payments: %v", err)
+				continue
+			}
+
+			jsonPath, csvPath, err := reconciliation.Save(dir, report)
+			if err != nil {
+				log.Printf("failed to save reconciliation report: %v", err)
+				continue
+			}
+
+			log.Printf("Сверка заказов с платежами: заказов %d, транзакций %d, расхождений %d, отчёт: %s, %s",
+				report.PaidOrders, report.Transactions, len(report.Issues), jsonPath, csvPath)
+		}
+	}
+}
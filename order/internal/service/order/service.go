@@ -1,11 +1,14 @@
 package order
 
 import (
+	"time"
+
 	inventoryv1 "github.com/Denisz0785/spaceyard/order/internal/client/grpc"
 	"github.com/Denisz0785/spaceyard/order/internal/repo"
 
 	paymentv1 "github.com/Denisz0785/spaceyard/order/internal/client/grpc"
 	def "github.com/Denisz0785/spaceyard/order/internal/service"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
 )
 
 var _ def.OrderService = (*orderService)(nil)
@@ -15,13 +18,24 @@ type orderService struct {
 
 	inventoryClient inventoryv1.InventoryClient
 	paymentClient   paymentv1.PaymentClient
+
+	// rates пересчитывает цены деталей в валюту заказа и суммы заказов в валюту отображения.
+	rates *currency.Rates
+
+	// startedAt — момент создания сервиса. Заказы живут в памяти, поэтому сверка не считает
+	// лишними транзакции, созданные до него.
+	startedAt time.Time
 }
 
-func NewOrderService(repo repo.OrderRepository, invClient inventoryv1.InventoryClient, payClient paymentv1.PaymentClient) *orderService {
+func NewOrderService(repo repo.OrderRepository, invClient inventoryv1.InventoryClient, payClient paymentv1.PaymentClient, rates *currency.Rates) *orderService {
 	return &orderService{
 		repo: repo,
 
 		inventoryClient: invClient,
 		paymentClient:   payClient,
+
+		rates: rates,
+
+		startedAt: time.Now(),
 	}
 }
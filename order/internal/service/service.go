@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
-	"github.com/Denisz0785/spaceyard/order/internal/model"
+
 	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/order/internal/model"
 )
 
 type OrderService interface {
 	CreateOrder(ctx context.Context, order *model.CreateOrderInfo) (*model.CreateOrderResponse, error)
-	GetOrder(ctx context.Context, orderUUID string) (*model.Order, error)
+	GetOrder(ctx context.Context, orderUUID uuid.UUID) (model.Order, error)
+	// ConvertOrderTotal пересчитывает сумму заказа в валюту отображения по действующему курсу.
+	ConvertOrderTotal(ctx context.Context, order model.Order, currency string) (model.DisplayPrice, error)
 	CancelOrder(ctx context.Context, orderUUID uuid.UUID) error
-	PayOrder(ctx context.Context, orderUUID string, paymentMethod string) error
+	PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod model.PaymentMethod) (model.OrderPayment, error)
+	// GetOrderReceipt возвращает чек об оплате заказа.
+	GetOrderReceipt(ctx context.Context, orderUUID uuid.UUID) (model.Receipt, error)
 }
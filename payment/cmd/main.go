@@ -0,0 +1,599 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	paymentApiV1 "github.com/Denisz0785/spaceyard/payment/internal/api/payment/v1"
+	"github.com/Denisz0785/spaceyard/payment/internal/fraud"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider"
+	investorProvider "github.com/Denisz0785/spaceyard/payment/internal/provider/investor"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider/simulator"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository"
+	cardRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/card"
+	disputeRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/dispute"
+	eventRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/event"
+	fraudRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/fraud"
+	idempotencyRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/idempotency"
+	installmentRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/installment"
+	investorRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/investor"
+	ledgerRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/ledger"
+	receiptRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/receipt"
+	refundRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/refund"
+	transactionRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/transaction"
+	webhookRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/webhook"
+	paymentService "github.com/Denisz0785/spaceyard/payment/internal/service/payment"
+	"github.com/Denisz0785/spaceyard/payment/internal/vault"
+	"github.com/Denisz0785/spaceyard/payment/internal/webhook"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+	"github.com/Denisz0785/spaceyard/shared/pkg/interceptor"
+	po "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+const (
+	port = "localhost:8081"
+	// dataDirEnv задаёт каталог с файлами хранилищ. Если переменная
+	// не задана, данные хранятся только в памяти.
+	dataDirEnv = "PAYMENT_DATA_DIR"
+	// idempotencyRetentionEnv задаёт срок хранения ключей идемпотентности (например, "24h").
+	idempotencyRetentionEnv     = "PAYMENT_IDEMPOTENCY_RETENTION"
+	defaultIdempotencyRetention = 24 * time.Hour
+	idempotencyCleanupInterval  = time.Minute
+	// authorizationTTLEnv задаёт срок действия авторизации до списания (например, "72h").
+	authorizationTTLEnv         = "PAYMENT_AUTHORIZATION_TTL"
+	defaultAuthorizationTTL     = 72 * time.Hour
+	authorizationExpiryInterval = time.Minute
+	// simulatorConfigEnv задаёт JSON-файл с правилами симулятора провайдеров.
+	// Без него все операции одобряются без задержки.
+	simulatorConfigEnv = "PAYMENT_SIMULATOR_CONFIG"
+	// feeBasisPointsEnv задаёт комиссию провайдера в базисных пунктах (например, "250" — 2,5%).
+	feeBasisPointsEnv = "PAYMENT_FEE_BASIS_POINTS"
+	// sbpBankIDEnv задаёт идентификатор банка-получателя в ссылке НСПК платежей СБП.
+	sbpBankIDEnv     = "PAYMENT_SBP_BANK_ID"
+	defaultSBPBankID = "100000000001"
+	// sbpIntentTTLEnv задаёт срок действия QR-кода платежа СБП (например, "15m").
+	sbpIntentTTLEnv     = "PAYMENT_SBP_INTENT_TTL"
+	defaultSBPIntentTTL = 15 * time.Minute
+	// fraudConfigEnv задаёт JSON-файл с правилами антифрода. Файл перечитывается
+	// при изменении; без него все платежи пропускаются.
+	fraudConfigEnv      = "PAYMENT_FRAUD_CONFIG"
+	fraudReloadInterval = 5 * time.Second
+	// installmentRatesEnv задаёт доступные сроки рассрочки в месяцах и годовые ставки
+	// в базисных пунктах, например "3:0,6:990".
+	installmentRatesEnv     = "PAYMENT_INSTALLMENT_RATES"
+	defaultInstallmentRates = "3:0,6:990,12:1490,24:1990"
+	// installmentPeriodEnv задаёт интервал между платежами рассрочки (например, "1m" для
+	// проверки графика вручную). По умолчанию платежи идут раз в календарный месяц.
+	installmentPeriodEnv = "PAYMENT_INSTALLMENT_PERIOD"
+	// installmentGracePeriodEnv задаёт, сколько после срока повторяется списание платежа,
+	// прежде чем он считается пропущенным (например, "72h").
+	installmentGracePeriodEnv     = "PAYMENT_INSTALLMENT_GRACE_PERIOD"
+	defaultInstallmentGracePeriod = 72 * time.Hour
+	// installmentRetryIntervalEnv задаёт паузу между попытками списать платёж после отказа.
+	installmentRetryIntervalEnv     = "PAYMENT_INSTALLMENT_RETRY_INTERVAL"
+	defaultInstallmentRetryInterval = 24 * time.Hour
+	installmentMaxMissed            = 3
+	installmentSchedulerInterval    = time.Minute
+	// webhookMaxAttemptsEnv задаёт, после скольких неудачных попыток доставка вебхука
+	// попадает в недоставленные.
+	webhookMaxAttemptsEnv     = "PAYMENT_WEBHOOK_MAX_ATTEMPTS"
+	defaultWebhookMaxAttempts = 8
+	// webhookBackoffBaseEnv и webhookBackoffMaxEnv задают паузу после первой неудачной
+	// попытки, которая затем удваивается, и её предел (например, "10s" и "1h").
+	webhookBackoffBaseEnv     = "PAYMENT_WEBHOOK_BACKOFF_BASE"
+	defaultWebhookBackoffBase = 10 * time.Second
+	webhookBackoffMaxEnv      = "PAYMENT_WEBHOOK_BACKOFF_MAX"
+	defaultWebhookBackoffMax  = time.Hour
+	// webhookAllowInsecureEnv разрешает доставку вебхуков по http и на loopback и частные
+	// адреса — для локального приёмника. В рабочем окружении не включается.
+	webhookAllowInsecureEnv = "PAYMENT_WEBHOOK_ALLOW_INSECURE"
+	webhookTimeout          = 5 * time.Second
+	webhookDeliveryInterval = time.Second
+	// settlementCurrencyEnv задаёт валюту расчётов с провайдерами, по умолчанию RUB.
+	settlementCurrencyEnv     = "PAYMENT_SETTLEMENT_CURRENCY"
+	defaultSettlementCurrency = "RUB"
+	// ratesFileEnv задаёт таблицу курсов валют. Без неё принимаются только платежи
+	// в валюте расчётов.
+	ratesFileEnv = "PAYMENT_RATES_FILE"
+	// cardKeyringEnv задаёт JSON-файл связки ключей, которыми шифруются сохранённые карты.
+	// Файл перечитывается при изменении; без него карты сохранять нельзя.
+	cardKeyringEnv        = "PAYMENT_CARD_KEYRING"
+	keyringReloadInterval = 5 * time.Second
+	// receiptSellerEnv задаёт название продавца в шапке чека.
+	receiptSellerEnv     = "PAYMENT_RECEIPT_SELLER"
+	defaultReceiptSeller = "Spaceyard"
+	// receiptVATBasisPointsEnv задаёт ставку НДС, включённого в цены, в базисных пунктах.
+	receiptVATBasisPointsEnv     = "PAYMENT_RECEIPT_VAT_BASIS_POINTS"
+	defaultReceiptVATBasisPoints = 2000
+	// disputeEvidenceWindowEnv задаёт, сколько после открытия спора можно отправить доказательства.
+	disputeEvidenceWindowEnv     = "PAYMENT_DISPUTE_EVIDENCE_WINDOW"
+	defaultDisputeEvidenceWindow = 7 * 24 * time.Hour
+	// shutdownTimeout — сколько ждать завершения запросов при остановке. Потоки событий
+	// сами не завершаются, поэтому по истечении срока соединения закрываются принудительно.
+	shutdownTimeout = 5 * time.Second
+)
+
+func main() {
+	lis, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	// Запросы проверяются по аннотациям buf.validate до вызова обработчиков
+	validator, err := interceptor.NewValidator(
+		paymentApiV1.ErrorDomain,
+		po.ErrorReason_ERROR_REASON_INVALID_ARGUMENT.String(),
+	)
+	if err != nil {
+		log.Fatalf("failed to create request validator: %v", err)
+	}
+
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(validator.Unary()),
+		grpc.StreamInterceptor(validator.Stream()),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Регистрируем наш сервис
+	dataDir := os.Getenv(dataDirEnv)
+	if dataDir != "" {
+		log.Printf("payment data is stored in %s", dataDir)
+	}
+	transactionRepo, err := newTransactionRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create transaction repository: %v", err)
+	}
+	idempotencyRepo, err := newIdempotencyRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create idempotency repository: %v", err)
+	}
+	refundRepo, err := newRefundRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create refund repository: %v", err)
+	}
+	ledgerRepo, err := newLedgerRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create ledger repository: %v", err)
+	}
+	investorRepo, err := newInvestorRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create investor repository: %v", err)
+	}
+	feeBasisPoints, err := basisPointsFromEnv(feeBasisPointsEnv, 0)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", feeBasisPointsEnv, err)
+	}
+	retention, err := durationFromEnv(idempotencyRetentionEnv, defaultIdempotencyRetention)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", idempotencyRetentionEnv, err)
+	}
+	authorizationTTL, err := durationFromEnv(authorizationTTLEnv, defaultAuthorizationTTL)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", authorizationTTLEnv, err)
+	}
+	sbpIntentTTL, err := durationFromEnv(sbpIntentTTLEnv, defaultSBPIntentTTL)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", sbpIntentTTLEnv, err)
+	}
+	sbpBankID := os.Getenv(sbpBankIDEnv)
+	if sbpBankID == "" {
+		sbpBankID = defaultSBPBankID
+	}
+	settlementCurrency := os.Getenv(settlementCurrencyEnv)
+	if settlementCurrency == "" {
+		settlementCurrency = defaultSettlementCurrency
+	}
+	if !currency.ValidCode(settlementCurrency) {
+		log.Fatalf("invalid %s: %q is not a three-letter ISO 4217 code", settlementCurrencyEnv, settlementCurrency)
+	}
+	rates, err := newRates(os.Getenv(ratesFileEnv), settlementCurrency)
+	if err != nil {
+		log.Fatalf("failed to load exchange rates: %v", err)
+	}
+	fraudRepo, err := newFraudRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create fraud decision repository: %v", err)
+	}
+	screener, err := fraud.NewScreener(os.Getenv(fraudConfigEnv), rates)
+	if err != nil {
+		log.Fatalf("failed to load fraud config: %v", err)
+	}
+	installmentRepo, err := newInstallmentRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create installment plan repository: %v", err)
+	}
+	installmentRates, err := installmentRatesFromEnv(installmentRatesEnv)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", installmentRatesEnv, err)
+	}
+	installmentPeriod, err := durationFromEnv(installmentPeriodEnv, 0)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", installmentPeriodEnv, err)
+	}
+	installmentGracePeriod, err := durationFromEnv(installmentGracePeriodEnv, defaultInstallmentGracePeriod)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", installmentGracePeriodEnv, err)
+	}
+	installmentRetryInterval, err := durationFromEnv(installmentRetryIntervalEnv, defaultInstallmentRetryInterval)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", installmentRetryIntervalEnv, err)
+	}
+	eventRepo, err := newEventRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create payment event repository: %v", err)
+	}
+	webhookRepo, err := newWebhookRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create webhook repository: %v", err)
+	}
+	webhookMaxAttempts, err := positiveIntFromEnv(webhookMaxAttemptsEnv, defaultWebhookMaxAttempts)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", webhookMaxAttemptsEnv, err)
+	}
+	webhookBackoffBase, err := durationFromEnv(webhookBackoffBaseEnv, defaultWebhookBackoffBase)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", webhookBackoffBaseEnv, err)
+	}
+	webhookBackoffMax, err := durationFromEnv(webhookBackoffMaxEnv, defaultWebhookBackoffMax)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", webhookBackoffMaxEnv, err)
+	}
+	if webhookBackoffMax < webhookBackoffBase {
+		log.Fatalf("%s must not be less than %s", webhookBackoffMaxEnv, webhookBackoffBaseEnv)
+	}
+	webhookAllowInsecure, err := boolFromEnv(webhookAllowInsecureEnv)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", webhookAllowInsecureEnv, err)
+	}
+	if webhookAllowInsecure {
+		log.Printf("%s is set: webhooks may be delivered over http and to private addresses", webhookAllowInsecureEnv)
+	}
+	cardRepo, err := newCardRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create card repository: %v", err)
+	}
+	keyring, err := vault.NewKeyring(os.Getenv(cardKeyringEnv))
+	if err != nil {
+		log.Fatalf("failed to load card keyring: %v", err)
+	}
+	if keyring.ActiveKeyID() == "" {
+		log.Printf("%s is not set, saving cards is disabled", cardKeyringEnv)
+	}
+	receiptRepo, err := newReceiptRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create receipt repository: %v", err)
+	}
+	receiptSeller := os.Getenv(receiptSellerEnv)
+	if receiptSeller == "" {
+		receiptSeller = defaultReceiptSeller
+	}
+	receiptVATBasisPoints, err := basisPointsFromEnv(receiptVATBasisPointsEnv, defaultReceiptVATBasisPoints)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", receiptVATBasisPointsEnv, err)
+	}
+	disputeRepo, err := newDisputeRepository(dataDir)
+	if err != nil {
+		log.Fatalf("failed to create dispute repository: %v", err)
+	}
+	disputeEvidenceWindow, err := durationFromEnv(disputeEvidenceWindowEnv, defaultDisputeEvidenceWindow)
+	if err != nil {
+		log.Fatalf("invalid %s: %v", disputeEvidenceWindowEnv, err)
+	}
+	providers, err := newProviders(os.Getenv(simulatorConfigEnv), investorRepo)
+	if err != nil {
+		log.Fatalf("failed to create payment providers: %v", err)
+	}
+	service := paymentService.NewService(
+		transactionRepo,
+		idempotencyRepo,
+		refundRepo,
+		ledgerRepo,
+		investorRepo,
+		fraudRepo,
+		installmentRepo,
+		eventRepo,
+		webhookRepo,
+		cardRepo,
+		receiptRepo,
+		disputeRepo,
+		screener,
+		keyring,
+		providers,
+		webhook.NewSender(webhookTimeout, webhookAllowInsecure),
+		rates,
+		paymentService.Config{
+			IdempotencyRetention:     retention,
+			AuthorizationTTL:         authorizationTTL,
+			FeeBasisPoints:           feeBasisPoints,
+			SBPBankID:                sbpBankID,
+			SBPIntentTTL:             sbpIntentTTL,
+			InstallmentRates:         installmentRates,
+			InstallmentPeriod:        installmentPeriod,
+			InstallmentGracePeriod:   installmentGracePeriod,
+			InstallmentRetryInterval: installmentRetryInterval,
+			InstallmentMaxMissed:     installmentMaxMissed,
+			WebhookMaxAttempts:       webhookMaxAttempts,
+			WebhookBackoffBase:       webhookBackoffBase,
+			WebhookBackoffMax:        webhookBackoffMax,
+			SettlementCurrency:       settlementCurrency,
+			ReceiptSeller:            receiptSeller,
+			ReceiptVATBasisPoints:    receiptVATBasisPoints,
+			DisputeEvidenceWindow:    disputeEvidenceWindow,
+		},
+	)
+	api := paymentApiV1.NewAPI(service)
+
+	go service.RunIdempotencyCleanup(ctx, idempotencyCleanupInterval)
+	go service.RunAuthorizationExpiry(ctx, authorizationExpiryInterval)
+	go screener.Run(ctx, fraudReloadInterval)
+	go keyring.Run(ctx, keyringReloadInterval)
+	go service.RunInstallmentScheduler(ctx, installmentSchedulerInterval)
+	go service.RunWebhookDelivery(ctx, webhookDeliveryInterval)
+
+	po.RegisterPaymentServiceServer(s, api)
+
+	// Включаем рефлексию для отладки
+	reflection.Register(s)
+
+	go func() {
+		log.Printf("server listening at %v", lis.Addr())
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	log.Println("🛑 Shutting down servers...")
+
+	// В конце останавливаем gRPC сервер
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		s.Stop()
+	}
+	log.Println("✅ gRPC server stopped")
+}
+
+func newTransactionRepository(dataDir string) (repository.TransactionRepository, error) {
+	if dataDir == "" {
+		return transactionRepository.NewRepository(), nil
+	}
+	return transactionRepository.NewFileRepository(filepath.Join(dataDir, "transactions.json"))
+}
+
+func newIdempotencyRepository(dataDir string) (repository.IdempotencyRepository, error) {
+	if dataDir == "" {
+		return idempotencyRepository.NewRepository(), nil
+	}
+	return idempotencyRepository.NewFileRepository(filepath.Join(dataDir, "idempotency_keys.json"))
+}
+
+func newRefundRepository(dataDir string) (repository.RefundRepository, error) {
+	if dataDir == "" {
+		return refundRepository.NewRepository(), nil
+	}
+	return refundRepository.NewFileRepository(filepath.Join(dataDir, "refunds.json"))
+}
+
+func newLedgerRepository(dataDir string) (repository.LedgerRepository, error) {
+	if dataDir == "" {
+		return ledgerRepository.NewRepository(), nil
+	}
+	return ledgerRepository.NewFileRepository(filepath.Join(dataDir, "ledger.json"))
+}
+
+func newInvestorRepository(dataDir string) (repository.InvestorRepository, error) {
+	if dataDir == "" {
+		return investorRepository.NewRepository(), nil
+	}
+	return investorRepository.NewFileRepository(filepath.Join(dataDir, "investors.json"))
+}
+
+func newFraudRepository(dataDir string) (repository.FraudDecisionRepository, error) {
+	if dataDir == "" {
+		return fraudRepository.NewRepository(), nil
+	}
+	return fraudRepository.NewFileRepository(filepath.Join(dataDir, "fraud_decisions.json"))
+}
+
+func newInstallmentRepository(dataDir string) (repository.InstallmentPlanRepository, error) {
+	if dataDir == "" {
+		return installmentRepository.NewRepository(), nil
+	}
+	return installmentRepository.NewFileRepository(filepath.Join(dataDir, "installment_plans.json"))
+}
+
+func newEventRepository(dataDir string) (repository.PaymentEventRepository, error) {
+	if dataDir == "" {
+		return eventRepository.NewRepository(), nil
+	}
+	return eventRepository.NewFileRepository(filepath.Join(dataDir, "payment_events.json"))
+}
+
+func newWebhookRepository(dataDir string) (repository.WebhookRepository, error) {
+	if dataDir == "" {
+		return webhookRepository.NewRepository(), nil
+	}
+	return webhookRepository.NewFileRepository(filepath.Join(dataDir, "webhooks.json"))
+}
+
+func newCardRepository(dataDir string) (repository.CardRepository, error) {
+	if dataDir == "" {
+		return cardRepository.NewRepository(), nil
+	}
+	return cardRepository.NewFileRepository(filepath.Join(dataDir, "cards.json"))
+}
+
+func newReceiptRepository(dataDir string) (repository.ReceiptRepository, error) {
+	if dataDir == "" {
+		return receiptRepository.NewRepository(), nil
+	}
+	return receiptRepository.NewFileRepository(filepath.Join(dataDir, "receipts.json"))
+}
+
+func newDisputeRepository(dataDir string) (repository.DisputeRepository, error) {
+	if dataDir == "" {
+		return disputeRepository.NewRepository(), nil
+	}
+	return disputeRepository.NewFileRepository(filepath.Join(dataDir, "disputes.json"))
+}
+
+// newProviders регистрирует адаптер для каждого способа оплаты. Оплату средствами
+// инвесторов проводит сервис сам, остальные способы обслуживает симулятор с общими
+// правилами из configPath.
+func newProviders(
+	configPath string,
+	investorRepo repository.InvestorRepository,
+) (map[model.PaymentMethod]provider.Provider, error) {
+	var config simulator.Config
+	if configPath != "" {
+		var err error
+		if config, err = simulator.LoadConfig(configPath); err != nil {
+			return nil, err
+		}
+		log.Printf("payment provider simulator is configured from %s", configPath)
+	}
+
+	providers := make(map[model.PaymentMethod]provider.Provider)
+	for _, method := range []model.PaymentMethod{
+		model.PaymentMethodCard,
+		model.PaymentMethodSBP,
+		model.PaymentMethodCreditCard,
+	} {
+		providers[method] = simulator.New(method, config)
+	}
+	providers[model.PaymentMethodInvestorMoney] = investorProvider.New(investorRepo)
+
+	return providers, nil
+}
+
+// durationFromEnv читает положительную длительность из переменной окружения name.
+func durationFromEnv(name string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, errors.New("duration must be positive")
+	}
+
+	return duration, nil
+}
+
+// positiveIntFromEnv читает положительное целое из переменной окружения name.
+func positiveIntFromEnv(name string, defaultValue int) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, errors.New("value must be positive")
+	}
+
+	return n, nil
+}
+
+// boolFromEnv читает флаг из переменной окружения name, по умолчанию выключенный.
+func boolFromEnv(name string) (bool, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(value)
+}
+
+// installmentRatesFromEnv читает сроки рассрочки и ставки вида "срок:ставка,...".
+// Срок — не меньше двух месяцев, ставка — от 0 до 10000 базисных пунктов годовых.
+func installmentRatesFromEnv(name string) (map[int]int64, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		value = defaultInstallmentRates
+	}
+
+	rates := make(map[int]int64)
+	for _, pair := range strings.Split(value, ",") {
+		termValue, rateValue, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("%q must be in the term:rate form", pair)
+		}
+		term, err := strconv.Atoi(termValue)
+		if err != nil || term < 2 || term > 120 {
+			return nil, fmt.Errorf("term %q must be between 2 and 120 months", termValue)
+		}
+		rate, err := strconv.ParseInt(rateValue, 10, 64)
+		if err != nil || rate < 0 || rate > 10_000 {
+			return nil, fmt.Errorf("rate %q must be between 0 and 10000 basis points", rateValue)
+		}
+		rates[term] = rate
+	}
+
+	return rates, nil
+}
+
+// basisPointsFromEnv читает долю от 0 до 10000 базисных пунктов, по умолчанию defaultValue.
+func basisPointsFromEnv(name string, defaultValue int64) (int64, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	bps, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if bps < 0 || bps > 10_000 {
+		return 0, errors.New("basis points must be between 0 and 10000")
+	}
+
+	return bps, nil
+}
+
+// newRates загружает таблицу курсов из файла. Без файла возвращает таблицу, в которой
+// есть только валюта расчётов.
+func newRates(path, settlementCurrency string) (*currency.Rates, error) {
+	if path == "" {
+		return currency.NewRates(settlementCurrency), nil
+	}
+
+	rates, err := currency.LoadRates(path)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("exchange rates are loaded from %s, base currency %s", path, rates.Base())
+	return rates, nil
+}
@@ -0,0 +1,118 @@
+// Приёмник вебхуков PaymentService для локальной проверки доставки. Проверяет подпись
+// запросов и пишет полученные события в лог. Запросы на /fail всегда получают 500,
+// чтобы проверить повторы и недоставленные события.
+//
+// По умолчанию PaymentService доставляет события только по https на публичные адреса.
+// Для локальной проверки его запускают с PAYMENT_WEBHOOK_ALLOW_INSECURE=true и подписывают
+// http://localhost:8091/. Иначе приёмник нужно открыть наружу: с сертификатом из
+// WEBHOOK_RECEIVER_TLS_CERT и WEBHOOK_RECEIVER_TLS_KEY или за туннелем, который сам завершает TLS.
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/webhook"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+const (
+	// addrEnv задаёт адрес приёмника, по умолчанию localhost:8091.
+	addrEnv     = "WEBHOOK_RECEIVER_ADDR"
+	defaultAddr = "localhost:8091"
+	// secretEnv задаёт секрет подписки, выданный CreateWebhookSubscription.
+	secretEnv = "WEBHOOK_SECRET"
+	// tlsCertEnv и tlsKeyEnv задают сертификат и ключ; без них приёмник слушает обычный http.
+	tlsCertEnv = "WEBHOOK_RECEIVER_TLS_CERT"
+	tlsKeyEnv  = "WEBHOOK_RECEIVER_TLS_KEY"
+	// signatureTolerance — насколько время подписи может расходиться с часами приёмника.
+	signatureTolerance = 5 * time.Minute
+	maxBodySize        = 1 << 20
+	readHeaderTimeout  = 5 * time.Second
+	shutdownTimeout    = 5 * time.Second
+)
+
+func main() {
+	secret := os.Getenv(secretEnv)
+	if secret == "" {
+		log.Fatalf("%s is not set", secretEnv)
+	}
+	addr := os.Getenv(addrEnv)
+	if addr == "" {
+		addr = defaultAddr
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /", receive(secret, http.StatusOK))
+	mux.HandleFunc("POST /fail", receive(secret, http.StatusInternalServerError))
+
+	certFile, keyFile := os.Getenv(tlsCertEnv), os.Getenv(tlsKeyEnv)
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		log.Printf("webhook receiver listening at %s", addr)
+		var err error
+		if certFile != "" || keyFile != "" {
+			err = server.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("failed to shut down webhook receiver: %v", err)
+	}
+}
+
+// receive проверяет подпись запроса и отвечает кодом status на правильно подписанные события.
+func receive(secret string, status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		if err := webhook.Verify(secret, r.Header.Get(webhook.SignatureHeader), body, time.Now(), signatureTolerance); err != nil {
+			log.Printf("rejected delivery %s: %v", r.Header.Get(webhook.DeliveryHeader), err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		var payload paymentv1.WebhookPayload
+		if err := protojson.Unmarshal(body, &payload); err != nil {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		event := payload.GetEvent()
+		log.Printf("delivery %s on %s: event %d %s, transaction %s is %s, answering %d",
+			payload.GetDeliveryUuid(), r.URL.Path, event.GetSequence(), event.GetType(),
+			event.GetTransactionUuid(), event.GetTransactionStatus(), status)
+
+		w.WriteHeader(status)
+	}
+}
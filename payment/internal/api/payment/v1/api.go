@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/service"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+type api struct {
+	paymentv1.UnimplementedPaymentServiceServer
+
+	paymentService service.PaymentService
+}
+
+func NewAPI(paymentService service.PaymentService) *api {
+	return &api{
+		paymentService: paymentService,
+	}
+}
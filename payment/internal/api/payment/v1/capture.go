@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// CapturePayment charges authorized amount
+func (a *api) CapturePayment(ctx context.Context, req *paymentv1.CapturePaymentRequest) (*paymentv1.CapturePaymentResponse, error) {
+	log.Printf("Получен запрос на списание: TransactionUUID=[%s]", req.GetTransactionUuid())
+
+	var amount *money.Money
+	if req.GetAmount() != nil {
+		m := money.FromProto(req.GetAmount())
+		amount = &m
+	}
+
+	transaction, err := a.paymentService.CapturePayment(ctx, req.GetTransactionUuid(), amount)
+	if err != nil {
+		return nil, transitionError(req.GetTransactionUuid(), err)
+	}
+
+	return &paymentv1.CapturePaymentResponse{Transaction: converter.TransactionToProto(transaction)}, nil
+}
+
+// transitionError сводит ошибки смены статуса транзакции к gRPC-статусам.
+func transitionError(transactionUUID string, err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidUUID):
+		return invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+	case errors.Is(err, model.ErrInvalidAmount):
+		return invalidAmountError(err)
+	case errors.Is(err, model.ErrInvalidInstallmentTerm):
+		return invalidInstallmentTermError("installment_term_months", err)
+	case errors.Is(err, model.ErrTransactionNotFound):
+		return transactionNotFoundError(transactionUUID)
+	case errors.Is(err, model.ErrAuthorizationExpired):
+		return authorizationExpiredError(transactionUUID)
+	case errors.Is(err, model.ErrPaymentIntentExpired):
+		return paymentIntentExpiredError(transactionUUID)
+	case errors.Is(err, model.ErrInvalidTransactionState):
+		return invalidTransactionStateError(transactionUUID)
+	case errors.Is(err, model.ErrPaymentDeclined):
+		return paymentDeclinedError(err)
+	case errors.Is(err, model.ErrProviderUnavailable):
+		return providerUnavailableError(err)
+	default:
+		return internalError(err)
+	}
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// SaveCard stores card in vault
+func (a *api) SaveCard(ctx context.Context, req *paymentv1.SaveCardRequest) (*paymentv1.SaveCardResponse, error) {
+	// Номер карты в лог не попадает, только его маска
+	log.Printf(
+		"Сохранение карты: UserUUID=[%s], Card=[%s], Expiry=[%02d/%d]",
+		req.GetUserUuid(),
+		model.MaskCardNumber(req.GetCardNumber()),
+		req.GetExpiryMonth(),
+		req.GetExpiryYear(),
+	)
+
+	card, err := a.paymentService.SaveCard(ctx, converter.CardInfoFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("user_uuid", "user_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrInvalidCard):
+			return nil, invalidArgumentError("card_number", "card_number must be a valid card number")
+		case errors.Is(err, model.ErrCardExpired):
+			return nil, invalidArgumentError("expiry_year", "card must not be expired")
+		case errors.Is(err, model.ErrCardVaultUnavailable):
+			return nil, cardVaultUnavailableError()
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.SaveCardResponse{Card: converter.PaymentCardToProto(card)}, nil
+}
+
+// ListCards returns saved cards of user
+func (a *api) ListCards(ctx context.Context, req *paymentv1.ListCardsRequest) (*paymentv1.ListCardsResponse, error) {
+	cards, err := a.paymentService.ListCards(ctx, req.GetUserUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidUUID) {
+			return nil, invalidArgumentError("user_uuid", "user_uuid must be a valid UUID")
+		}
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ListCardsResponse{Cards: converter.PaymentCardsToProto(cards)}, nil
+}
+
+// DeleteCard removes saved card
+func (a *api) DeleteCard(ctx context.Context, req *paymentv1.DeleteCardRequest) (*paymentv1.DeleteCardResponse, error) {
+	if err := a.paymentService.DeleteCard(ctx, req.GetUserUuid(), req.GetPaymentToken()); err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("user_uuid", "user_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrCardNotFound):
+			return nil, cardNotFoundError(req.GetPaymentToken())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.DeleteCardResponse{}, nil
+}
+
+// ReencryptCards encrypts saved cards with active key
+func (a *api) ReencryptCards(ctx context.Context, _ *paymentv1.ReencryptCardsRequest) (*paymentv1.ReencryptCardsResponse, error) {
+	result, err := a.paymentService.ReencryptCards(ctx)
+	if err != nil {
+		if errors.Is(err, model.ErrCardVaultUnavailable) {
+			return nil, cardVaultUnavailableError()
+		}
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ReencryptCardsResponse{
+		ActiveKeyId:      result.ActiveKeyID,
+		ReencryptedCount: int32(result.Reencrypted), // #nosec G115 -- число карт в хранилище помещается в int32
+	}, nil
+}
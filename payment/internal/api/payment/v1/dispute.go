@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// OpenDispute registers an issuer dispute of a card transaction
+func (a *api) OpenDispute(ctx context.Context, req *paymentv1.OpenDisputeRequest) (*paymentv1.OpenDisputeResponse, error) {
+	log.Printf(
+		"Получен спор: TransactionUUID=[%s], Reason=[%s]",
+		req.GetTransactionUuid(),
+		req.GetReason().String(),
+	)
+
+	dispute, err := a.paymentService.OpenDispute(ctx, converter.DisputeInfoFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrInvalidAmount):
+			return nil, invalidAmountError(err)
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, transactionNotFoundError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrTransactionNotDisputable):
+			return nil, transactionNotDisputableError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrDisputeAlreadyOpen):
+			return nil, disputeAlreadyOpenError(req.GetTransactionUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.OpenDisputeResponse{Dispute: converter.DisputeToProto(dispute)}, nil
+}
+
+// GetDispute returns dispute by uuid
+func (a *api) GetDispute(ctx context.Context, req *paymentv1.GetDisputeRequest) (*paymentv1.GetDisputeResponse, error) {
+	dispute, err := a.paymentService.GetDispute(ctx, req.GetDisputeUuid())
+	if err != nil {
+		return nil, disputeError(req.GetDisputeUuid(), err)
+	}
+
+	return &paymentv1.GetDisputeResponse{Dispute: converter.DisputeToProto(dispute)}, nil
+}
+
+// ListDisputes returns disputes matching the filter
+func (a *api) ListDisputes(ctx context.Context, req *paymentv1.ListDisputesRequest) (*paymentv1.ListDisputesResponse, error) {
+	disputes, err := a.paymentService.ListDisputes(ctx, converter.DisputesFilterFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.ListDisputesResponse{Disputes: converter.DisputesToProto(disputes)}, nil
+}
+
+// SubmitDisputeEvidence contests a dispute with evidence
+func (a *api) SubmitDisputeEvidence(ctx context.Context, req *paymentv1.SubmitDisputeEvidenceRequest) (*paymentv1.SubmitDisputeEvidenceResponse, error) {
+	dispute, err := a.paymentService.SubmitDisputeEvidence(ctx, req.GetDisputeUuid(), converter.DisputeEvidenceFromProto(req.GetEvidence()))
+	if err != nil {
+		return nil, disputeError(req.GetDisputeUuid(), err)
+	}
+
+	return &paymentv1.SubmitDisputeEvidenceResponse{Dispute: converter.DisputeToProto(dispute)}, nil
+}
+
+// AcceptDispute concedes a dispute without evidence
+func (a *api) AcceptDispute(ctx context.Context, req *paymentv1.AcceptDisputeRequest) (*paymentv1.AcceptDisputeResponse, error) {
+	log.Printf("Получено согласие со спором: DisputeUUID=[%s]", req.GetDisputeUuid())
+
+	dispute, err := a.paymentService.AcceptDispute(ctx, req.GetDisputeUuid())
+	if err != nil {
+		return nil, disputeError(req.GetDisputeUuid(), err)
+	}
+
+	return &paymentv1.AcceptDisputeResponse{Dispute: converter.DisputeToProto(dispute)}, nil
+}
+
+// ResolveDispute records the issuer outcome of a dispute
+func (a *api) ResolveDispute(ctx context.Context, req *paymentv1.ResolveDisputeRequest) (*paymentv1.ResolveDisputeResponse, error) {
+	log.Printf(
+		"Получено решение по спору: DisputeUUID=[%s], Outcome=[%s]",
+		req.GetDisputeUuid(),
+		req.GetOutcome().String(),
+	)
+
+	dispute, err := a.paymentService.ResolveDispute(ctx, req.GetDisputeUuid(), model.DisputeOutcome(req.GetOutcome()))
+	if err != nil {
+		return nil, disputeError(req.GetDisputeUuid(), err)
+	}
+
+	return &paymentv1.ResolveDisputeResponse{Dispute: converter.DisputeToProto(dispute)}, nil
+}
+
+// disputeError преобразует ошибки операций над существующим спором.
+func disputeError(disputeUUID string, err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidUUID):
+		return invalidArgumentError("dispute_uuid", "dispute_uuid must be a valid UUID")
+	case errors.Is(err, model.ErrInvalidDisputeEvidence):
+		return invalidArgumentError("evidence", err.Error())
+	case errors.Is(err, model.ErrDisputeNotFound):
+		return disputeNotFoundError(disputeUUID)
+	case errors.Is(err, model.ErrInvalidDisputeState):
+		return invalidDisputeStateError(disputeUUID, err)
+	case errors.Is(err, model.ErrDisputeEvidenceOverdue):
+		return disputeEvidenceOverdueError(disputeUUID)
+	default:
+		return internalError(err)
+	}
+}
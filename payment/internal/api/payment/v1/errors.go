@@ -0,0 +1,567 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// ErrorDomain передаётся в google.rpc.ErrorInfo, чтобы клиенты могли отличить ошибки PaymentService.
+const ErrorDomain = "payment.spaceyard"
+
+// Типы ресурсов для google.rpc.ResourceInfo.
+const (
+	transactionResourceType = "payment.v1.Transaction"
+	refundResourceType      = "payment.v1.Refund"
+	investorResourceType    = "payment.v1.Investor"
+	installmentResourceType = "payment.v1.InstallmentPlan"
+	webhookResourceType     = "payment.v1.WebhookSubscription"
+	deliveryResourceType    = "payment.v1.WebhookDelivery"
+	cardResourceType        = "payment.v1.PaymentCard"
+	receiptResourceType     = "payment.v1.Receipt"
+	disputeResourceType     = "payment.v1.Dispute"
+)
+
+// invalidArgumentError возвращает InvalidArgument с нарушением для конкретного поля запроса.
+func invalidArgumentError(field, description string) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description)),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"field": field},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: description},
+			},
+		},
+	)
+}
+
+// paymentMethodNotSupportedError возвращает InvalidArgument с нарушением для поля payment_method.
+func paymentMethodNotSupportedError(method paymentv1.PaymentMethod) error {
+	return withDetails(
+		status.Newf(codes.InvalidArgument, "payment method %s is not supported", method),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_PAYMENT_METHOD_NOT_SUPPORTED.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"payment_method": method.String()},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "payment_method", Description: "payment method must be specified and supported"},
+			},
+		},
+	)
+}
+
+// invalidAmountError возвращает InvalidArgument с нарушением для поля amount.
+func invalidAmountError(err error) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INVALID_AMOUNT.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"field": "amount"},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "amount", Description: err.Error()},
+			},
+		},
+	)
+}
+
+// idempotencyKeyReusedError возвращает AlreadyExists, если ключ уже использован для другого запроса.
+func idempotencyKeyReusedError(key string) error {
+	return withDetails(
+		status.New(codes.AlreadyExists, "idempotency key was already used for a different request"),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_IDEMPOTENCY_KEY_REUSED.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"idempotency_key": key},
+		},
+	)
+}
+
+// refundExceedsCapturedError возвращает FailedPrecondition, если сумма возвратов превысила бы списанную.
+func refundExceedsCapturedError(transactionUUID string) error {
+	return withDetails(
+		status.New(codes.FailedPrecondition, "refund amount exceeds the remaining captured amount"),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_REFUND_EXCEEDS_CAPTURED.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "AMOUNT",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "cumulative refunds must not exceed the captured amount",
+				},
+			},
+		},
+	)
+}
+
+// transactionNotRefundableError возвращает FailedPrecondition, если по транзакции нечего возвращать.
+func transactionNotRefundableError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "transaction %q cannot be refunded", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_TRANSACTION_NOT_REFUNDABLE.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "STATUS",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "transaction must be paid and not fully refunded",
+				},
+			},
+		},
+	)
+}
+
+// authorizationExpiredError возвращает FailedPrecondition для просроченной авторизации.
+func authorizationExpiredError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "authorization of transaction %q is expired", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_AUTHORIZATION_EXPIRED.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "EXPIRATION",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "authorization must be captured before it expires",
+				},
+			},
+		},
+	)
+}
+
+// paymentIntentExpiredError возвращает FailedPrecondition для платежа СБП, не оплаченного вовремя.
+func paymentIntentExpiredError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "SBP payment intent of transaction %q is expired", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_PAYMENT_INTENT_EXPIRED.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "EXPIRATION",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "QR code must be paid before it expires",
+				},
+			},
+		},
+	)
+}
+
+// invalidTransactionStateError возвращает FailedPrecondition, если статус транзакции не допускает операцию.
+func invalidTransactionStateError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "transaction %q is not in a valid state for this operation", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INVALID_TRANSACTION_STATE.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "STATUS",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "transaction status does not allow this operation",
+				},
+			},
+		},
+	)
+}
+
+// paymentDeclinedError возвращает FailedPrecondition с кодом отказа провайдера.
+func paymentDeclinedError(err error) error {
+	metadata := map[string]string{}
+	var decline *model.DeclineError
+	if errors.As(err, &decline) {
+		metadata["decline_code"] = decline.Code
+	}
+
+	return withDetails(
+		status.New(codes.FailedPrecondition, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_PAYMENT_DECLINED.String(),
+			Domain:   ErrorDomain,
+			Metadata: metadata,
+		},
+	)
+}
+
+// paymentBlockedError возвращает PermissionDenied с причиной и правилом антифрода.
+func paymentBlockedError(err error) error {
+	metadata := map[string]string{}
+	var fraudErr *model.FraudError
+	if errors.As(err, &fraudErr) {
+		metadata["fraud_reason"] = paymentv1.FraudReason(fraudErr.Reason).String()
+		metadata["rule"] = fraudErr.Rule
+	}
+
+	return withDetails(
+		status.New(codes.PermissionDenied, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_PAYMENT_BLOCKED.String(),
+			Domain:   ErrorDomain,
+			Metadata: metadata,
+		},
+	)
+}
+
+// providerUnavailableError возвращает Unavailable: провайдер не ответил, операцию можно повторить.
+func providerUnavailableError(err error) error {
+	metadata := map[string]string{}
+	var providerErr *model.ProviderError
+	if errors.As(err, &providerErr) {
+		metadata["code"] = providerErr.Code
+	}
+
+	return withDetails(
+		status.New(codes.Unavailable, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_PROVIDER_UNAVAILABLE.String(),
+			Domain:   ErrorDomain,
+			Metadata: metadata,
+		},
+	)
+}
+
+// refundNotFoundError возвращает NotFound с описанием отсутствующего возврата.
+func refundNotFoundError(refundUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "refund with UUID %q not found", refundUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_REFUND_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"uuid": refundUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: refundResourceType,
+			ResourceName: refundUUID,
+			Description:  "refund does not exist",
+		},
+	)
+}
+
+// transactionNotFoundError возвращает NotFound с описанием отсутствующей транзакции.
+func transactionNotFoundError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "transaction with UUID %q not found", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_TRANSACTION_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"uuid": transactionUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: transactionResourceType,
+			ResourceName: transactionUUID,
+			Description:  "transaction does not exist",
+		},
+	)
+}
+
+// receiptNotFoundError возвращает NotFound, если транзакция ещё не списана и чек не выписан.
+func receiptNotFoundError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "receipt for transaction %q not found", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_RECEIPT_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: receiptResourceType,
+			ResourceName: transactionUUID,
+			Description:  "receipt is issued once the transaction is captured",
+		},
+	)
+}
+
+// invalidInvestorError возвращает InvalidArgument для некорректного описания инвестора.
+func invalidInvestorError(err error) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason: paymentv1.ErrorReason_ERROR_REASON_INVALID_ARGUMENT.String(),
+			Domain: ErrorDomain,
+		},
+	)
+}
+
+// investorNotFoundError возвращает NotFound с описанием отсутствующего инвестора.
+func investorNotFoundError(investorUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "investor with UUID %q not found", investorUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INVESTOR_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"uuid": investorUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: investorResourceType,
+			ResourceName: investorUUID,
+			Description:  "investor does not exist",
+		},
+	)
+}
+
+// invalidInstallmentTermError возвращает InvalidArgument с нарушением для поля срока рассрочки.
+func invalidInstallmentTermError(field string, err error) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INVALID_INSTALLMENT_TERM.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"field": field},
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: err.Error()},
+			},
+		},
+	)
+}
+
+// installmentPlanNotFoundError возвращает NotFound с описанием отсутствующего плана рассрочки.
+func installmentPlanNotFoundError(planUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "installment plan with UUID %q not found", planUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INSTALLMENT_PLAN_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"uuid": planUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: installmentResourceType,
+			ResourceName: planUUID,
+			Description:  "installment plan does not exist",
+		},
+	)
+}
+
+// webhookSubscriptionNotFoundError возвращает NotFound с описанием отсутствующей подписки на вебхуки.
+func webhookSubscriptionNotFoundError(subscriptionUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "webhook subscription with UUID %q not found", subscriptionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"uuid": subscriptionUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: webhookResourceType,
+			ResourceName: subscriptionUUID,
+			Description:  "webhook subscription does not exist",
+		},
+	)
+}
+
+// webhookDeliveryNotFoundError возвращает NotFound, если среди недоставленных событий подписки
+// нет запрошенной доставки.
+func webhookDeliveryNotFoundError(subscriptionUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "dead webhook delivery of subscription %q not found", subscriptionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_WEBHOOK_DELIVERY_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"subscription_uuid": subscriptionUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: deliveryResourceType,
+			Owner:        subscriptionUUID,
+			Description:  "webhook delivery does not exist or is not dead",
+		},
+	)
+}
+
+// cardNotFoundError возвращает NotFound для неизвестного токена или карты другого пользователя.
+func cardNotFoundError(token string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "card with payment token %q not found", token),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_CARD_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"payment_token": token},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: cardResourceType,
+			ResourceName: token,
+			Description:  "card does not exist or belongs to another user",
+		},
+	)
+}
+
+// cardExpiredError возвращает FailedPrecondition, если срок действия сохранённой карты истёк.
+func cardExpiredError(token string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "card with payment token %q is expired", token),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_CARD_EXPIRED.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"payment_token": token},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "EXPIRY",
+					Subject:     cardResourceType + "/" + token,
+					Description: "card expiry date must not be in the past",
+				},
+			},
+		},
+	)
+}
+
+// cardVaultUnavailableError возвращает FailedPrecondition, если у хранилища карт нет ключей шифрования.
+func cardVaultUnavailableError() error {
+	return withDetails(
+		status.New(codes.FailedPrecondition, "card vault is not configured"),
+		&errdetails.ErrorInfo{
+			Reason: paymentv1.ErrorReason_ERROR_REASON_CARD_VAULT_UNAVAILABLE.String(),
+			Domain: ErrorDomain,
+		},
+	)
+}
+
+// disputeNotFoundError возвращает NotFound с описанием отсутствующего спора.
+func disputeNotFoundError(disputeUUID string) error {
+	return withDetails(
+		status.Newf(codes.NotFound, "dispute %q not found", disputeUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_DISPUTE_NOT_FOUND.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"dispute_uuid": disputeUUID},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: disputeResourceType,
+			ResourceName: disputeUUID,
+			Description:  "dispute with this uuid does not exist",
+		},
+	)
+}
+
+// transactionNotDisputableError возвращает FailedPrecondition, если по транзакции нельзя открыть спор.
+func transactionNotDisputableError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "transaction %q cannot be disputed", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_TRANSACTION_NOT_DISPUTABLE.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "STATUS",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "transaction must be paid by card and not fully refunded or charged back",
+				},
+			},
+		},
+	)
+}
+
+// disputeAlreadyOpenError возвращает FailedPrecondition, если по транзакции уже есть спор без решения.
+func disputeAlreadyOpenError(transactionUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "transaction %q already has an open dispute", transactionUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_DISPUTE_ALREADY_OPEN.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"transaction_uuid": transactionUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "DISPUTE",
+					Subject:     transactionResourceType + "/" + transactionUUID,
+					Description: "previous dispute must be resolved first",
+				},
+			},
+		},
+	)
+}
+
+// invalidDisputeStateError возвращает FailedPrecondition, если статус спора не допускает операцию.
+func invalidDisputeStateError(disputeUUID string, err error) error {
+	return withDetails(
+		status.New(codes.FailedPrecondition, err.Error()),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_INVALID_DISPUTE_STATE.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"dispute_uuid": disputeUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "STATUS",
+					Subject:     disputeResourceType + "/" + disputeUUID,
+					Description: "dispute status does not allow this operation",
+				},
+			},
+		},
+	)
+}
+
+// disputeEvidenceOverdueError возвращает FailedPrecondition, если срок ответа на спор истёк.
+func disputeEvidenceOverdueError(disputeUUID string) error {
+	return withDetails(
+		status.Newf(codes.FailedPrecondition, "evidence deadline of dispute %q has passed", disputeUUID),
+		&errdetails.ErrorInfo{
+			Reason:   paymentv1.ErrorReason_ERROR_REASON_DISPUTE_EVIDENCE_OVERDUE.String(),
+			Domain:   ErrorDomain,
+			Metadata: map[string]string{"dispute_uuid": disputeUUID},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "EXPIRATION",
+					Subject:     disputeResourceType + "/" + disputeUUID,
+					Description: "evidence must be submitted before evidence_due_by",
+				},
+			},
+		},
+	)
+}
+
+// internalError скрывает детали внутренней ошибки от клиента, оставляя их в логе.
+func internalError(err error) error {
+	log.Printf("internal error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		log.Printf("failed to attach error details: %v", err)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"log"
+
+	"google.golang.org/grpc"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// SubscribePaymentEvents streams payment events
+func (a *api) SubscribePaymentEvents(
+	req *paymentv1.SubscribePaymentEventsRequest,
+	stream grpc.ServerStreamingServer[paymentv1.SubscribePaymentEventsResponse],
+) error {
+	log.Printf("Подписка на события платежей: AfterSequence=[%d]", req.GetAfterSequence())
+
+	err := a.paymentService.SubscribePaymentEvents(stream.Context(), req.GetAfterSequence(), func(event model.PaymentEvent) error {
+		return stream.Send(&paymentv1.SubscribePaymentEventsResponse{Event: converter.PaymentEventToProto(event)})
+	})
+	// Подписчик отключился или сервер останавливается: поток завершён штатно.
+	if err != nil && stream.Context().Err() == nil {
+		return internalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// ListFraudDecisions returns fraud screening decisions
+func (a *api) ListFraudDecisions(ctx context.Context, req *paymentv1.ListFraudDecisionsRequest) (*paymentv1.ListFraudDecisionsResponse, error) {
+	decisions, err := a.paymentService.ListFraudDecisions(ctx, converter.FraudDecisionsFilterFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("user_uuid", "user_uuid and order_uuid must be valid UUIDs")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.ListFraudDecisionsResponse{Decisions: converter.FraudDecisionsToProto(decisions)}, nil
+}
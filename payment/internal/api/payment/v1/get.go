@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// GetTransaction returns transaction by uuid
+func (a *api) GetTransaction(ctx context.Context, req *paymentv1.GetTransactionRequest) (*paymentv1.GetTransactionResponse, error) {
+	transaction, err := a.paymentService.GetTransaction(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, transactionNotFoundError(req.GetTransactionUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.GetTransactionResponse{Transaction: converter.TransactionToProto(transaction)}, nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// idempotencyKeyMetadata — ключ метаданных с ключом идемпотентности.
+	idempotencyKeyMetadata = "idempotency-key"
+	maxIdempotencyKeyLen   = 255
+)
+
+// idempotentRequest — запрос с необязательным ключом идемпотентности.
+type idempotentRequest interface {
+	GetIdempotencyKey() string
+}
+
+// idempotencyKey возвращает ключ идемпотентности из поля запроса или из метаданных.
+func idempotencyKey(ctx context.Context, req idempotentRequest) (string, error) {
+	key := req.GetIdempotencyKey()
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyKeyMetadata); len(values) > 0 {
+			switch {
+			case len(values) > 1:
+				return "", invalidArgumentError(idempotencyKeyMetadata, "metadata must contain a single idempotency key")
+			case key != "" && key != values[0]:
+				return "", invalidArgumentError("idempotency_key", "idempotency_key must match the idempotency-key metadata")
+			}
+			key = values[0]
+		}
+	}
+
+	if len(key) > maxIdempotencyKeyLen {
+		return "", invalidArgumentError("idempotency_key", "idempotency_key must not exceed 255 characters")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// QuoteInstallmentPlan calculates installment schedule
+func (a *api) QuoteInstallmentPlan(ctx context.Context, req *paymentv1.QuoteInstallmentPlanRequest) (*paymentv1.QuoteInstallmentPlanResponse, error) {
+	quote, err := a.paymentService.QuoteInstallmentPlan(ctx, money.FromProto(req.GetAmount()), int(req.GetTermMonths()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidAmount):
+			return nil, invalidAmountError(err)
+		case errors.Is(err, model.ErrInvalidInstallmentTerm):
+			return nil, invalidInstallmentTermError("term_months", err)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.QuoteInstallmentPlanResponse{Quote: converter.InstallmentQuoteToProto(quote)}, nil
+}
+
+// GetInstallmentPlan returns installment plan with its installments
+func (a *api) GetInstallmentPlan(ctx context.Context, req *paymentv1.GetInstallmentPlanRequest) (*paymentv1.GetInstallmentPlanResponse, error) {
+	plan, err := a.paymentService.GetInstallmentPlan(ctx, req.GetPlanUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("plan_uuid", "plan_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrInstallmentPlanNotFound):
+			return nil, installmentPlanNotFoundError(req.GetPlanUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.GetInstallmentPlanResponse{Plan: converter.InstallmentPlanToProto(plan)}, nil
+}
+
+// ListInstallmentPlans returns installment plans
+func (a *api) ListInstallmentPlans(ctx context.Context, req *paymentv1.ListInstallmentPlansRequest) (*paymentv1.ListInstallmentPlansResponse, error) {
+	plans, err := a.paymentService.ListInstallmentPlans(ctx, converter.InstallmentPlansFilterFromProto(req))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ListInstallmentPlansResponse{Plans: converter.InstallmentPlansToProto(plans)}, nil
+}
@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// CreateInvestor creates an investor account
+func (a *api) CreateInvestor(ctx context.Context, req *paymentv1.CreateInvestorRequest) (*paymentv1.CreateInvestorResponse, error) {
+	investor, err := a.paymentService.CreateInvestor(ctx, converter.InvestorInfoFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("authorized_user_uuids", "authorized_user_uuids must be valid UUIDs")
+		case errors.Is(err, model.ErrInvalidInvestor):
+			return nil, invalidInvestorError(err)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	log.Printf("Создан инвестор, investor_uuid: %s", investor.UUID)
+
+	return &paymentv1.CreateInvestorResponse{Investor: converter.InvestorToProto(investor)}, nil
+}
+
+// GetInvestor returns investor by uuid
+func (a *api) GetInvestor(ctx context.Context, req *paymentv1.GetInvestorRequest) (*paymentv1.GetInvestorResponse, error) {
+	investor, err := a.paymentService.GetInvestor(ctx, req.GetInvestorUuid())
+	if err != nil {
+		return nil, investorError(err, req.GetInvestorUuid())
+	}
+
+	return &paymentv1.GetInvestorResponse{Investor: converter.InvestorToProto(investor)}, nil
+}
+
+// ListInvestors returns all investors
+func (a *api) ListInvestors(ctx context.Context, _ *paymentv1.ListInvestorsRequest) (*paymentv1.ListInvestorsResponse, error) {
+	investors, err := a.paymentService.ListInvestors(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ListInvestorsResponse{Investors: converter.InvestorsToProto(investors)}, nil
+}
+
+// TopUpInvestor adds money to investor
+func (a *api) TopUpInvestor(ctx context.Context, req *paymentv1.TopUpInvestorRequest) (*paymentv1.TopUpInvestorResponse, error) {
+	investor, err := a.paymentService.TopUpInvestor(ctx, req.GetInvestorUuid(), money.FromProto(req.GetAmount()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidAmount) {
+			return nil, invalidAmountError(err)
+		}
+		return nil, investorError(err, req.GetInvestorUuid())
+	}
+
+	log.Printf("Инвестор пополнен, investor_uuid: %s, amount: %s", investor.UUID, money.FromProto(req.GetAmount()))
+
+	return &paymentv1.TopUpInvestorResponse{Investor: converter.InvestorToProto(investor)}, nil
+}
+
+// GrantInvestorAccess allows user to spend investor money
+func (a *api) GrantInvestorAccess(ctx context.Context, req *paymentv1.GrantInvestorAccessRequest) (*paymentv1.GrantInvestorAccessResponse, error) {
+	investor, err := a.paymentService.GrantInvestorAccess(ctx, req.GetInvestorUuid(), req.GetUserUuid())
+	if err != nil {
+		return nil, investorError(err, req.GetInvestorUuid())
+	}
+
+	return &paymentv1.GrantInvestorAccessResponse{Investor: converter.InvestorToProto(investor)}, nil
+}
+
+// RevokeInvestorAccess forbids user to spend investor money
+func (a *api) RevokeInvestorAccess(ctx context.Context, req *paymentv1.RevokeInvestorAccessRequest) (*paymentv1.RevokeInvestorAccessResponse, error) {
+	investor, err := a.paymentService.RevokeInvestorAccess(ctx, req.GetInvestorUuid(), req.GetUserUuid())
+	if err != nil {
+		return nil, investorError(err, req.GetInvestorUuid())
+	}
+
+	return &paymentv1.RevokeInvestorAccessResponse{Investor: converter.InvestorToProto(investor)}, nil
+}
+
+// ListInvestorMovements returns movements of investor money
+func (a *api) ListInvestorMovements(ctx context.Context, req *paymentv1.ListInvestorMovementsRequest) (*paymentv1.ListInvestorMovementsResponse, error) {
+	movements, err := a.paymentService.ListInvestorMovements(ctx, req.GetInvestorUuid())
+	if err != nil {
+		return nil, investorError(err, req.GetInvestorUuid())
+	}
+
+	return &paymentv1.ListInvestorMovementsResponse{Movements: converter.InvestorMovementsToProto(movements)}, nil
+}
+
+// investorError преобразует общие для операций над инвестором ошибки в статусы gRPC.
+func investorError(err error, investorUUID string) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidUUID):
+		return invalidArgumentError("investor_uuid", "investor_uuid and user_uuid must be valid UUIDs")
+	case errors.Is(err, model.ErrInvestorNotFound):
+		return investorNotFoundError(investorUUID)
+	default:
+		return internalError(err)
+	}
+}
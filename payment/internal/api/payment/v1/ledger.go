@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// ListAccountBalances returns balances of ledger accounts
+func (a *api) ListAccountBalances(ctx context.Context, req *paymentv1.ListAccountBalancesRequest) (*paymentv1.ListAccountBalancesResponse, error) {
+	balances, err := a.paymentService.ListAccountBalances(ctx, converter.AccountBalancesFilterFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("owner_uuid", "owner_uuid must be a valid UUID")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.ListAccountBalancesResponse{Balances: converter.AccountBalancesToProto(balances)}, nil
+}
+
+// ListJournalEntries returns ledger journal entries
+func (a *api) ListJournalEntries(ctx context.Context, req *paymentv1.ListJournalEntriesRequest) (*paymentv1.ListJournalEntriesResponse, error) {
+	entries, err := a.paymentService.ListJournalEntries(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.ListJournalEntriesResponse{Entries: converter.JournalEntriesToProto(entries)}, nil
+}
+
+// CheckLedgerConsistency verifies the ledger
+func (a *api) CheckLedgerConsistency(ctx context.Context, _ *paymentv1.CheckLedgerConsistencyRequest) (*paymentv1.CheckLedgerConsistencyResponse, error) {
+	report, err := a.paymentService.CheckLedger(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	if len(report.Violations) > 0 {
+		log.Printf("Сверка книги нашла расхождения: %d", len(report.Violations))
+	}
+
+	return converter.LedgerReportToProto(report), nil
+}
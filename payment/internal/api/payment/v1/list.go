@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// ListTransactions returns transactions, with optional filtering.
+func (a *api) ListTransactions(ctx context.Context, req *paymentv1.ListTransactionsRequest) (*paymentv1.ListTransactionsResponse, error) {
+	transactions, err := a.paymentService.ListTransactions(ctx, converter.TransactionsFilterFromProto(req.GetFilter()))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ListTransactionsResponse{Transactions: converter.TransactionsToProto(transactions)}, nil
+}
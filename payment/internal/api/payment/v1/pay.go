@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// PayOrder is doing payment
+func (a *api) PayOrder(ctx context.Context, req *paymentv1.PayOrderRequest) (*paymentv1.PayOrderResponse, error) {
+	// Логируем полученные данные для полноты обработки запроса
+	log.Printf(
+		"Получен запрос на оплату: OrderUUID=[%s], UserUUID=[%s], PaymentMethod=[%s], Amount=[%d.%09d %s]",
+		req.GetOrderUuid(),
+		req.GetUserUuid(),
+		req.GetPaymentMethod().String(),
+		req.GetAmount().GetUnits(),
+		req.GetAmount().GetNanos(),
+		req.GetAmount().GetCurrencyCode(),
+	)
+
+	key, err := idempotencyKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	info := converter.PayOrderInfoFromProto(req)
+	info.IdempotencyKey = key
+
+	transaction, err := a.paymentService.PayOrder(ctx, info)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPaymentMethodNotSupported):
+			return nil, paymentMethodNotSupportedError(req.GetPaymentMethod())
+		case errors.Is(err, model.ErrInvalidAmount):
+			return nil, invalidAmountError(err)
+		case errors.Is(err, model.ErrInvalidInstallmentTerm):
+			return nil, invalidInstallmentTermError("installment_term_months", err)
+		case errors.Is(err, model.ErrPaymentTokenNotAllowed):
+			return nil, invalidArgumentError("payment_token", "payment_token is accepted only for PAYMENT_METHOD_CARD")
+		case errors.Is(err, model.ErrInvalidLineItems):
+			return nil, invalidArgumentError("line_items", err.Error())
+		case errors.Is(err, model.ErrCardNotFound):
+			return nil, cardNotFoundError(req.GetPaymentToken())
+		case errors.Is(err, model.ErrCardExpired):
+			return nil, cardExpiredError(req.GetPaymentToken())
+		case errors.Is(err, model.ErrCardVaultUnavailable):
+			return nil, cardVaultUnavailableError()
+		case errors.Is(err, model.ErrIdempotencyKeyReused):
+			return nil, idempotencyKeyReusedError(info.IdempotencyKey)
+		case errors.Is(err, model.ErrPaymentBlocked):
+			return nil, paymentBlockedError(err)
+		case errors.Is(err, model.ErrPaymentDeclined):
+			return nil, paymentDeclinedError(err)
+		case errors.Is(err, model.ErrProviderUnavailable):
+			return nil, providerUnavailableError(err)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	response := &paymentv1.PayOrderResponse{TransactionUuid: transaction.UUID}
+	if transaction.Status == model.TransactionStatusPending {
+		intent, err := a.paymentService.GetSBPPaymentIntent(ctx, transaction.UUID, model.QRImageFormatPNG)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		response.SbpPaymentIntent = converter.SBPPaymentIntentToProto(intent)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// GetReceipt returns receipt of captured transaction
+func (a *api) GetReceipt(ctx context.Context, req *paymentv1.GetReceiptRequest) (*paymentv1.GetReceiptResponse, error) {
+	receipt, err := a.paymentService.GetReceipt(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, transactionNotFoundError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrReceiptNotFound):
+			return nil, receiptNotFoundError(req.GetTransactionUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.GetReceiptResponse{Receipt: converter.ReceiptToProto(receipt)}, nil
+}
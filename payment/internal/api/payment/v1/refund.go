@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// RefundPayment returns money of a transaction
+func (a *api) RefundPayment(ctx context.Context, req *paymentv1.RefundPaymentRequest) (*paymentv1.RefundPaymentResponse, error) {
+	log.Printf(
+		"Получен запрос на возврат: TransactionUUID=[%s], Reason=[%s]",
+		req.GetTransactionUuid(),
+		req.GetReason().String(),
+	)
+
+	refund, err := a.paymentService.RefundPayment(ctx, converter.RefundInfoFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrInvalidAmount):
+			return nil, invalidAmountError(err)
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, transactionNotFoundError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrTransactionNotRefundable):
+			return nil, transactionNotRefundableError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrDisputeAlreadyOpen):
+			return nil, disputeAlreadyOpenError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrRefundExceedsCaptured):
+			return nil, refundExceedsCapturedError(req.GetTransactionUuid())
+		case errors.Is(err, model.ErrPaymentDeclined):
+			return nil, paymentDeclinedError(err)
+		case errors.Is(err, model.ErrProviderUnavailable):
+			return nil, providerUnavailableError(err)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.RefundPaymentResponse{Refund: converter.RefundToProto(refund)}, nil
+}
+
+// GetRefund returns refund by uuid
+func (a *api) GetRefund(ctx context.Context, req *paymentv1.GetRefundRequest) (*paymentv1.GetRefundResponse, error) {
+	refund, err := a.paymentService.GetRefund(ctx, req.GetRefundUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("refund_uuid", "refund_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrRefundNotFound):
+			return nil, refundNotFoundError(req.GetRefundUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.GetRefundResponse{Refund: converter.RefundToProto(refund)}, nil
+}
+
+// ListRefunds returns refunds of a transaction
+func (a *api) ListRefunds(ctx context.Context, req *paymentv1.ListRefundsRequest) (*paymentv1.ListRefundsResponse, error) {
+	refunds, err := a.paymentService.ListRefunds(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("transaction_uuid", "transaction_uuid must be a valid UUID")
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.ListRefundsResponse{Refunds: converter.RefundsToProto(refunds)}, nil
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// CreateSbpPaymentIntent creates SBP payment paid by QR code
+func (a *api) CreateSbpPaymentIntent(ctx context.Context, req *paymentv1.CreateSbpPaymentIntentRequest) (*paymentv1.CreateSbpPaymentIntentResponse, error) {
+	log.Printf(
+		"Получен запрос на платёж СБП: OrderUUID=[%s], UserUUID=[%s], Amount=[%d.%09d %s]",
+		req.GetOrderUuid(),
+		req.GetUserUuid(),
+		req.GetAmount().GetUnits(),
+		req.GetAmount().GetNanos(),
+		req.GetAmount().GetCurrencyCode(),
+	)
+
+	key, err := idempotencyKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	info := converter.SBPPaymentIntentInfoFromProto(req)
+	info.IdempotencyKey = key
+
+	intent, err := a.paymentService.CreateSBPPaymentIntent(ctx, info, model.QRImageFormat(req.GetImageFormat()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidAmount):
+			return nil, invalidAmountError(err)
+		case errors.Is(err, model.ErrInvalidLineItems):
+			return nil, invalidArgumentError("line_items", err.Error())
+		case errors.Is(err, model.ErrIdempotencyKeyReused):
+			return nil, idempotencyKeyReusedError(info.IdempotencyKey)
+		case errors.Is(err, model.ErrPaymentBlocked):
+			return nil, paymentBlockedError(err)
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.CreateSbpPaymentIntentResponse{Intent: converter.SBPPaymentIntentToProto(intent)}, nil
+}
+
+// GetSbpPaymentIntent returns SBP payment with QR code
+func (a *api) GetSbpPaymentIntent(ctx context.Context, req *paymentv1.GetSbpPaymentIntentRequest) (*paymentv1.GetSbpPaymentIntentResponse, error) {
+	intent, err := a.paymentService.GetSBPPaymentIntent(ctx, req.GetTransactionUuid(), model.QRImageFormat(req.GetImageFormat()))
+	if err != nil {
+		return nil, transitionError(req.GetTransactionUuid(), err)
+	}
+
+	return &paymentv1.GetSbpPaymentIntentResponse{Intent: converter.SBPPaymentIntentToProto(intent)}, nil
+}
+
+// ConfirmSbpPaymentIntent pays SBP payment as if QR code was scanned
+func (a *api) ConfirmSbpPaymentIntent(ctx context.Context, req *paymentv1.ConfirmSbpPaymentIntentRequest) (*paymentv1.ConfirmSbpPaymentIntentResponse, error) {
+	log.Printf("Получено подтверждение платежа СБП: TransactionUUID=[%s]", req.GetTransactionUuid())
+
+	transaction, err := a.paymentService.ConfirmSBPPayment(ctx, req.GetTransactionUuid())
+	if err != nil {
+		return nil, transitionError(req.GetTransactionUuid(), err)
+	}
+
+	return &paymentv1.ConfirmSbpPaymentIntentResponse{Transaction: converter.TransactionToProto(transaction)}, nil
+}
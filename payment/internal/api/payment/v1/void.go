@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// VoidAuthorization releases authorized amount
+func (a *api) VoidAuthorization(ctx context.Context, req *paymentv1.VoidAuthorizationRequest) (*paymentv1.VoidAuthorizationResponse, error) {
+	log.Printf("Получен запрос на отмену авторизации: TransactionUUID=[%s]", req.GetTransactionUuid())
+
+	transaction, err := a.paymentService.VoidAuthorization(ctx, req.GetTransactionUuid())
+	if err != nil {
+		return nil, transitionError(req.GetTransactionUuid(), err)
+	}
+
+	return &paymentv1.VoidAuthorizationResponse{Transaction: converter.TransactionToProto(transaction)}, nil
+}
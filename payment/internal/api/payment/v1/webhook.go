@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// CreateWebhookSubscription registers webhook endpoint
+func (a *api) CreateWebhookSubscription(
+	ctx context.Context,
+	req *paymentv1.CreateWebhookSubscriptionRequest,
+) (*paymentv1.CreateWebhookSubscriptionResponse, error) {
+	log.Printf("Регистрация вебхука: URL=[%s], EventTypes=%v", req.GetUrl(), req.GetEventTypes())
+
+	subscription, err := a.paymentService.CreateWebhookSubscription(ctx, converter.WebhookSubscriptionInfoFromProto(req))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidWebhookSubscription) {
+			return nil, invalidArgumentError("url", "url must be an absolute https URL of a public host")
+		}
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.CreateWebhookSubscriptionResponse{
+		Subscription: converter.WebhookSubscriptionToProto(subscription),
+	}, nil
+}
+
+// ListWebhookSubscriptions returns webhook subscriptions
+func (a *api) ListWebhookSubscriptions(
+	ctx context.Context,
+	_ *paymentv1.ListWebhookSubscriptionsRequest,
+) (*paymentv1.ListWebhookSubscriptionsResponse, error) {
+	subscriptions, err := a.paymentService.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ListWebhookSubscriptionsResponse{
+		Subscriptions: converter.WebhookSubscriptionsToProto(subscriptions),
+	}, nil
+}
+
+// DeleteWebhookSubscription removes webhook subscription
+func (a *api) DeleteWebhookSubscription(
+	ctx context.Context,
+	req *paymentv1.DeleteWebhookSubscriptionRequest,
+) (*paymentv1.DeleteWebhookSubscriptionResponse, error) {
+	if err := a.paymentService.DeleteWebhookSubscription(ctx, req.GetSubscriptionUuid()); err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("subscription_uuid", "subscription_uuid must be a valid UUID")
+		case errors.Is(err, model.ErrWebhookSubscriptionNotFound):
+			return nil, webhookSubscriptionNotFoundError(req.GetSubscriptionUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+// ListWebhookDeliveries returns webhook deliveries
+func (a *api) ListWebhookDeliveries(
+	ctx context.Context,
+	req *paymentv1.ListWebhookDeliveriesRequest,
+) (*paymentv1.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := a.paymentService.ListWebhookDeliveries(ctx, converter.WebhookDeliveriesFilterFromProto(req))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidUUID) {
+			return nil, invalidArgumentError("subscription_uuid", "subscription_uuid must be a valid UUID")
+		}
+		return nil, internalError(err)
+	}
+
+	return &paymentv1.ListWebhookDeliveriesResponse{Deliveries: converter.WebhookDeliveriesToProto(deliveries)}, nil
+}
+
+// ReplayWebhookDeliveries requeues dead webhook deliveries
+func (a *api) ReplayWebhookDeliveries(
+	ctx context.Context,
+	req *paymentv1.ReplayWebhookDeliveriesRequest,
+) (*paymentv1.ReplayWebhookDeliveriesResponse, error) {
+	log.Printf("Повтор доставки вебхуков: SubscriptionUUID=[%s], DeliveryUUIDs=%v", req.GetSubscriptionUuid(), req.GetDeliveryUuids())
+
+	deliveries, err := a.paymentService.ReplayWebhookDeliveries(ctx, req.GetSubscriptionUuid(), req.GetDeliveryUuids())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidUUID):
+			return nil, invalidArgumentError("subscription_uuid", "subscription_uuid and delivery_uuids must be valid UUIDs")
+		case errors.Is(err, model.ErrWebhookSubscriptionNotFound):
+			return nil, webhookSubscriptionNotFoundError(req.GetSubscriptionUuid())
+		case errors.Is(err, model.ErrWebhookDeliveryNotFound):
+			return nil, webhookDeliveryNotFoundError(req.GetSubscriptionUuid())
+		default:
+			return nil, internalError(err)
+		}
+	}
+
+	return &paymentv1.ReplayWebhookDeliveriesResponse{Deliveries: converter.WebhookDeliveriesToProto(deliveries)}, nil
+}
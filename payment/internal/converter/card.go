@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func CardInfoFromProto(req *paymentv1.SaveCardRequest) model.CardInfo {
+	return model.CardInfo{
+		UserUUID:    req.GetUserUuid(),
+		Number:      req.GetCardNumber(),
+		ExpiryMonth: int(req.GetExpiryMonth()),
+		ExpiryYear:  int(req.GetExpiryYear()),
+	}
+}
+
+// PaymentCardToProto передаёт только замаскированные данные карты.
+func PaymentCardToProto(card model.PaymentCard) *paymentv1.PaymentCard {
+	return &paymentv1.PaymentCard{
+		PaymentToken: card.Token,
+		UserUuid:     card.UserUUID,
+		Brand:        paymentv1.CardBrand(card.Brand),
+		MaskedNumber: card.MaskedNumber,
+		Last4:        card.Last4,
+		ExpiryMonth:  int32(card.ExpiryMonth), // #nosec G115 -- месяц от 1 до 12
+		ExpiryYear:   int32(card.ExpiryYear),  // #nosec G115 -- год проверяется при сохранении карты
+		CreatedAt:    timestamppb.New(card.CreatedAt),
+	}
+}
+
+func PaymentCardsToProto(cards []model.PaymentCard) []*paymentv1.PaymentCard {
+	result := make([]*paymentv1.PaymentCard, 0, len(cards))
+	for _, card := range cards {
+		result = append(result, PaymentCardToProto(card))
+	}
+	return result
+}
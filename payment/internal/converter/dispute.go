@@ -0,0 +1,74 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// DisputeInfoFromProto преобразует запрос на открытие спора. Отсутствующая сумма означает
+// весь ещё не возвращённый остаток транзакции.
+func DisputeInfoFromProto(req *paymentv1.OpenDisputeRequest) model.DisputeInfo {
+	info := model.DisputeInfo{
+		TransactionUUID: req.GetTransactionUuid(),
+		Reason:          model.DisputeReason(req.GetReason()),
+	}
+	if req.GetAmount() != nil {
+		amount := money.FromProto(req.GetAmount())
+		info.Amount = &amount
+	}
+	return info
+}
+
+func DisputesFilterFromProto(req *paymentv1.ListDisputesRequest) model.DisputesFilter {
+	filter := model.DisputesFilter{TransactionUUID: req.GetTransactionUuid()}
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, model.DisputeStatus(status))
+	}
+	return filter
+}
+
+func DisputeEvidenceFromProto(evidence *paymentv1.DisputeEvidence) model.DisputeEvidence {
+	return model.DisputeEvidence{
+		Description:    evidence.GetDescription(),
+		TrackingNumber: evidence.GetTrackingNumber(),
+		DocumentURLs:   evidence.GetDocumentUrls(),
+	}
+}
+
+func DisputeToProto(dispute model.Dispute) *paymentv1.Dispute {
+	result := &paymentv1.Dispute{
+		Uuid:            dispute.UUID,
+		TransactionUuid: dispute.TransactionUUID,
+		OrderUuid:       dispute.OrderUUID,
+		UserUuid:        dispute.UserUUID,
+		Amount:          money.ToProto(dispute.Amount),
+		Reason:          paymentv1.DisputeReason(dispute.Reason),
+		Status:          paymentv1.DisputeStatus(dispute.Status),
+		EvidenceDueBy:   timestamppb.New(dispute.EvidenceDueBy),
+		CreatedAt:       timestamppb.New(dispute.CreatedAt),
+		UpdatedAt:       timestamppb.New(dispute.UpdatedAt),
+	}
+	if dispute.Evidence != nil {
+		result.Evidence = &paymentv1.DisputeEvidence{
+			Description:    dispute.Evidence.Description,
+			TrackingNumber: dispute.Evidence.TrackingNumber,
+			DocumentUrls:   dispute.Evidence.DocumentURLs,
+			SubmittedAt:    timestamppb.New(dispute.Evidence.SubmittedAt),
+		}
+	}
+	if !dispute.ResolvedAt.IsZero() {
+		result.ResolvedAt = timestamppb.New(dispute.ResolvedAt)
+	}
+	return result
+}
+
+func DisputesToProto(disputes []model.Dispute) []*paymentv1.Dispute {
+	result := make([]*paymentv1.Dispute, 0, len(disputes))
+	for _, dispute := range disputes {
+		result = append(result, DisputeToProto(dispute))
+	}
+	return result
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func PaymentEventToProto(event model.PaymentEvent) *paymentv1.PaymentEvent {
+	return &paymentv1.PaymentEvent{
+		Sequence:          event.Sequence,
+		Type:              paymentv1.PaymentEventType(event.Type),
+		TransactionUuid:   event.TransactionUUID,
+		OrderUuid:         event.OrderUUID,
+		UserUuid:          event.UserUUID,
+		PaymentMethod:     paymentv1.PaymentMethod(event.PaymentMethod),
+		TransactionStatus: paymentv1.TransactionStatus(event.TransactionStatus),
+		Amount:            money.ToProto(event.Amount),
+		RefundUuid:        event.RefundUUID,
+		DisputeUuid:       event.DisputeUUID,
+		Reason:            event.Reason,
+		CreatedAt:         timestamppb.New(event.CreatedAt),
+	}
+}
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func FraudDecisionsFilterFromProto(req *paymentv1.ListFraudDecisionsRequest) model.FraudDecisionsFilter {
+	return model.FraudDecisionsFilter{
+		UserUUID:  req.GetUserUuid(),
+		OrderUUID: req.GetOrderUuid(),
+		Outcome:   model.FraudOutcome(req.GetOutcome()),
+	}
+}
+
+func FraudDecisionsToProto(decisions []model.FraudDecision) []*paymentv1.FraudDecision {
+	result := make([]*paymentv1.FraudDecision, 0, len(decisions))
+	for _, decision := range decisions {
+		result = append(result, &paymentv1.FraudDecision{
+			Uuid:          decision.UUID,
+			OrderUuid:     decision.OrderUUID,
+			UserUuid:      decision.UserUUID,
+			PaymentMethod: paymentv1.PaymentMethod(decision.PaymentMethod),
+			Amount:        money.ToProto(decision.Amount),
+			Outcome:       paymentv1.FraudOutcome(decision.Outcome),
+			Reason:        paymentv1.FraudReason(decision.Reason),
+			Rule:          decision.Rule,
+			Description:   decision.Description,
+			CreatedAt:     timestamppb.New(decision.CreatedAt),
+		})
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func InstallmentPlansFilterFromProto(req *paymentv1.ListInstallmentPlansRequest) model.InstallmentPlansFilter {
+	filter := model.InstallmentPlansFilter{
+		UserUUID:        req.GetUserUuid(),
+		TransactionUUID: req.GetTransactionUuid(),
+	}
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, model.InstallmentPlanStatus(status))
+	}
+	return filter
+}
+
+func InstallmentQuoteToProto(quote model.InstallmentQuote) *paymentv1.InstallmentQuote {
+	installments := make([]*paymentv1.Installment, 0, len(quote.Installments))
+	for _, installment := range quote.Installments {
+		result := &paymentv1.Installment{
+			Number:          int32(installment.Number), // #nosec G115 -- номер не больше срока рассрочки
+			DueAt:           timestamppb.New(installment.DueAt),
+			Principal:       money.ToProto(installment.Principal),
+			Interest:        money.ToProto(installment.Interest),
+			Amount:          money.ToProto(installment.Amount),
+			Status:          paymentv1.InstallmentStatus(installment.Status),
+			Attempts:        int32(installment.Attempts), // #nosec G115 -- попыток не больше числа запусков планировщика
+			LastDeclineCode: installment.LastDeclineCode,
+		}
+		if !installment.PaidAt.IsZero() {
+			result.PaidAt = timestamppb.New(installment.PaidAt)
+		}
+		if !installment.NextAttemptAt.IsZero() {
+			result.NextAttemptAt = timestamppb.New(installment.NextAttemptAt)
+		}
+		installments = append(installments, result)
+	}
+
+	return &paymentv1.InstallmentQuote{
+		Principal:             money.ToProto(quote.Principal),
+		TermMonths:            int32(quote.TermMonths), // #nosec G115 -- срок рассрочки задаётся из int32
+		AnnualRateBasisPoints: quote.AnnualRateBasisPoints,
+		Installments:          installments,
+		TotalInterest:         money.ToProto(quote.TotalInterest),
+		Total:                 money.ToProto(quote.Total),
+	}
+}
+
+func InstallmentPlanToProto(plan model.InstallmentPlan) *paymentv1.InstallmentPlan {
+	return &paymentv1.InstallmentPlan{
+		Uuid:            plan.UUID,
+		TransactionUuid: plan.TransactionUUID,
+		OrderUuid:       plan.OrderUUID,
+		UserUuid:        plan.UserUUID,
+		Status:          paymentv1.InstallmentPlanStatus(plan.Status),
+		Schedule:        InstallmentQuoteToProto(plan.InstallmentQuote),
+		MissedCount:     int32(plan.MissedCount), // #nosec G115 -- пропусков не больше срока рассрочки
+		CreatedAt:       timestamppb.New(plan.CreatedAt),
+		UpdatedAt:       timestamppb.New(plan.UpdatedAt),
+	}
+}
+
+func InstallmentPlansToProto(plans []model.InstallmentPlan) []*paymentv1.InstallmentPlan {
+	result := make([]*paymentv1.InstallmentPlan, 0, len(plans))
+	for _, plan := range plans {
+		result = append(result, InstallmentPlanToProto(plan))
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func InvestorInfoFromProto(req *paymentv1.CreateInvestorRequest) model.InvestorInfo {
+	return model.InvestorInfo{
+		Name:                req.GetName(),
+		CurrencyCode:        req.GetCurrencyCode(),
+		AuthorizedUserUUIDs: req.GetAuthorizedUserUuids(),
+	}
+}
+
+func InvestorToProto(investor model.Investor) *paymentv1.Investor {
+	return &paymentv1.Investor{
+		Uuid:                investor.UUID,
+		Name:                investor.Name,
+		Available:           money.ToProto(investor.Available),
+		Held:                money.ToProto(investor.Held),
+		Spent:               money.ToProto(investor.Spent),
+		AuthorizedUserUuids: investor.AuthorizedUserUUIDs,
+		CreatedAt:           timestamppb.New(investor.CreatedAt),
+		UpdatedAt:           timestamppb.New(investor.UpdatedAt),
+	}
+}
+
+func InvestorsToProto(investors []model.Investor) []*paymentv1.Investor {
+	result := make([]*paymentv1.Investor, 0, len(investors))
+	for _, investor := range investors {
+		result = append(result, InvestorToProto(investor))
+	}
+	return result
+}
+
+func InvestorMovementsToProto(movements []model.InvestorMovement) []*paymentv1.InvestorMovement {
+	result := make([]*paymentv1.InvestorMovement, 0, len(movements))
+	for _, movement := range movements {
+		result = append(result, &paymentv1.InvestorMovement{
+			Uuid:            movement.UUID,
+			InvestorUuid:    movement.InvestorUUID,
+			Kind:            paymentv1.InvestorMovementKind(movement.Kind),
+			Amount:          money.ToProto(movement.Amount),
+			TransactionUuid: movement.TransactionUUID,
+			UserUuid:        movement.UserUUID,
+			CreatedAt:       timestamppb.New(movement.CreatedAt),
+		})
+	}
+	return result
+}
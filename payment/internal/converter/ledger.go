@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func AccountBalancesFilterFromProto(req *paymentv1.ListAccountBalancesRequest) model.AccountBalancesFilter {
+	return model.AccountBalancesFilter{
+		AccountType: model.LedgerAccountType(req.GetAccountType()),
+		OwnerUUID:   req.GetOwnerUuid(),
+	}
+}
+
+func LedgerAccountToProto(account model.LedgerAccount) *paymentv1.LedgerAccount {
+	return &paymentv1.LedgerAccount{
+		Type:      paymentv1.LedgerAccountType(account.Type),
+		OwnerUuid: account.OwnerUUID,
+	}
+}
+
+func AccountBalancesToProto(balances []model.AccountBalance) []*paymentv1.AccountBalance {
+	result := make([]*paymentv1.AccountBalance, 0, len(balances))
+	for _, balance := range balances {
+		result = append(result, &paymentv1.AccountBalance{
+			Account: LedgerAccountToProto(balance.Account),
+			Debits:  money.ToProto(balance.Debits),
+			Credits: money.ToProto(balance.Credits),
+			Balance: money.ToProto(balance.Balance),
+		})
+	}
+	return result
+}
+
+func JournalEntryToProto(entry model.JournalEntry) *paymentv1.JournalEntry {
+	postings := make([]*paymentv1.Posting, 0, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		postings = append(postings, &paymentv1.Posting{
+			Account:   LedgerAccountToProto(posting.Account),
+			Direction: paymentv1.PostingDirection(posting.Direction),
+			Amount:    money.ToProto(posting.Amount),
+		})
+	}
+
+	return &paymentv1.JournalEntry{
+		Uuid:              entry.UUID,
+		Kind:              paymentv1.JournalEntryKind(entry.Kind),
+		TransactionUuid:   entry.TransactionUUID,
+		RefundUuid:        entry.RefundUUID,
+		DisputeUuid:       entry.DisputeUUID,
+		ReversesEntryUuid: entry.ReversesEntryUUID,
+		Postings:          postings,
+		CreatedAt:         timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func JournalEntriesToProto(entries []model.JournalEntry) []*paymentv1.JournalEntry {
+	result := make([]*paymentv1.JournalEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, JournalEntryToProto(entry))
+	}
+	return result
+}
+
+func LedgerReportToProto(report model.LedgerReport) *paymentv1.CheckLedgerConsistencyResponse {
+	violations := make([]*paymentv1.LedgerViolation, 0, len(report.Violations))
+	for _, violation := range report.Violations {
+		violations = append(violations, &paymentv1.LedgerViolation{
+			EntryUuid:       violation.EntryUUID,
+			TransactionUuid: violation.TransactionUUID,
+			Description:     violation.Description,
+		})
+	}
+
+	return &paymentv1.CheckLedgerConsistencyResponse{
+		Consistent:     len(violations) == 0,
+		EntriesChecked: int64(report.EntriesChecked),
+		Violations:     violations,
+	}
+}
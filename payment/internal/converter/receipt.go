@@ -0,0 +1,71 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func LineItemsFromProto(items []*paymentv1.LineItem) []model.LineItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	result := make([]model.LineItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.LineItem{
+			SKU:       item.GetSku(),
+			Name:      item.GetName(),
+			Quantity:  int(item.GetQuantity()),
+			UnitPrice: money.FromProto(item.GetUnitPrice()),
+		})
+	}
+	return result
+}
+
+func LineItemsToProto(items []model.LineItem) []*paymentv1.LineItem {
+	result := make([]*paymentv1.LineItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &paymentv1.LineItem{
+			Sku:       item.SKU,
+			Name:      item.Name,
+			Quantity:  int32(item.Quantity), // #nosec G115 -- количество задаётся из int32
+			UnitPrice: money.ToProto(item.UnitPrice),
+		})
+	}
+	return result
+}
+
+func ReceiptToProto(receipt model.Receipt) *paymentv1.Receipt {
+	items := make([]*paymentv1.ReceiptItem, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, &paymentv1.ReceiptItem{
+			Sku:                item.SKU,
+			Name:               item.Name,
+			Quantity:           int32(item.Quantity), // #nosec G115 -- количество задаётся из int32
+			UnitPrice:          money.ToProto(item.UnitPrice),
+			Amount:             money.ToProto(item.Amount),
+			TaxRateBasisPoints: item.TaxRateBasisPoints,
+			Tax:                money.ToProto(item.Tax),
+		})
+	}
+
+	return &paymentv1.Receipt{
+		Uuid:             receipt.UUID,
+		TransactionUuid:  receipt.TransactionUUID,
+		OrderUuid:        receipt.OrderUUID,
+		UserUuid:         receipt.UserUUID,
+		PaymentMethod:    paymentv1.PaymentMethod(receipt.PaymentMethod),
+		MaskedCardNumber: receipt.MaskedCardNumber,
+		Items:            items,
+		Total:            money.ToProto(receipt.Total),
+		TaxTotal:         money.ToProto(receipt.TaxTotal),
+		CreditAmount:     money.ToProto(receipt.CreditAmount),
+		PaidAt:           timestamppb.New(receipt.PaidAt),
+		Html:             receipt.HTML,
+		Text:             receipt.Text,
+		CreatedAt:        timestamppb.New(receipt.CreatedAt),
+	}
+}
@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// RefundInfoFromProto преобразует запрос на возврат. Отсутствующая сумма означает возврат всего остатка.
+func RefundInfoFromProto(req *paymentv1.RefundPaymentRequest) model.RefundInfo {
+	info := model.RefundInfo{
+		TransactionUUID: req.GetTransactionUuid(),
+		Reason:          model.RefundReason(req.GetReason()),
+	}
+	if req.GetAmount() != nil {
+		amount := money.FromProto(req.GetAmount())
+		info.Amount = &amount
+	}
+	return info
+}
+
+func RefundToProto(refund model.Refund) *paymentv1.Refund {
+	return &paymentv1.Refund{
+		Uuid:             refund.UUID,
+		TransactionUuid:  refund.TransactionUUID,
+		Amount:           money.ToProto(refund.Amount),
+		WrittenOffAmount: money.ToProto(refund.WrittenOffAmount),
+		Reason:           paymentv1.RefundReason(refund.Reason),
+		Status:           paymentv1.RefundStatus(refund.Status),
+		CreatedAt:        timestamppb.New(refund.CreatedAt),
+	}
+}
+
+func RefundsToProto(refunds []model.Refund) []*paymentv1.Refund {
+	result := make([]*paymentv1.Refund, 0, len(refunds))
+	for _, refund := range refunds {
+		result = append(result, RefundToProto(refund))
+	}
+	return result
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func SBPPaymentIntentInfoFromProto(req *paymentv1.CreateSbpPaymentIntentRequest) model.PayOrderInfo {
+	return model.PayOrderInfo{
+		OrderUUID:     req.GetOrderUuid(),
+		UserUUID:      req.GetUserUuid(),
+		PaymentMethod: model.PaymentMethodSBP,
+		Amount:        money.FromProto(req.GetAmount()),
+		LineItems:     LineItemsFromProto(req.GetLineItems()),
+	}
+}
+
+func SBPPaymentIntentToProto(intent model.SBPPaymentIntent) *paymentv1.SbpPaymentIntent {
+	return &paymentv1.SbpPaymentIntent{
+		Transaction:        TransactionToProto(intent.Transaction),
+		Payload:            intent.Payload,
+		QrImage:            intent.QRImage,
+		QrImageContentType: intent.QRImageContentType,
+	}
+}
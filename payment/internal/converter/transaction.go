@@ -0,0 +1,106 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func PayOrderInfoFromProto(req *paymentv1.PayOrderRequest) model.PayOrderInfo {
+	return model.PayOrderInfo{
+		OrderUUID:             req.GetOrderUuid(),
+		UserUUID:              req.GetUserUuid(),
+		PaymentMethod:         model.PaymentMethod(req.GetPaymentMethod()),
+		Amount:                money.FromProto(req.GetAmount()),
+		InvestorUUID:          req.GetInvestorUuid(),
+		InstallmentTermMonths: int(req.GetInstallmentTermMonths()),
+		PaymentToken:          req.GetPaymentToken(),
+		LineItems:             LineItemsFromProto(req.GetLineItems()),
+	}
+}
+
+func AuthorizeInfoFromProto(req *paymentv1.AuthorizePaymentRequest) model.PayOrderInfo {
+	return model.PayOrderInfo{
+		OrderUUID:             req.GetOrderUuid(),
+		UserUUID:              req.GetUserUuid(),
+		PaymentMethod:         model.PaymentMethod(req.GetPaymentMethod()),
+		Amount:                money.FromProto(req.GetAmount()),
+		InvestorUUID:          req.GetInvestorUuid(),
+		InstallmentTermMonths: int(req.GetInstallmentTermMonths()),
+		PaymentToken:          req.GetPaymentToken(),
+		LineItems:             LineItemsFromProto(req.GetLineItems()),
+	}
+}
+
+func TransactionToProto(transaction model.Transaction) *paymentv1.Transaction {
+	result := &paymentv1.Transaction{
+		Uuid:                  transaction.UUID,
+		OrderUuid:             transaction.OrderUUID,
+		UserUuid:              transaction.UserUUID,
+		PaymentMethod:         paymentv1.PaymentMethod(transaction.PaymentMethod),
+		Status:                paymentv1.TransactionStatus(transaction.Status),
+		Amount:                money.ToProto(transaction.Amount),
+		SettlementAmount:      money.ToProto(transaction.SettlementAmount),
+		ExchangeRate:          transaction.ExchangeRate,
+		RefundedAmount:        money.ToProto(transaction.RefundedAmount),
+		ChargedBackAmount:     money.ToProto(transaction.ChargedBackAmount),
+		CollectedAmount:       money.ToProto(transaction.CollectedAmount),
+		AuthorizedAmount:      money.ToProto(transaction.AuthorizedAmount),
+		DeclineCode:           transaction.DeclineCode,
+		InvestorUuid:          transaction.InvestorUUID,
+		InstallmentTermMonths: int32(transaction.InstallmentTermMonths), // #nosec G115 -- срок рассрочки задаётся из int32
+		PaymentToken:          transaction.PaymentToken,
+		MaskedCardNumber:      transaction.MaskedCardNumber,
+		LineItems:             LineItemsToProto(transaction.LineItems),
+		CreatedAt:             timestamppb.New(transaction.CreatedAt),
+	}
+	if !transaction.AuthorizationExpiresAt.IsZero() {
+		result.AuthorizationExpiresAt = timestamppb.New(transaction.AuthorizationExpiresAt)
+	}
+	if !transaction.CapturedAt.IsZero() {
+		result.CapturedAt = timestamppb.New(transaction.CapturedAt)
+	}
+	return result
+}
+
+func TransactionsToProto(transactions []model.Transaction) []*paymentv1.Transaction {
+	result := make([]*paymentv1.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		result = append(result, TransactionToProto(transaction))
+	}
+	return result
+}
+
+// TransactionsFilterFromProto преобразует protobuf-фильтр в доменный. nil-фильтр означает отсутствие фильтрации.
+func TransactionsFilterFromProto(filter *paymentv1.TransactionsFilter) model.TransactionsFilter {
+	if filter == nil {
+		return model.TransactionsFilter{}
+	}
+
+	methods := make([]model.PaymentMethod, 0, len(filter.GetPaymentMethods()))
+	for _, method := range filter.GetPaymentMethods() {
+		methods = append(methods, model.PaymentMethod(method))
+	}
+
+	statuses := make([]model.TransactionStatus, 0, len(filter.GetStatuses()))
+	for _, status := range filter.GetStatuses() {
+		statuses = append(statuses, model.TransactionStatus(status))
+	}
+
+	result := model.TransactionsFilter{
+		OrderUUIDs:     filter.GetOrderUuids(),
+		UserUUIDs:      filter.GetUserUuids(),
+		PaymentMethods: methods,
+		Statuses:       statuses,
+	}
+	if filter.GetCreatedFrom() != nil {
+		result.CreatedFrom = filter.GetCreatedFrom().AsTime()
+	}
+	if filter.GetCreatedTo() != nil {
+		result.CreatedTo = filter.GetCreatedTo().AsTime()
+	}
+
+	return result
+}
@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+func WebhookSubscriptionInfoFromProto(req *paymentv1.CreateWebhookSubscriptionRequest) model.WebhookSubscriptionInfo {
+	info := model.WebhookSubscriptionInfo{URL: req.GetUrl()}
+	for _, eventType := range req.GetEventTypes() {
+		info.EventTypes = append(info.EventTypes, model.PaymentEventType(eventType))
+	}
+	return info
+}
+
+func WebhookDeliveriesFilterFromProto(req *paymentv1.ListWebhookDeliveriesRequest) model.WebhookDeliveriesFilter {
+	filter := model.WebhookDeliveriesFilter{SubscriptionUUID: req.GetSubscriptionUuid()}
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, model.WebhookDeliveryStatus(status))
+	}
+	return filter
+}
+
+func WebhookSubscriptionToProto(subscription model.WebhookSubscription) *paymentv1.WebhookSubscription {
+	eventTypes := make([]paymentv1.PaymentEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, paymentv1.PaymentEventType(eventType))
+	}
+
+	return &paymentv1.WebhookSubscription{
+		Uuid:       subscription.UUID,
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		Secret:     subscription.Secret,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func WebhookSubscriptionsToProto(subscriptions []model.WebhookSubscription) []*paymentv1.WebhookSubscription {
+	result := make([]*paymentv1.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, WebhookSubscriptionToProto(subscription))
+	}
+	return result
+}
+
+func WebhookDeliveryToProto(delivery model.WebhookDelivery) *paymentv1.WebhookDelivery {
+	result := &paymentv1.WebhookDelivery{
+		Uuid:             delivery.UUID,
+		SubscriptionUuid: delivery.SubscriptionUUID,
+		EventSequence:    delivery.EventSequence,
+		EventType:        paymentv1.PaymentEventType(delivery.EventType),
+		Status:           paymentv1.WebhookDeliveryStatus(delivery.Status),
+		Attempts:         int32(delivery.Attempts), // #nosec G115 -- попыток не больше WebhookMaxAttempts
+		LastError:        delivery.LastError,
+		CreatedAt:        timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:        timestamppb.New(delivery.UpdatedAt),
+	}
+	if !delivery.NextAttemptAt.IsZero() {
+		result.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		result.DeliveredAt = timestamppb.New(delivery.DeliveredAt)
+	}
+	return result
+}
+
+func WebhookDeliveriesToProto(deliveries []model.WebhookDelivery) []*paymentv1.WebhookDelivery {
+	result := make([]*paymentv1.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, WebhookDeliveryToProto(delivery))
+	}
+	return result
+}
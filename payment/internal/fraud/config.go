@@ -0,0 +1,225 @@
+package fraud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// Config задаёт правила антифрода. Нулевой Config пропускает все платежи.
+// Правила проверяются в порядке: чёрные списки, пороги сумм, частота попыток,
+// отклонённые попытки; срабатывает первое нарушенное.
+type Config struct {
+	Blocklists     []BlocklistRule      `json:"blocklists"`
+	AmountLimits   []AmountLimitRule    `json:"amount_limits"`
+	Velocity       []VelocityRule       `json:"velocity"`
+	FailedAttempts []FailedAttemptsRule `json:"failed_attempts"`
+}
+
+// BlocklistRule блокирует платежи перечисленных пользователей и заказов.
+type BlocklistRule struct {
+	Name       string   `json:"name"`
+	UserUUIDs  []string `json:"user_uuids"`
+	OrderUUIDs []string `json:"order_uuids"`
+
+	users  map[string]struct{}
+	orders map[string]struct{}
+}
+
+// AmountLimitRule блокирует платежи больше MaxAmount способами PaymentMethods.
+type AmountLimitRule struct {
+	Name string `json:"name"`
+	// PaymentMethods — CARD, SBP, CREDIT_CARD или INVESTOR_MONEY; пустой список — все способы.
+	PaymentMethods []string `json:"payment_methods"`
+	// CurrencyCode — валюта порога. Платежи в других валютах пересчитываются в неё по курсу,
+	// а платёж в валюте без курса правило отклоняет.
+	CurrencyCode string `json:"currency_code"`
+	// MaxAmount — наибольшая допустимая сумма в виде десятичной строки, например "50000".
+	MaxAmount string `json:"max_amount"`
+
+	methods   map[model.PaymentMethod]struct{}
+	maxAmount money.Money
+}
+
+// VelocityRule ограничивает число попыток оплаты одного пользователя за окно Window.
+type VelocityRule struct {
+	Name string `json:"name"`
+	// PaymentMethods ограничивает подсчёт попытками этими способами; пустой список — все способы.
+	PaymentMethods []string `json:"payment_methods"`
+	Window         Duration `json:"window"`
+	// MaxAttempts — сколько попыток допускается за окно, следующая блокируется.
+	MaxAttempts int `json:"max_attempts"`
+
+	methods map[model.PaymentMethod]struct{}
+}
+
+// FailedAttemptsRule блокирует пользователя, у которого за окно Window провайдер
+// отклонил MaxFailures оплат или больше.
+type FailedAttemptsRule struct {
+	Name        string   `json:"name"`
+	Window      Duration `json:"window"`
+	MaxFailures int      `json:"max_failures"`
+}
+
+// Duration читается из JSON как строка time.ParseDuration, например "10m".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	if parsed <= 0 {
+		return errors.New("duration must be positive")
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+var paymentMethods = map[string]model.PaymentMethod{
+	"CARD":           model.PaymentMethodCard,
+	"SBP":            model.PaymentMethodSBP,
+	"CREDIT_CARD":    model.PaymentMethodCreditCard,
+	"INVESTOR_MONEY": model.PaymentMethodInvestorMoney,
+}
+
+// LoadConfig читает правила антифрода из JSON-файла и проверяет их.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задаёт оператор сервиса
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse fraud config: %w", err)
+	}
+	if err := config.prepare(); err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// prepare проверяет правила и разбирает их условия.
+func (c *Config) prepare() error {
+	for i := range c.Blocklists {
+		if err := c.Blocklists[i].prepare(); err != nil {
+			return fmt.Errorf("blocklist %d (%q): %w", i, c.Blocklists[i].Name, err)
+		}
+	}
+	for i := range c.AmountLimits {
+		if err := c.AmountLimits[i].prepare(); err != nil {
+			return fmt.Errorf("amount limit %d (%q): %w", i, c.AmountLimits[i].Name, err)
+		}
+	}
+	for i := range c.Velocity {
+		if err := c.Velocity[i].prepare(); err != nil {
+			return fmt.Errorf("velocity rule %d (%q): %w", i, c.Velocity[i].Name, err)
+		}
+	}
+	for i, r := range c.FailedAttempts {
+		if err := checkName(r.Name); err != nil {
+			return fmt.Errorf("failed attempts rule %d: %w", i, err)
+		}
+		if r.Window == 0 || r.MaxFailures <= 0 {
+			return fmt.Errorf("failed attempts rule %d (%q): window and max_failures must be positive", i, r.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *BlocklistRule) prepare() error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+
+	var err error
+	if r.users, err = uuidSet(r.UserUUIDs); err != nil {
+		return fmt.Errorf("invalid user_uuids: %w", err)
+	}
+	if r.orders, err = uuidSet(r.OrderUUIDs); err != nil {
+		return fmt.Errorf("invalid order_uuids: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AmountLimitRule) prepare() error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+	if r.CurrencyCode == "" {
+		return errors.New("currency_code must be set")
+	}
+
+	var err error
+	if r.methods, err = methodSet(r.PaymentMethods); err != nil {
+		return err
+	}
+	if r.maxAmount, err = money.Parse(r.CurrencyCode, r.MaxAmount); err != nil {
+		return fmt.Errorf("invalid max_amount: %w", err)
+	}
+	if r.maxAmount.IsNegative() {
+		return errors.New("max_amount must not be negative")
+	}
+
+	return nil
+}
+
+func (r *VelocityRule) prepare() error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+	if r.Window == 0 || r.MaxAttempts <= 0 {
+		return errors.New("window and max_attempts must be positive")
+	}
+
+	var err error
+	r.methods, err = methodSet(r.PaymentMethods)
+	return err
+}
+
+// checkName требует имя правила: оно записывается в решение и возвращается клиенту.
+func checkName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("name must be set")
+	}
+	return nil
+}
+
+func methodSet(names []string) (map[model.PaymentMethod]struct{}, error) {
+	methods := make(map[model.PaymentMethod]struct{}, len(names))
+	for _, name := range names {
+		method, ok := paymentMethods[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown payment method %q", name)
+		}
+		methods[method] = struct{}{}
+	}
+	return methods, nil
+}
+
+func uuidSet(values []string) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if err := uuid.Validate(value); err != nil {
+			return nil, fmt.Errorf("%q is not a UUID", value)
+		}
+		set[strings.ToLower(value)] = struct{}{}
+	}
+	return set, nil
+}
@@ -0,0 +1,224 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// Screener проверяет попытки оплаты правилами антифрода. Правила перечитываются
+// из файла при его изменении, действующие правила заменяются атомарно.
+type Screener struct {
+	path   string
+	config atomic.Pointer[Config]
+	// rates пересчитывает сумму платежа в валюту порога правил AmountLimits.
+	rates *currency.Rates
+
+	// modTime и size — отметка прочитанной версии файла, меняются только в Run.
+	modTime time.Time
+	size    int64
+}
+
+// History — недавняя история пользователя, по которой считаются частотные правила.
+type History struct {
+	// Attempts — решения по предыдущим попыткам пользователя за HistoryWindow.
+	Attempts []model.FraudDecision
+	// Failures — время отклонённых провайдером оплат пользователя за HistoryWindow.
+	Failures []time.Time
+}
+
+// NewScreener создаёт антифрод с правилами из файла path. Пустой path означает
+// отсутствие правил: все платежи пропускаются, но решения всё равно записываются.
+// По таблице rates суммы платежей пересчитываются в валюту порогов.
+func NewScreener(path string, rates *currency.Rates) (*Screener, error) {
+	s := &Screener{path: path, rates: rates}
+	s.config.Store(&Config{})
+
+	if path == "" {
+		return s, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	s.config.Store(&config)
+	s.modTime, s.size = info.ModTime(), info.Size()
+
+	return s, nil
+}
+
+// Run периодически проверяет файл правил и перечитывает его при изменении до отмены ctx.
+// Если новая версия некорректна, продолжают действовать прежние правила.
+func (s *Screener) Run(ctx context.Context, interval time.Duration) {
+	if s.path == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.reload()
+		}
+	}
+}
+
+func (s *Screener) reload() {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		log.Printf("failed to stat fraud config %s: %v", s.path, err)
+		return
+	}
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+
+	config, err := LoadConfig(s.path)
+	if err != nil {
+		log.Printf("failed to reload fraud config %s, keeping previous rules: %v", s.path, err)
+		return
+	}
+	s.config.Store(&config)
+
+	log.Printf("Правила антифрода перечитаны из %s", s.path)
+}
+
+// HistoryWindow возвращает самое длинное окно частотных правил: историю старше него
+// передавать в Screen не нужно. Ноль означает, что история не используется.
+func (s *Screener) HistoryWindow() time.Duration {
+	config := s.config.Load()
+
+	var window time.Duration
+	for _, r := range config.Velocity {
+		window = max(window, time.Duration(r.Window))
+	}
+	for _, r := range config.FailedAttempts {
+		window = max(window, time.Duration(r.Window))
+	}
+
+	return window
+}
+
+// Screen проверяет попытку оплаты info в момент now и возвращает решение.
+// UUID и время решения заполняет вызывающий.
+func (s *Screener) Screen(info model.PayOrderInfo, history History, now time.Time) model.FraudDecision {
+	decision := model.FraudDecision{
+		OrderUUID:     info.OrderUUID,
+		UserUUID:      info.UserUUID,
+		PaymentMethod: info.PaymentMethod,
+		Amount:        info.Amount,
+		Outcome:       model.FraudOutcomeAllow,
+	}
+
+	block := func(reason model.FraudReason, rule, description string) model.FraudDecision {
+		decision.Outcome = model.FraudOutcomeBlock
+		decision.Reason = reason
+		decision.Rule = rule
+		decision.Description = description
+		return decision
+	}
+
+	config := s.config.Load()
+	user := strings.ToLower(info.UserUUID)
+	order := strings.ToLower(info.OrderUUID)
+
+	for _, r := range config.Blocklists {
+		if _, ok := r.users[user]; ok {
+			return block(model.FraudReasonBlocklist, r.Name, "user is blocklisted")
+		}
+		if _, ok := r.orders[order]; ok {
+			return block(model.FraudReasonBlocklist, r.Name, "order is blocklisted")
+		}
+	}
+
+	for _, r := range config.AmountLimits {
+		if !matchesMethod(r.methods, info.PaymentMethod) {
+			continue
+		}
+		// Платёж, который нельзя сравнить с порогом, не должен обходить правило сменой валюты.
+		amount, err := s.convert(info.Amount, r.maxAmount.CurrencyCode, now)
+		if err != nil {
+			return block(model.FraudReasonAmountLimit, r.Name,
+				fmt.Sprintf("amount in %s cannot be compared with %s: %v", info.Amount.CurrencyCode, r.maxAmount, err))
+		}
+		if amount.Cmp(r.maxAmount) > 0 {
+			return block(model.FraudReasonAmountLimit, r.Name, fmt.Sprintf("amount %s exceeds %s", amount, r.maxAmount))
+		}
+	}
+
+	for _, r := range config.Velocity {
+		if !matchesMethod(r.methods, info.PaymentMethod) {
+			continue
+		}
+		from := now.Add(-time.Duration(r.Window))
+		attempts := 0
+		for _, attempt := range history.Attempts {
+			if !attempt.CreatedAt.Before(from) && matchesMethod(r.methods, attempt.PaymentMethod) {
+				attempts++
+			}
+		}
+		if attempts >= r.MaxAttempts {
+			return block(model.FraudReasonVelocityLimit, r.Name,
+				fmt.Sprintf("%d payment attempts within %s", attempts, time.Duration(r.Window)))
+		}
+	}
+
+	for _, r := range config.FailedAttempts {
+		from := now.Add(-time.Duration(r.Window))
+		failures := 0
+		for _, failedAt := range history.Failures {
+			if !failedAt.Before(from) {
+				failures++
+			}
+		}
+		if failures >= r.MaxFailures {
+			return block(model.FraudReasonFailedAttempts, r.Name,
+				fmt.Sprintf("%d declined payments within %s", failures, time.Duration(r.Window)))
+		}
+	}
+
+	return decision
+}
+
+// convert пересчитывает amount в валюту to по курсу, действующему в момент at.
+func (s *Screener) convert(amount money.Money, to string, at time.Time) (money.Money, error) {
+	if amount.CurrencyCode == to {
+		return amount, nil
+	}
+	if s.rates == nil {
+		return money.Money{}, fmt.Errorf("%w: no exchange rates", currency.ErrUnknownCurrency)
+	}
+
+	conversion, err := s.rates.Convert(amount.Rat(), amount.CurrencyCode, to, at)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.FromRat(to, conversion.Amount), nil
+}
+
+// matchesMethod сообщает, что правило с набором methods применяется к способу оплаты method.
+func matchesMethod(methods map[model.PaymentMethod]struct{}, method model.PaymentMethod) bool {
+	if len(methods) == 0 {
+		return true
+	}
+	_, ok := methods[method]
+	return ok
+}
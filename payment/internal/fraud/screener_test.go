@@ -0,0 +1,185 @@
+package fraud
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+const (
+	testUserUUID  = "0d9e8f7a-6b5c-4d3e-8f1a-2b3c4d5e6f70"
+	testOrderUUID = "6f1c1d0e-8a8b-4c53-9d4e-0b1a2c3d4e5f"
+)
+
+const testConfig = `{
+  "blocklists": [{"name": "banned", "order_uuids": ["7A2B3C4D-5E6F-4A1B-8C2D-3E4F5A6B7C8D"]}],
+  "amount_limits": [
+    {"name": "card limit", "payment_methods": ["CARD"], "currency_code": "RUB", "max_amount": "50000"}
+  ],
+  "velocity": [{"name": "sbp burst", "payment_methods": ["SBP"], "window": "10m", "max_attempts": 2}],
+  "failed_attempts": [{"name": "declines", "window": "1h", "max_failures": 3}]
+}`
+
+// newTestScreener создаёт антифрод с правилами testConfig и курсом доллара 90 рублей.
+func newTestScreener(t *testing.T) *Screener {
+	t.Helper()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "fraud.json")
+	ratesPath := filepath.Join(dir, "rates.json")
+	rates := `{"base_currency": "RUB", "rates": [{"currency_code": "USD", "rate": "90", "effective_from": "2026-01-01"}]}`
+	if err := os.WriteFile(configPath, []byte(testConfig), 0o600); err != nil {
+		t.Fatalf("failed to write fraud config: %v", err)
+	}
+	if err := os.WriteFile(ratesPath, []byte(rates), 0o600); err != nil {
+		t.Fatalf("failed to write rates: %v", err)
+	}
+
+	table, err := currency.LoadRates(ratesPath)
+	if err != nil {
+		t.Fatalf("LoadRates() error = %v", err)
+	}
+	s, err := NewScreener(configPath, table)
+	if err != nil {
+		t.Fatalf("NewScreener() error = %v", err)
+	}
+	return s
+}
+
+func TestScreen(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	recent := []model.FraudDecision{
+		{PaymentMethod: model.PaymentMethodSBP, CreatedAt: now.Add(-time.Minute)},
+		{PaymentMethod: model.PaymentMethodSBP, CreatedAt: now.Add(-2 * time.Minute)},
+	}
+
+	tests := []struct {
+		name        string
+		orderUUID   string
+		method      model.PaymentMethod
+		currency    string
+		amount      string
+		history     History
+		wantOutcome model.FraudOutcome
+		wantReason  model.FraudReason
+	}{
+		{
+			name:        "within limit",
+			amount:      "50000",
+			wantOutcome: model.FraudOutcomeAllow,
+		},
+		{
+			name:        "over limit",
+			amount:      "50000.01",
+			wantOutcome: model.FraudOutcomeBlock,
+			wantReason:  model.FraudReasonAmountLimit,
+		},
+		{
+			name:        "blocklisted order in other case",
+			orderUUID:   "7a2b3c4d-5e6f-4a1b-8c2d-3e4f5a6b7c8d",
+			amount:      "1",
+			wantOutcome: model.FraudOutcomeBlock,
+			wantReason:  model.FraudReasonBlocklist,
+		},
+		{
+			name:        "converted amount within limit",
+			currency:    "USD",
+			amount:      "555.55",
+			wantOutcome: model.FraudOutcomeAllow,
+		},
+		{
+			name:        "converted amount over limit",
+			currency:    "USD",
+			amount:      "555.56",
+			wantOutcome: model.FraudOutcomeBlock,
+			wantReason:  model.FraudReasonAmountLimit,
+		},
+		{
+			name:        "currency without rate",
+			currency:    "EUR",
+			amount:      "1",
+			wantOutcome: model.FraudOutcomeBlock,
+			wantReason:  model.FraudReasonAmountLimit,
+		},
+		{
+			name:        "limit of another method",
+			method:      model.PaymentMethodSBP,
+			amount:      "1000000",
+			wantOutcome: model.FraudOutcomeAllow,
+		},
+		{
+			name:        "velocity",
+			method:      model.PaymentMethodSBP,
+			amount:      "1",
+			history:     History{Attempts: recent},
+			wantOutcome: model.FraudOutcomeBlock,
+			wantReason:  model.FraudReasonVelocityLimit,
+		},
+		{
+			name:        "velocity counts only its methods",
+			amount:      "1",
+			history:     History{Attempts: recent},
+			wantOutcome: model.FraudOutcomeAllow,
+		},
+		{
+			name:   "failed attempts",
+			amount: "1",
+			history: History{Failures: []time.Time{
+				now.Add(-time.Minute), now.Add(-10 * time.Minute), now.Add(-59 * time.Minute),
+			}},
+			wantOutcome: model.FraudOutcomeBlock,
+			wantReason:  model.FraudReasonFailedAttempts,
+		},
+		{
+			name:   "failed attempts outside the window",
+			amount: "1",
+			history: History{Failures: []time.Time{
+				now.Add(-time.Minute), now.Add(-10 * time.Minute), now.Add(-61 * time.Minute),
+			}},
+			wantOutcome: model.FraudOutcomeAllow,
+		},
+	}
+
+	s := newTestScreener(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := model.PayOrderInfo{
+				OrderUUID:     testOrderUUID,
+				UserUUID:      testUserUUID,
+				PaymentMethod: model.PaymentMethodCard,
+			}
+			if tt.orderUUID != "" {
+				info.OrderUUID = tt.orderUUID
+			}
+			if tt.method != model.PaymentMethodUnspecified {
+				info.PaymentMethod = tt.method
+			}
+			code := "RUB"
+			if tt.currency != "" {
+				code = tt.currency
+			}
+			amount, err := money.Parse(code, tt.amount)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.amount, err)
+			}
+			info.Amount = amount
+
+			decision := s.Screen(info, tt.history, now)
+			if decision.Outcome != tt.wantOutcome || decision.Reason != tt.wantReason {
+				t.Fatalf("Screen() = %v/%v (%s), want %v/%v",
+					decision.Outcome, decision.Reason, decision.Description, tt.wantOutcome, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestHistoryWindow(t *testing.T) {
+	if got := newTestScreener(t).HistoryWindow(); got != time.Hour {
+		t.Fatalf("HistoryWindow() = %v, want %v", got, time.Hour)
+	}
+}
@@ -0,0 +1,104 @@
+package installment
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+const (
+	// minorUnitNanos — нано-единиц в сотой доле валюты: платежи округляются до копеек.
+	minorUnitNanos     = 10_000_000
+	basisPointsPerUnit = 10_000
+	monthsPerYear      = 12
+)
+
+// Schedule рассчитывает аннуитетный график: платежи равны, а проценты в каждом
+// начисляются на остаток долга по месячной ставке annualRateBasisPoints/12.
+// Платежи и проценты округляются до копеек, последний платёж гасит остаток точно,
+// поэтому основной долг по графику совпадает с principal до нано-единицы.
+// Первый платёж приходится на start, следующие — через period, а при нулевом
+// period — через календарный месяц.
+func Schedule(
+	principal money.Money,
+	termMonths int,
+	annualRateBasisPoints int64,
+	start time.Time,
+	period time.Duration,
+) model.InstallmentQuote {
+	currency := principal.CurrencyCode
+	rate := big.NewRat(annualRateBasisPoints, basisPointsPerUnit*monthsPerYear)
+	remaining := principal.TotalNanos()
+	payment := roundToMinorUnit(annuity(new(big.Rat).SetInt(remaining), rate, termMonths))
+
+	quote := model.InstallmentQuote{
+		Principal:             principal,
+		TermMonths:            termMonths,
+		AnnualRateBasisPoints: annualRateBasisPoints,
+		Installments:          make([]model.Installment, 0, termMonths),
+	}
+	totalInterest := new(big.Int)
+	total := new(big.Int)
+	for i := range termMonths {
+		interest := roundToMinorUnit(new(big.Rat).Mul(new(big.Rat).SetInt(remaining), rate))
+		part := new(big.Int).Sub(payment, interest)
+		if i == termMonths-1 || part.Cmp(remaining) > 0 {
+			part.Set(remaining)
+		}
+		remaining.Sub(remaining, part)
+		amount := new(big.Int).Add(part, interest)
+
+		totalInterest.Add(totalInterest, interest)
+		total.Add(total, amount)
+		quote.Installments = append(quote.Installments, model.Installment{
+			Number:    i + 1,
+			DueAt:     dueAt(start, period, i),
+			Principal: money.FromNanos(currency, part),
+			Interest:  money.FromNanos(currency, interest),
+			Amount:    money.FromNanos(currency, amount),
+			Status:    model.InstallmentStatusScheduled,
+		})
+	}
+	quote.TotalInterest = money.FromNanos(currency, totalInterest)
+	quote.Total = money.FromNanos(currency, total)
+
+	return quote
+}
+
+// annuity возвращает точный размер платежа P·r·(1+r)^n / ((1+r)^n − 1),
+// а при нулевой ставке — P/n.
+func annuity(principal, rate *big.Rat, n int) *big.Rat {
+	if rate.Sign() == 0 {
+		return new(big.Rat).Quo(principal, big.NewRat(int64(n), 1))
+	}
+
+	growth := big.NewRat(1, 1)
+	base := new(big.Rat).Add(big.NewRat(1, 1), rate)
+	for range n {
+		growth.Mul(growth, base)
+	}
+
+	payment := new(big.Rat).Mul(principal, rate)
+	payment.Mul(payment, growth)
+	return payment.Quo(payment, growth.Sub(growth, big.NewRat(1, 1)))
+}
+
+// roundToMinorUnit округляет неотрицательное количество нано-единиц до копеек половиной вверх.
+func roundToMinorUnit(nanos *big.Rat) *big.Int {
+	minorUnits := new(big.Rat).Quo(nanos, big.NewRat(minorUnitNanos, 1))
+	// floor((2·num + den) / (2·den)) — округление половиной вверх для неотрицательных чисел.
+	num := new(big.Int).Lsh(minorUnits.Num(), 1)
+	num.Add(num, minorUnits.Denom())
+	den := new(big.Int).Lsh(minorUnits.Denom(), 1)
+	rounded := num.Quo(num, den)
+	return rounded.Mul(rounded, big.NewInt(minorUnitNanos))
+}
+
+func dueAt(start time.Time, period time.Duration, index int) time.Time {
+	if period > 0 {
+		return start.Add(time.Duration(index) * period)
+	}
+	return start.AddDate(0, index, 0)
+}
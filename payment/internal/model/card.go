@@ -0,0 +1,114 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type CardBrand int32
+
+const (
+	// CardBrandUnspecified — платёжная система по номеру карты не распознана.
+	CardBrandUnspecified CardBrand = iota
+	CardBrandVisa
+	CardBrandMastercard
+	CardBrandMir
+	CardBrandAmex
+	CardBrandUnionPay
+	CardBrandJCB
+)
+
+// CardInfo описывает карту, которую пользователь сохраняет для оплаты по токену.
+type CardInfo struct {
+	UserUUID string
+	// Number — номер карты (PAN). Хранится только в зашифрованном виде и не выводится в лог.
+	Number      string
+	ExpiryMonth int
+	ExpiryYear  int
+}
+
+// String скрывает номер карты, чтобы он не попал в лог даже при выводе всей структуры.
+func (c CardInfo) String() string {
+	return fmt.Sprintf("{UserUUID:%s Number:%s Expiry:%02d/%d}", c.UserUUID, MaskCardNumber(c.Number), c.ExpiryMonth, c.ExpiryYear)
+}
+
+// GoString скрывает номер карты при выводе через %#v.
+func (c CardInfo) GoString() string {
+	return c.String()
+}
+
+// PaymentCard — сохранённая карта. Номер хранится только зашифрованным в Sealed,
+// остальные поля — замаскированные данные, которые можно показывать пользователю.
+type PaymentCard struct {
+	// Token — непрозрачный токен, по которому карта оплачивает заказы.
+	Token    string
+	UserUUID string
+	Brand    CardBrand
+	// MaskedNumber — первые шесть и последние четыре цифры номера, например "411111******1111".
+	MaskedNumber string
+	Last4        string
+	ExpiryMonth  int
+	ExpiryYear   int
+	Sealed       SealedData
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Expired сообщает, что к моменту now срок действия карты закончился. Карта действует
+// до конца месяца, указанного на ней.
+func (c PaymentCard) Expired(now time.Time) bool {
+	end := time.Date(c.ExpiryYear, time.Month(c.ExpiryMonth)+1, 1, 0, 0, 0, 0, time.UTC)
+	return !now.Before(end)
+}
+
+// SealedData — данные, зашифрованные AES-GCM ключом KeyID из связки ключей.
+type SealedData struct {
+	KeyID      string
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// CardDetails — расшифрованные данные сохранённой карты для платёжного провайдера.
+type CardDetails struct {
+	Number      string
+	ExpiryMonth int
+	ExpiryYear  int
+}
+
+// String скрывает номер карты, чтобы он не попал в лог вместе с запросом к провайдеру.
+func (c CardDetails) String() string {
+	return fmt.Sprintf("{Number:%s Expiry:%02d/%d}", MaskCardNumber(c.Number), c.ExpiryMonth, c.ExpiryYear)
+}
+
+// GoString скрывает номер карты при выводе через %#v.
+func (c CardDetails) GoString() string {
+	return c.String()
+}
+
+// CardReencryption — итог перешифрования сохранённых карт активным ключом.
+type CardReencryption struct {
+	ActiveKeyID string
+	// Reencrypted — сколько карт было зашифровано другими ключами.
+	Reencrypted int
+}
+
+// MaskCardNumber скрывает номер карты так, чтобы под маской оставалось не меньше шести цифр.
+// У номеров от 16 цифр видны первые шесть и последние четыре ("411111******1111"),
+// у более коротких — только последние четыре. Номера, где и это открыло бы слишком много,
+// маскируются целиком.
+func MaskCardNumber(number string) string {
+	const (
+		minHidden = 6
+		bin       = 6
+		last      = 4
+	)
+	switch {
+	case len(number) >= 16:
+		return number[:bin] + strings.Repeat("*", len(number)-bin-last) + number[len(number)-last:]
+	case len(number)-last >= minHidden:
+		return strings.Repeat("*", len(number)-last) + number[len(number)-last:]
+	default:
+		return strings.Repeat("*", len(number))
+	}
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMaskCardNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+	}{
+		{number: "4111111111111111", want: "411111******1111"},
+		{number: "6759649826438453113", want: "675964*********3113"},
+		{number: "378282246310005", want: "***********0005"},
+		{number: "4111111111", want: "******1111"},
+		{number: "411111111", want: "*********"},
+		{number: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := MaskCardNumber(tt.number); got != tt.want {
+			t.Errorf("MaskCardNumber(%q) = %q, want %q", tt.number, got, tt.want)
+		}
+	}
+}
+
+func TestCardInfoString(t *testing.T) {
+	const number = "4111111111111111"
+
+	card := CardInfo{UserUUID: "0d9e8f7a-6b5c-4d3e-8f1a-2b3c4d5e6f70", Number: number, ExpiryMonth: 1, ExpiryYear: 2030}
+	details := CardDetails{Number: number, ExpiryMonth: 1, ExpiryYear: 2030}
+
+	// Номер не попадает в вывод ни при каком формате, в том числе внутри других структур.
+	for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+		for _, value := range []any{card, details, &card, struct{ Card CardInfo }{card}} {
+			if got := fmt.Sprintf(format, value); strings.Contains(got, number) {
+				t.Errorf("Sprintf(%q) = %s contains the card number", format, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type DisputeReason int32
+
+const (
+	DisputeReasonUnspecified DisputeReason = iota
+	// DisputeReasonFraudulent — покупатель не совершал платёж.
+	DisputeReasonFraudulent
+	DisputeReasonProductNotReceived
+	// DisputeReasonProductUnacceptable — заказ с браком или не соответствует описанию.
+	DisputeReasonProductUnacceptable
+	DisputeReasonDuplicate
+	// DisputeReasonCreditNotProcessed — обещанный возврат не проведён.
+	DisputeReasonCreditNotProcessed
+	DisputeReasonGeneral
+)
+
+type DisputeStatus int32
+
+const (
+	DisputeStatusUnspecified DisputeStatus = iota
+	// DisputeStatusNeedsResponse — спор ждёт доказательств или согласия магазина.
+	DisputeStatusNeedsResponse
+	// DisputeStatusUnderReview — доказательства отправлены, эмитент их рассматривает.
+	DisputeStatusUnderReview
+	DisputeStatusWon
+	// DisputeStatusLost — спорная сумма возвращена покупателю.
+	DisputeStatusLost
+)
+
+// IsResolved сообщает, что по спору принято окончательное решение.
+func (s DisputeStatus) IsResolved() bool {
+	return s == DisputeStatusWon || s == DisputeStatusLost
+}
+
+type DisputeOutcome int32
+
+const (
+	DisputeOutcomeUnspecified DisputeOutcome = iota
+	DisputeOutcomeWon
+	DisputeOutcomeLost
+)
+
+// DisputeInfo описывает спор, открытый эмитентом. Пустой Amount означает
+// всю ещё не возвращённую сумму транзакции.
+type DisputeInfo struct {
+	TransactionUUID string
+	Amount          *money.Money
+	Reason          DisputeReason
+}
+
+// Dispute — опротестование списания эмитентом карты по заявлению покупателя (chargeback).
+type Dispute struct {
+	UUID            string
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	// Amount — спорная сумма. Пока спор не решён, её нельзя вернуть покупателю.
+	Amount money.Money
+	Reason DisputeReason
+	Status DisputeStatus
+	// EvidenceDueBy — срок, до которого магазин может оспорить спор доказательствами.
+	EvidenceDueBy time.Time
+	// Evidence заполняется, когда магазин оспорил спор.
+	Evidence   *DisputeEvidence
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ResolvedAt time.Time
+}
+
+// DisputeEvidence — ответ магазина на спор.
+type DisputeEvidence struct {
+	Description    string
+	TrackingNumber string
+	DocumentURLs   []string
+	SubmittedAt    time.Time
+}
+
+// DisputesFilter задаёт условия выборки споров. Пустые поля не применяются.
+type DisputesFilter struct {
+	TransactionUUID string
+	Statuses        []DisputeStatus
+}
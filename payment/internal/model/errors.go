@@ -0,0 +1,47 @@
+package model
+
+import "errors"
+
+var (
+	ErrInvalidUUID                  = errors.New("invalid uuid")
+	ErrPaymentMethodNotSupported    = errors.New("payment method is not supported")
+	ErrInvalidAmount                = errors.New("invalid amount")
+	ErrTransactionNotFound          = errors.New("transaction is not found")
+	ErrTransactionAlreadyExists     = errors.New("transaction already exists")
+	ErrIdempotencyKeyNotFound       = errors.New("idempotency key is not found")
+	ErrIdempotencyKeyReused         = errors.New("idempotency key is reused with a different request")
+	ErrRefundNotFound               = errors.New("refund is not found")
+	ErrRefundExceedsCaptured        = errors.New("refund exceeds captured amount")
+	ErrTransactionNotRefundable     = errors.New("transaction cannot be refunded")
+	ErrAuthorizationExpired         = errors.New("authorization is expired")
+	ErrInvalidTransactionState      = errors.New("invalid transaction state")
+	ErrPaymentDeclined              = errors.New("payment is declined")
+	ErrProviderUnavailable          = errors.New("payment provider is unavailable")
+	ErrUnbalancedEntry              = errors.New("journal entry is not balanced")
+	ErrInvestorNotFound             = errors.New("investor is not found")
+	ErrInvalidInvestor              = errors.New("invalid investor")
+	ErrPaymentIntentExpired         = errors.New("payment intent is expired")
+	ErrPaymentBlocked               = errors.New("payment is blocked by fraud screening")
+	ErrInvalidInstallmentTerm       = errors.New("invalid installment term")
+	ErrInstallmentPlanNotFound      = errors.New("installment plan is not found")
+	ErrInstallmentPlanAlreadyExists = errors.New("installment plan already exists")
+	ErrInvalidWebhookSubscription   = errors.New("invalid webhook subscription")
+	ErrWebhookSubscriptionNotFound  = errors.New("webhook subscription is not found")
+	ErrWebhookDeliveryNotFound      = errors.New("webhook delivery is not found")
+	ErrInvalidCard                  = errors.New("invalid card")
+	ErrCardNotFound                 = errors.New("card is not found")
+	ErrCardExpired                  = errors.New("card is expired")
+	ErrCardVaultUnavailable         = errors.New("card vault is not configured")
+	ErrPaymentTokenNotAllowed       = errors.New("payment token is accepted only for card payments")
+	ErrSBPAuthorizationNotAllowed   = errors.New("SBP payments are paid by QR code and cannot be authorized")
+	ErrInvalidLineItems             = errors.New("invalid line items")
+	ErrReceiptNotFound              = errors.New("receipt is not found")
+	ErrReceiptAlreadyExists         = errors.New("receipt already exists")
+	ErrDisputeNotFound              = errors.New("dispute is not found")
+	ErrDisputeAlreadyExists         = errors.New("dispute already exists")
+	ErrTransactionNotDisputable     = errors.New("transaction cannot be disputed")
+	ErrDisputeAlreadyOpen           = errors.New("transaction already has an open dispute")
+	ErrInvalidDisputeState          = errors.New("invalid dispute state")
+	ErrDisputeEvidenceOverdue       = errors.New("dispute evidence deadline has passed")
+	ErrInvalidDisputeEvidence       = errors.New("invalid dispute evidence")
+)
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type PaymentEventType int32
+
+const (
+	PaymentEventTypeUnspecified PaymentEventType = iota
+	PaymentEventTypeAuthorized
+	PaymentEventTypeCaptured
+	// PaymentEventTypeFailed — оплата не состоялась: провайдер отклонил авторизацию
+	// или авторизация и платёж СБП истекли.
+	PaymentEventTypeFailed
+	PaymentEventTypeRefunded
+	PaymentEventTypeVoided
+	// PaymentEventTypeDisputeOpened — эмитент опротестовал списание.
+	PaymentEventTypeDisputeOpened
+	PaymentEventTypeDisputeWon
+	// PaymentEventTypeDisputeLost — спор проигран, спорная сумма возвращена покупателю.
+	PaymentEventTypeDisputeLost
+	// PaymentEventTypeInstallmentCharged — планировщик списал очередной платёж рассрочки.
+	PaymentEventTypeInstallmentCharged
+)
+
+// Причины события FAILED, кроме кодов отказа провайдера.
+const (
+	PaymentFailureAuthorizationExpired = "authorization_expired"
+	PaymentFailureIntentExpired        = "payment_intent_expired"
+)
+
+// PaymentEvent — изменение транзакции, о котором узнают подписчики потока событий.
+type PaymentEvent struct {
+	// Sequence — номер события. Номера возрастают с 1 без пропусков, поэтому
+	// подписчик может продолжить чтение с последнего полученного номера.
+	Sequence        int64
+	Type            PaymentEventType
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   PaymentMethod
+	// TransactionStatus — статус транзакции после события.
+	TransactionStatus TransactionStatus
+	// Amount — сумма события: авторизованная, списанная, возвращённая, освобождённая или спорная,
+	// а для INSTALLMENT_CHARGED — списанный платёж рассрочки вместе с процентами.
+	Amount money.Money
+	// RefundUUID заполняется для события REFUNDED.
+	RefundUUID string
+	// DisputeUUID заполняется для событий DISPUTE_*.
+	DisputeUUID string
+	// Reason — причина события FAILED: код отказа провайдера или одна из PaymentFailure*.
+	Reason    string
+	CreatedAt time.Time
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+	"time"
+)
+
+type FraudOutcome int32
+
+const (
+	FraudOutcomeUnspecified FraudOutcome = iota
+	FraudOutcomeAllow
+	FraudOutcomeBlock
+)
+
+// FraudReason — вид правила антифрода, заблокировавшего платёж.
+type FraudReason int32
+
+const (
+	FraudReasonUnspecified FraudReason = iota
+	// FraudReasonBlocklist — пользователь или заказ в чёрном списке.
+	FraudReasonBlocklist
+	// FraudReasonAmountLimit — сумма превышает порог для способа оплаты.
+	FraudReasonAmountLimit
+	// FraudReasonVelocityLimit — слишком много попыток оплаты пользователя за окно.
+	FraudReasonVelocityLimit
+	// FraudReasonFailedAttempts — слишком много отклонённых провайдером оплат пользователя за окно.
+	FraudReasonFailedAttempts
+)
+
+func (r FraudReason) String() string {
+	switch r {
+	case FraudReasonBlocklist:
+		return "BLOCKLIST"
+	case FraudReasonAmountLimit:
+		return "AMOUNT_LIMIT"
+	case FraudReasonVelocityLimit:
+		return "VELOCITY_LIMIT"
+	case FraudReasonFailedAttempts:
+		return "FAILED_ATTEMPTS"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// FraudDecision — решение антифрода по попытке оплаты. Для заблокированной попытки
+// Reason и Rule указывают сработавшее правило.
+type FraudDecision struct {
+	UUID          string
+	OrderUUID     string
+	UserUUID      string
+	PaymentMethod PaymentMethod
+	Amount        money.Money
+	Outcome       FraudOutcome
+	Reason        FraudReason
+	// Rule — имя сработавшего правила из конфигурации.
+	Rule string
+	// Description поясняет, почему правило сработало.
+	Description string
+	CreatedAt   time.Time
+}
+
+// FraudDecisionsFilter задаёт условия выборки решений антифрода. Пустые поля не применяются.
+type FraudDecisionsFilter struct {
+	UserUUID  string
+	OrderUUID string
+	Outcome   FraudOutcome
+	// CreatedFrom — нижняя граница времени решения включительно.
+	CreatedFrom time.Time
+}
+
+// FraudError — оплата заблокирована правилом антифрода.
+type FraudError struct {
+	Reason FraudReason
+	Rule   string
+}
+
+func (e *FraudError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ErrPaymentBlocked, e.Reason, e.Rule)
+}
+
+func (e *FraudError) Unwrap() error {
+	return ErrPaymentBlocked
+}
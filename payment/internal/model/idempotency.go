@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// IdempotencyRecord связывает ключ идемпотентности с транзакцией, созданной по первому запросу.
+type IdempotencyRecord struct {
+	Key string
+	// RequestHash — отпечаток полезной нагрузки запроса для обнаружения повторов с другими данными.
+	RequestHash     string
+	TransactionUUID string
+	ExpiresAt       time.Time
+}
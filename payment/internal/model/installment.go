@@ -0,0 +1,88 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type InstallmentPlanStatus int32
+
+const (
+	InstallmentPlanStatusUnspecified InstallmentPlanStatus = iota
+	// InstallmentPlanStatusActive — платежи вносятся по графику.
+	InstallmentPlanStatusActive
+	// InstallmentPlanStatusDelinquent — есть пропущенные и ещё не погашенные платежи.
+	InstallmentPlanStatusDelinquent
+	// InstallmentPlanStatusDefaulted — пропущено слишком много платежей, списания прекращены.
+	InstallmentPlanStatusDefaulted
+	// InstallmentPlanStatusCompleted — все платежи внесены.
+	InstallmentPlanStatusCompleted
+	// InstallmentPlanStatusCancelled — оплата возвращена, списания прекращены.
+	InstallmentPlanStatusCancelled
+)
+
+type InstallmentStatus int32
+
+const (
+	InstallmentStatusUnspecified InstallmentStatus = iota
+	// InstallmentStatusScheduled — срок платежа ещё не наступил или списание повторяется в льготный период.
+	InstallmentStatusScheduled
+	InstallmentStatusPaid
+	// InstallmentStatusMissed — платёж не удалось списать до конца льготного периода.
+	// Планировщик продолжает попытки, пока план не признан дефолтным.
+	InstallmentStatusMissed
+)
+
+// InstallmentQuote — расчёт рассрочки: аннуитетный график с процентами.
+type InstallmentQuote struct {
+	Principal  money.Money
+	TermMonths int
+	// AnnualRateBasisPoints — годовая ставка в базисных пунктах (1 б.п. = 0,01%).
+	AnnualRateBasisPoints int64
+	// Installments — платежи графика. Срок первого платежа — момент оплаты.
+	Installments  []Installment
+	TotalInterest money.Money
+	// Total — сумма всех платежей: основной долг и проценты.
+	Total money.Money
+}
+
+// Installment — один платёж графика рассрочки.
+type Installment struct {
+	// Number — номер платежа, начиная с 1.
+	Number    int
+	DueAt     time.Time
+	Principal money.Money
+	Interest  money.Money
+	// Amount — сумма платежа: Principal + Interest.
+	Amount money.Money
+	Status InstallmentStatus
+	PaidAt time.Time
+	// Attempts — число неудачных попыток списания.
+	Attempts int
+	// NextAttemptAt — время следующей попытки после неудачного списания.
+	NextAttemptAt   time.Time
+	LastDeclineCode string
+}
+
+// InstallmentPlan — рассрочка по оплаченной кредитной картой транзакции.
+// Заказ считается оплаченным полностью, а покупатель вносит платежи по графику.
+type InstallmentPlan struct {
+	UUID            string
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	Status          InstallmentPlanStatus
+	InstallmentQuote
+	// MissedCount — сколько платежей было пропущено за всё время плана.
+	MissedCount int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// InstallmentPlansFilter задаёт условия выборки планов. Пустые поля не применяются.
+type InstallmentPlansFilter struct {
+	UserUUID        string
+	TransactionUUID string
+	Statuses        []InstallmentPlanStatus
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// Investor — инвестор, из средств которого разрешённые им пользователи оплачивают заказы.
+type Investor struct {
+	UUID string
+	Name string
+	// Available — свободные средства, удержанные авторизациями суммы уже вычтены.
+	Available money.Money
+	// Held — сумма, удержанная ещё не списанными авторизациями.
+	Held money.Money
+	// Spent — списанная сумма за вычетом возвратов.
+	Spent money.Money
+	// AuthorizedUserUUIDs — пользователи, которым разрешено тратить средства инвестора.
+	AuthorizedUserUUIDs []string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// InvestorInfo описывает нового инвестора. Все суммы инвестора ведутся в CurrencyCode.
+type InvestorInfo struct {
+	Name                string
+	CurrencyCode        string
+	AuthorizedUserUUIDs []string
+}
+
+type InvestorMovementKind int32
+
+const (
+	InvestorMovementKindUnspecified InvestorMovementKind = iota
+	InvestorMovementKindTopUp
+	InvestorMovementKindHold
+	InvestorMovementKindCapture
+	InvestorMovementKindRelease
+	InvestorMovementKindRefund
+)
+
+// InvestorMovement — движение средств инвестора: пополнение, удержание, списание,
+// снятие удержания или возврат.
+type InvestorMovement struct {
+	UUID            string
+	InvestorUUID    string
+	Kind            InvestorMovementKind
+	Amount          money.Money
+	TransactionUUID string
+	UserUUID        string
+	CreatedAt       time.Time
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type LedgerAccountType int32
+
+const (
+	LedgerAccountTypeUnspecified LedgerAccountType = iota
+	// LedgerAccountTypeCustomer — счёт покупателя, по одному на пользователя.
+	LedgerAccountTypeCustomer
+	LedgerAccountTypeMerchant
+	LedgerAccountTypeRefunds
+	LedgerAccountTypeFees
+	// LedgerAccountTypeChargebacks — суммы, возвращённые покупателям проигранными спорами.
+	LedgerAccountTypeChargebacks
+	// LedgerAccountTypeInterest — проценты, полученные с покупателей по рассрочке.
+	LedgerAccountTypeInterest
+)
+
+// LedgerAccount — счёт книги. OwnerUUID задан только у счетов покупателей.
+type LedgerAccount struct {
+	Type      LedgerAccountType
+	OwnerUUID string
+}
+
+// MerchantAccount, RefundsAccount, FeesAccount, ChargebacksAccount и InterestAccount — общие счета магазина.
+var (
+	MerchantAccount    = LedgerAccount{Type: LedgerAccountTypeMerchant}
+	RefundsAccount     = LedgerAccount{Type: LedgerAccountTypeRefunds}
+	FeesAccount        = LedgerAccount{Type: LedgerAccountTypeFees}
+	ChargebacksAccount = LedgerAccount{Type: LedgerAccountTypeChargebacks}
+	InterestAccount    = LedgerAccount{Type: LedgerAccountTypeInterest}
+)
+
+// CustomerAccount возвращает счёт покупателя userUUID.
+func CustomerAccount(userUUID string) LedgerAccount {
+	return LedgerAccount{Type: LedgerAccountTypeCustomer, OwnerUUID: userUUID}
+}
+
+type PostingDirection int32
+
+const (
+	PostingDirectionUnspecified PostingDirection = iota
+	PostingDirectionDebit
+	PostingDirectionCredit
+)
+
+// Posting — одна сторона проводки: дебет или кредит счёта на положительную сумму.
+type Posting struct {
+	Account   LedgerAccount
+	Direction PostingDirection
+	Amount    money.Money
+}
+
+type JournalEntryKind int32
+
+const (
+	JournalEntryKindUnspecified JournalEntryKind = iota
+	JournalEntryKindCapture
+	JournalEntryKindRefund
+	// JournalEntryKindReversal — сторно: зеркальная запись, отменяющая ошибочную.
+	JournalEntryKindReversal
+	// JournalEntryKindChargeback — возврат спорной суммы покупателю по проигранному спору.
+	JournalEntryKindChargeback
+)
+
+// JournalEntry — запись журнала. Сумма дебетов её проводок равна сумме кредитов.
+// Записи не изменяются и не удаляются, ошибки исправляются сторнированием.
+type JournalEntry struct {
+	UUID            string
+	Kind            JournalEntryKind
+	TransactionUUID string
+	// RefundUUID задан у записей о возврате.
+	RefundUUID string
+	// DisputeUUID задан у записей о проигранном споре.
+	DisputeUUID string
+	// ReversesEntryUUID задан у сторнирующих записей.
+	ReversesEntryUUID string
+	Postings          []Posting
+	CreatedAt         time.Time
+}
+
+// AccountBalance — обороты и сальдо счёта в одной валюте. Balance равен Debits - Credits.
+type AccountBalance struct {
+	Account LedgerAccount
+	Debits  money.Money
+	Credits money.Money
+	Balance money.Money
+}
+
+// AccountBalancesFilter задаёт условия выборки счетов. Пустые поля не применяются.
+type AccountBalancesFilter struct {
+	AccountType LedgerAccountType
+	OwnerUUID   string
+}
+
+// LedgerViolation описывает найденное при сверке расхождение.
+type LedgerViolation struct {
+	EntryUUID       string
+	TransactionUUID string
+	Description     string
+}
+
+// LedgerReport — результат сверки книги.
+type LedgerReport struct {
+	EntriesChecked int
+	Violations     []LedgerViolation
+}
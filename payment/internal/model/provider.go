@@ -0,0 +1,81 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// ProviderOperation — операция, которую сервис передаёт платёжному провайдеру.
+type ProviderOperation int32
+
+const (
+	ProviderOperationUnspecified ProviderOperation = iota
+	ProviderOperationAuthorize
+	ProviderOperationCapture
+	ProviderOperationVoid
+	ProviderOperationRefund
+	// ProviderOperationCharge — списание без предварительной авторизации, например платежа рассрочки.
+	ProviderOperationCharge
+)
+
+func (o ProviderOperation) String() string {
+	switch o {
+	case ProviderOperationAuthorize:
+		return "authorize"
+	case ProviderOperationCapture:
+		return "capture"
+	case ProviderOperationVoid:
+		return "void"
+	case ProviderOperationRefund:
+		return "refund"
+	case ProviderOperationCharge:
+		return "charge"
+	default:
+		return "unspecified"
+	}
+}
+
+// ProviderRequest описывает операцию над транзакцией для платёжного провайдера.
+type ProviderRequest struct {
+	Operation       ProviderOperation
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   PaymentMethod
+	// Amount — сумма операции: удерживаемая, списываемая или возвращаемая.
+	Amount money.Money
+	// AuthorizedAmount — удержанная при авторизации сумма, из неё списывается Amount.
+	AuthorizedAmount money.Money
+	// InvestorUUID — инвестор, из средств которого идёт оплата способом INVESTOR_MONEY.
+	InvestorUUID string
+	// Card — расшифрованная сохранённая карта при авторизации по токену, иначе nil.
+	// При выводе номер карты маскируется.
+	Card *CardDetails
+}
+
+// DeclineError — окончательный отказ провайдера с кодом причины, например "insufficient_funds".
+type DeclineError struct {
+	Code string
+}
+
+func (e *DeclineError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPaymentDeclined, e.Code)
+}
+
+func (e *DeclineError) Unwrap() error {
+	return ErrPaymentDeclined
+}
+
+// ProviderError — сбой или таймаут провайдера. Операция не проведена, её можно повторить.
+type ProviderError struct {
+	Code string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrProviderUnavailable, e.Code)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return ErrProviderUnavailable
+}
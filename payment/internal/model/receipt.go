@@ -0,0 +1,55 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// LineItem — строка заказа, которая печатается в чеке.
+type LineItem struct {
+	// SKU — идентификатор позиции в каталоге продавца, например UUID детали.
+	SKU      string
+	Name     string
+	Quantity int
+	// UnitPrice — цена единицы с налогами в валюте суммы оплаты.
+	UnitPrice money.Money
+}
+
+// Receipt — чек, подтверждающий оплату заказа. Выписывается при списании суммы.
+type Receipt struct {
+	UUID             string
+	TransactionUUID  string
+	OrderUUID        string
+	UserUUID         string
+	PaymentMethod    PaymentMethod
+	MaskedCardNumber string
+	// InstallmentTermMonths — срок рассрочки, ноль для оплаты целиком.
+	InstallmentTermMonths int
+	Items                 []ReceiptItem
+	// Total — сумма, списанная при оплате. Вместе с CreditAmount она равна сумме строк.
+	Total money.Money
+	// CreditAmount — остаток, который спишут платежи рассрочки, ноль для оплаты целиком.
+	CreditAmount money.Money
+	// TaxTotal — НДС, включённый в сумму строк.
+	TaxTotal money.Money
+	PaidAt   time.Time
+	// HTML и Text — чек, отрисованный самостоятельным HTML-документом и простым текстом.
+	HTML      string
+	Text      string
+	CreatedAt time.Time
+}
+
+// ReceiptItem — строка чека.
+type ReceiptItem struct {
+	SKU       string
+	Name      string
+	Quantity  int
+	UnitPrice money.Money
+	// Amount — стоимость всех единиц строки.
+	Amount money.Money
+	// TaxRateBasisPoints — ставка НДС в базисных пунктах, налог включён в цену.
+	TaxRateBasisPoints int64
+	// Tax — НДС, включённый в Amount.
+	Tax money.Money
+}
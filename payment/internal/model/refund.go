@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type RefundReason int32
+
+const (
+	RefundReasonUnspecified RefundReason = iota
+	RefundReasonRequestedByCustomer
+	RefundReasonOrderCancelled
+	RefundReasonDuplicate
+	RefundReasonFraudulent
+)
+
+type RefundStatus int32
+
+const (
+	RefundStatusUnspecified RefundStatus = iota
+	RefundStatusSucceeded
+	RefundStatusFailed
+)
+
+// RefundInfo описывает запрос на возврат. Пустой Amount означает возврат всего остатка.
+// Возврат по рассрочке сначала уменьшает невнесённые платежи, и только остаток
+// перечисляется покупателю.
+type RefundInfo struct {
+	TransactionUUID string
+	Amount          *money.Money
+	Reason          RefundReason
+}
+
+type Refund struct {
+	UUID            string
+	TransactionUUID string
+	// Amount — сумма, перечисленная покупателю.
+	Amount money.Money
+	// WrittenOffAmount — часть возврата, на которую уменьшен непогашенный основной долг
+	// рассрочки. Покупателю она не перечисляется: эти деньги с него ещё не списаны.
+	WrittenOffAmount money.Money
+	Reason           RefundReason
+	Status           RefundStatus
+	CreatedAt        time.Time
+}
@@ -0,0 +1,21 @@
+package model
+
+// QRImageFormat — формат изображения QR-кода.
+type QRImageFormat int32
+
+const (
+	QRImageFormatUnspecified QRImageFormat = iota
+	QRImageFormatPNG
+	QRImageFormatSVG
+)
+
+// SBPPaymentIntent — платёж СБП, ожидающий оплаты по QR-коду.
+type SBPPaymentIntent struct {
+	// Transaction — транзакция платежа, в статусе PENDING до подтверждения оплаты.
+	Transaction Transaction
+	// Payload — ссылка в формате НСПК, которую кодирует QR-код.
+	Payload string
+	// QRImage — изображение QR-кода в формате QRImageContentType.
+	QRImage            []byte
+	QRImageContentType string
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type PaymentMethod int32
+
+const (
+	PaymentMethodUnspecified PaymentMethod = iota
+	PaymentMethodCard
+	PaymentMethodSBP
+	PaymentMethodCreditCard
+	PaymentMethodInvestorMoney
+)
+
+type TransactionStatus int32
+
+const (
+	TransactionStatusUnspecified TransactionStatus = iota
+	// TransactionStatusPaid — сумма списана (захвачена).
+	TransactionStatusPaid
+	TransactionStatusPartiallyRefunded
+	TransactionStatusRefunded
+	TransactionStatusAuthorized
+	TransactionStatusVoided
+	TransactionStatusExpired
+	// TransactionStatusDeclined — провайдер отказал в авторизации.
+	TransactionStatusDeclined
+	// TransactionStatusPending — платёж СБП ждёт, пока покупатель отсканирует QR-код и оплатит.
+	TransactionStatusPending
+	// TransactionStatusChargedBack — проигранные споры вернули покупателю весь невозвращённый остаток.
+	TransactionStatusChargedBack
+)
+
+// PayOrderInfo описывает запрос на оплату заказа.
+type PayOrderInfo struct {
+	OrderUUID     string
+	UserUUID      string
+	PaymentMethod PaymentMethod
+	Amount        money.Money
+	// InvestorUUID — инвестор, из средств которого идёт оплата способом INVESTOR_MONEY.
+	// Если не задан, выбирается инвестор, разрешивший пользователю тратить свои средства.
+	InvestorUUID string
+	// InstallmentTermMonths — срок рассрочки в месяцах для CREDIT_CARD, ноль — оплата целиком.
+	InstallmentTermMonths int
+	// PaymentToken — токен сохранённой карты пользователя для оплаты способом CARD.
+	PaymentToken string
+	// LineItems — строки заказа для чека. Если пусты, в чеке одна строка на всю сумму.
+	LineItems []LineItem
+	// IdempotencyKey — необязательный ключ, защищающий от повторного списания.
+	IdempotencyKey string
+}
+
+type Transaction struct {
+	UUID          string
+	OrderUUID     string
+	UserUUID      string
+	PaymentMethod PaymentMethod
+	Status        TransactionStatus
+	// Amount — удерживаемая сумма до списания и списанная сумма после него.
+	Amount money.Money
+	// SettlementAmount — Amount, пересчитанная в валюту расчётов с провайдером.
+	SettlementAmount money.Money
+	// ExchangeRate — курс валюты Amount к валюте расчётов, зафиксированный при создании
+	// транзакции. Для транзакций в валюте расчётов равен "1".
+	ExchangeRate string
+	// RefundedAmount — сумма всех успешных возвратов по транзакции.
+	RefundedAmount money.Money
+	// ChargedBackAmount — сумма, возвращённая покупателю проигранными спорами.
+	ChargedBackAmount money.Money
+	// CollectedAmount — сумма, фактически списанная с покупателя. После списания равна
+	// Amount, а в рассрочку — основному долгу внесённых платежей и растёт с каждым из них.
+	// Возвраты и споры не могут превысить её.
+	CollectedAmount money.Money
+	// AuthorizedAmount — сумма, удержанная при авторизации.
+	AuthorizedAmount money.Money
+	// AuthorizationExpiresAt — срок действия авторизации, а для ожидающего платежа СБП — срок действия QR-кода.
+	AuthorizationExpiresAt time.Time
+	// CapturedAt — время списания, нулевое для несписанных транзакций.
+	CapturedAt time.Time
+	// DeclineCode — код отказа провайдера для отклонённой транзакции.
+	DeclineCode string
+	// InvestorUUID — инвестор, из средств которого оплачена транзакция INVESTOR_MONEY.
+	InvestorUUID string
+	// InstallmentTermMonths — срок рассрочки; при списании по нему создаётся план платежей.
+	InstallmentTermMonths int
+	// PaymentToken — токен сохранённой карты, которой оплачена транзакция.
+	PaymentToken string
+	// MaskedCardNumber — замаскированный номер сохранённой карты.
+	MaskedCardNumber string
+	// LineItems — строки заказа, которые печатаются в чеке.
+	LineItems []LineItem
+	CreatedAt time.Time
+}
+
+// TransactionsFilter задаёт условия выборки транзакций. Пустые поля не применяются.
+type TransactionsFilter struct {
+	OrderUUIDs     []string
+	UserUUIDs      []string
+	PaymentMethods []PaymentMethod
+	Statuses       []TransactionStatus
+	// CreatedFrom — нижняя граница времени создания включительно.
+	CreatedFrom time.Time
+	// CreatedTo — верхняя граница времени создания не включительно.
+	CreatedTo time.Time
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatusUnspecified WebhookDeliveryStatus = iota
+	// WebhookDeliveryStatusPending — доставка ждёт первой или повторной попытки.
+	WebhookDeliveryStatusPending
+	WebhookDeliveryStatusDelivered
+	// WebhookDeliveryStatusDead — попытки исчерпаны, доставку можно только повторить вручную.
+	WebhookDeliveryStatusDead
+)
+
+// WebhookSubscriptionInfo описывает новую подписку на события платежей.
+type WebhookSubscriptionInfo struct {
+	URL string
+	// EventTypes — типы событий, пустой список означает все.
+	EventTypes []PaymentEventType
+}
+
+// WebhookSubscription — HTTP-адрес, на который отправляются события платежей.
+type WebhookSubscription struct {
+	UUID       string
+	URL        string
+	EventTypes []PaymentEventType
+	// Secret — ключ HMAC-SHA256 подписи запросов. Клиенту показывается только при создании.
+	Secret    string
+	CreatedAt time.Time
+}
+
+// WebhookDelivery — отправка одного события одному подписчику.
+type WebhookDelivery struct {
+	UUID             string
+	SubscriptionUUID string
+	EventSequence    int64
+	EventType        PaymentEventType
+	// Payload — тело запроса, сформированное при постановке в очередь. Повторы отправляют его без изменений.
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	// LastError — причина последней неудачной попытки: код ответа или ошибка соединения.
+	LastError   string
+	DeliveredAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// WebhookDeliveriesFilter задаёт условия выборки доставок. Пустые поля не применяются.
+type WebhookDeliveriesFilter struct {
+	SubscriptionUUID string
+	Statuses         []WebhookDeliveryStatus
+	// DueBy оставляет доставки, очередная попытка которых наступила к этому моменту.
+	DueBy time.Time
+}
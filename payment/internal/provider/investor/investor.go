@@ -0,0 +1,163 @@
+package investor
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	def "github.com/Denisz0785/spaceyard/payment/internal/provider"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+var _ def.Provider = (*provider)(nil)
+
+// Коды отказа, которые видит клиент в decline_code.
+const (
+	declineInvestorNotFound  = "investor_not_found"
+	declineAccessDenied      = "investor_access_denied"
+	declineCurrencyMismatch  = "currency_mismatch"
+	declineInsufficientFunds = "insufficient_funds"
+)
+
+// provider проводит оплату способом INVESTOR_MONEY из средств инвестора:
+// авторизация удерживает сумму со свободного остатка, списание переводит
+// удержание в потраченное, отмена и возврат возвращают средства в свободный остаток.
+type provider struct {
+	investorRepository repository.InvestorRepository
+}
+
+// New создаёт адаптер оплаты средствами инвесторов.
+func New(investorRepository repository.InvestorRepository) *provider {
+	return &provider{
+		investorRepository: investorRepository,
+	}
+}
+
+func (p *provider) Authorize(ctx context.Context, req model.ProviderRequest) error {
+	return p.update(ctx, req, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		if err := checkAvailable(investor, req); err != nil {
+			return nil, err
+		}
+
+		investor.Available = investor.Available.Sub(req.Amount)
+		investor.Held = investor.Held.Add(req.Amount)
+
+		return []model.InvestorMovement{movement(req, model.InvestorMovementKindHold, req.Amount)}, nil
+	})
+}
+
+func (p *provider) Capture(ctx context.Context, req model.ProviderRequest) error {
+	return p.update(ctx, req, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		investor.Held = investor.Held.Sub(req.AuthorizedAmount)
+		investor.Spent = investor.Spent.Add(req.Amount)
+
+		movements := []model.InvestorMovement{movement(req, model.InvestorMovementKindCapture, req.Amount)}
+
+		// Несписанный остаток удержания возвращается в свободные средства.
+		if remainder := req.AuthorizedAmount.Sub(req.Amount); remainder.IsPositive() {
+			investor.Available = investor.Available.Add(remainder)
+			movements = append(movements, movement(req, model.InvestorMovementKindRelease, remainder))
+		}
+
+		return movements, nil
+	})
+}
+
+// Charge сразу переводит сумму из свободного остатка в потраченное.
+func (p *provider) Charge(ctx context.Context, req model.ProviderRequest) error {
+	return p.update(ctx, req, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		if err := checkAvailable(investor, req); err != nil {
+			return nil, err
+		}
+
+		investor.Available = investor.Available.Sub(req.Amount)
+		investor.Spent = investor.Spent.Add(req.Amount)
+
+		return []model.InvestorMovement{movement(req, model.InvestorMovementKindCapture, req.Amount)}, nil
+	})
+}
+
+func (p *provider) Void(ctx context.Context, req model.ProviderRequest) error {
+	return p.update(ctx, req, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		investor.Held = investor.Held.Sub(req.Amount)
+		investor.Available = investor.Available.Add(req.Amount)
+
+		return []model.InvestorMovement{movement(req, model.InvestorMovementKindRelease, req.Amount)}, nil
+	})
+}
+
+func (p *provider) Refund(ctx context.Context, req model.ProviderRequest) error {
+	return p.update(ctx, req, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		investor.Spent = investor.Spent.Sub(req.Amount)
+		investor.Available = investor.Available.Add(req.Amount)
+
+		return []model.InvestorMovement{movement(req, model.InvestorMovementKindRefund, req.Amount)}, nil
+	})
+}
+
+// checkAvailable проверяет, что пользователь может тратить средства инвестора
+// и свободного остатка хватает на сумму запроса.
+func checkAvailable(investor *model.Investor, req model.ProviderRequest) error {
+	switch {
+	case !slices.Contains(investor.AuthorizedUserUUIDs, req.UserUUID):
+		return &model.DeclineError{Code: declineAccessDenied}
+	case investor.Available.CurrencyCode != req.Amount.CurrencyCode:
+		return &model.DeclineError{Code: declineCurrencyMismatch}
+	case investor.Available.Cmp(req.Amount) < 0:
+		return &model.DeclineError{Code: declineInsufficientFunds}
+	default:
+		return nil
+	}
+}
+
+// update атомарно применяет операцию к балансам инвестора транзакции.
+// Отсутствующий инвестор — окончательный отказ, ошибка хранилища — повторяемый сбой.
+func (p *provider) update(
+	ctx context.Context,
+	req model.ProviderRequest,
+	apply func(investor *model.Investor) ([]model.InvestorMovement, error),
+) error {
+	if req.InvestorUUID == "" {
+		return &model.DeclineError{Code: declineInvestorNotFound}
+	}
+
+	_, err := p.investorRepository.Update(ctx, req.InvestorUUID, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		movements, err := apply(investor)
+		if err != nil {
+			return nil, err
+		}
+		investor.UpdatedAt = time.Now()
+		return movements, nil
+	})
+
+	var decline *model.DeclineError
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, model.ErrInvestorNotFound):
+		return &model.DeclineError{Code: declineInvestorNotFound}
+	case errors.As(err, &decline):
+		return err
+	default:
+		log.Printf("failed to %s investor %s funds: %v", req.Operation, req.InvestorUUID, err)
+		return &model.ProviderError{Code: "storage_error"}
+	}
+}
+
+func movement(req model.ProviderRequest, kind model.InvestorMovementKind, amount money.Money) model.InvestorMovement {
+	return model.InvestorMovement{
+		UUID:            uuid.NewString(),
+		InvestorUUID:    req.InvestorUUID,
+		Kind:            kind,
+		Amount:          amount,
+		TransactionUUID: req.TransactionUUID,
+		UserUUID:        req.UserUUID,
+		CreatedAt:       time.Now(),
+	}
+}
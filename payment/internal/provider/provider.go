@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// Provider проводит операции над транзакциями во внешней платёжной системе.
+// Для каждого способа оплаты регистрируется свой адаптер.
+//
+// Окончательный отказ возвращается как *model.DeclineError, сбой или таймаут,
+// после которого операцию можно повторить, — как *model.ProviderError.
+type Provider interface {
+	Authorize(ctx context.Context, req model.ProviderRequest) error
+	Capture(ctx context.Context, req model.ProviderRequest) error
+	Void(ctx context.Context, req model.ProviderRequest) error
+	Refund(ctx context.Context, req model.ProviderRequest) error
+	// Charge списывает сумму без предварительной авторизации.
+	Charge(ctx context.Context, req model.ProviderRequest) error
+}
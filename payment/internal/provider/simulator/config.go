@@ -0,0 +1,222 @@
+package simulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// Исходы операции, которые может задать правило.
+const (
+	OutcomeApprove = "approve"
+	OutcomeDecline = "decline"
+	OutcomeError   = "error"
+	OutcomeTimeout = "timeout"
+)
+
+// Коды, которые подставляются, если правило не задало свой.
+const (
+	defaultDeclineCode = "do_not_honor"
+	defaultErrorCode   = "processing_error"
+	timeoutCode        = "timeout"
+	defaultTimeout     = 30 * time.Second
+)
+
+// Config задаёт поведение симулятора. Нулевой Config одобряет все операции без задержки.
+type Config struct {
+	// Latency — задержка каждой операции, к ней добавляется случайная часть до LatencyJitter.
+	Latency       Duration `json:"latency"`
+	LatencyJitter Duration `json:"latency_jitter"`
+	// Timeout — через сколько операция с исходом timeout завершается ошибкой провайдера.
+	Timeout Duration `json:"timeout"`
+	// DeclineRate, ErrorRate и TimeoutRate — доли операций от 0 до 1 с соответствующим
+	// исходом, если ни одно правило не сработало.
+	DeclineRate float64 `json:"decline_rate"`
+	DeclineCode string  `json:"decline_code"`
+	ErrorRate   float64 `json:"error_rate"`
+	TimeoutRate float64 `json:"timeout_rate"`
+	// Rules проверяются по порядку, срабатывает первое подходящее.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule задаёт исход для подходящих операций, например «отклонять суммы больше X для пользователя Y».
+// Пустые условия не применяются.
+type Rule struct {
+	Name string `json:"name"`
+	// Operations — authorize, capture, void или refund.
+	Operations []string `json:"operations"`
+	// PaymentMethods — CARD, SBP, CREDIT_CARD или INVESTOR_MONEY.
+	PaymentMethods []string `json:"payment_methods"`
+	UserUUIDs      []string `json:"user_uuids"`
+	OrderUUIDs     []string `json:"order_uuids"`
+	// CurrencyCode ограничивает правило валютой, в ней же заданы AmountOver и AmountUnder.
+	CurrencyCode string `json:"currency_code"`
+	// AmountOver и AmountUnder — строгие границы суммы в виде десятичной строки, например "1000.50".
+	AmountOver  string `json:"amount_over"`
+	AmountUnder string `json:"amount_under"`
+	// Probability — вероятность срабатывания подходящего правила, по умолчанию 1.
+	Probability *float64 `json:"probability"`
+	// Outcome — approve, decline, error или timeout.
+	Outcome string `json:"outcome"`
+	// Code — код отказа или ошибки провайдера, например "insufficient_funds".
+	Code string `json:"code"`
+	// Latency заменяет задержку по умолчанию.
+	Latency *Duration `json:"latency"`
+
+	operations  map[model.ProviderOperation]struct{}
+	methods     map[model.PaymentMethod]struct{}
+	amountOver  *money.Money
+	amountUnder *money.Money
+}
+
+// Duration читается из JSON как строка time.ParseDuration, например "250ms".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	if parsed < 0 {
+		return errors.New("duration must not be negative")
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+var paymentMethods = map[string]model.PaymentMethod{
+	"CARD":           model.PaymentMethodCard,
+	"SBP":            model.PaymentMethodSBP,
+	"CREDIT_CARD":    model.PaymentMethodCreditCard,
+	"INVESTOR_MONEY": model.PaymentMethodInvestorMoney,
+}
+
+var operations = map[string]model.ProviderOperation{
+	model.ProviderOperationAuthorize.String(): model.ProviderOperationAuthorize,
+	model.ProviderOperationCapture.String():   model.ProviderOperationCapture,
+	model.ProviderOperationVoid.String():      model.ProviderOperationVoid,
+	model.ProviderOperationRefund.String():    model.ProviderOperationRefund,
+	model.ProviderOperationCharge.String():    model.ProviderOperationCharge,
+}
+
+// LoadConfig читает конфигурацию симулятора из JSON-файла и проверяет правила.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задаёт оператор сервиса
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse simulator config: %w", err)
+	}
+	if err := config.prepare(); err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// prepare проверяет конфигурацию и разбирает условия правил.
+func (c *Config) prepare() error {
+	for name, rate := range map[string]float64{
+		"decline_rate": c.DeclineRate,
+		"error_rate":   c.ErrorRate,
+		"timeout_rate": c.TimeoutRate,
+	} {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("%s must be between 0 and 1", name)
+		}
+	}
+	if c.DeclineCode == "" {
+		c.DeclineCode = defaultDeclineCode
+	}
+	if c.Timeout == 0 {
+		c.Timeout = Duration(defaultTimeout)
+	}
+
+	for i := range c.Rules {
+		if err := c.Rules[i].prepare(); err != nil {
+			return fmt.Errorf("rule %d (%q): %w", i, c.Rules[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Rule) prepare() error {
+	switch r.Outcome {
+	case OutcomeApprove:
+	case OutcomeTimeout:
+		r.Code = timeoutCode
+	case OutcomeDecline:
+		if r.Code == "" {
+			r.Code = defaultDeclineCode
+		}
+	case OutcomeError:
+		if r.Code == "" {
+			r.Code = defaultErrorCode
+		}
+	default:
+		return fmt.Errorf("unknown outcome %q", r.Outcome)
+	}
+
+	if r.Probability != nil && (*r.Probability < 0 || *r.Probability > 1) {
+		return errors.New("probability must be between 0 and 1")
+	}
+
+	r.operations = make(map[model.ProviderOperation]struct{}, len(r.Operations))
+	for _, name := range r.Operations {
+		operation, ok := operations[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("unknown operation %q", name)
+		}
+		r.operations[operation] = struct{}{}
+	}
+
+	r.methods = make(map[model.PaymentMethod]struct{}, len(r.PaymentMethods))
+	for _, name := range r.PaymentMethods {
+		method, ok := paymentMethods[strings.ToUpper(name)]
+		if !ok {
+			return fmt.Errorf("unknown payment method %q", name)
+		}
+		r.methods[method] = struct{}{}
+	}
+
+	var err error
+	if r.amountOver, err = parseAmount(r.AmountOver); err != nil {
+		return fmt.Errorf("invalid amount_over: %w", err)
+	}
+	if r.amountUnder, err = parseAmount(r.AmountUnder); err != nil {
+		return fmt.Errorf("invalid amount_under: %w", err)
+	}
+
+	return nil
+}
+
+// parseAmount разбирает границу суммы. Пустая строка означает отсутствие границы.
+func parseAmount(value string) (*money.Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := money.Parse("", value)
+	if err != nil {
+		return nil, err
+	}
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("%q must not be negative", value)
+	}
+
+	return &amount, nil
+}
@@ -0,0 +1,168 @@
+package simulator
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	def "github.com/Denisz0785/spaceyard/payment/internal/provider"
+)
+
+var _ def.Provider = (*simulator)(nil)
+
+// simulator имитирует платёжного провайдера одного способа оплаты: отказы, сбои,
+// задержки и таймауты задаются правилами конфигурации.
+type simulator struct {
+	method model.PaymentMethod
+	config Config
+}
+
+// New создаёт адаптер-симулятор для способа оплаты method.
+func New(method model.PaymentMethod, config Config) *simulator {
+	return &simulator{
+		method: method,
+		config: config,
+	}
+}
+
+func (s *simulator) Authorize(ctx context.Context, req model.ProviderRequest) error {
+	return s.process(ctx, req)
+}
+
+func (s *simulator) Capture(ctx context.Context, req model.ProviderRequest) error {
+	return s.process(ctx, req)
+}
+
+func (s *simulator) Void(ctx context.Context, req model.ProviderRequest) error {
+	return s.process(ctx, req)
+}
+
+func (s *simulator) Refund(ctx context.Context, req model.ProviderRequest) error {
+	return s.process(ctx, req)
+}
+
+func (s *simulator) Charge(ctx context.Context, req model.ProviderRequest) error {
+	return s.process(ctx, req)
+}
+
+// process выбирает исход операции и выдерживает задержку перед ответом.
+func (s *simulator) process(ctx context.Context, req model.ProviderRequest) error {
+	outcome, code, latency, ruleName := s.decide(req)
+
+	if outcome != OutcomeApprove {
+		log.Printf(
+			"simulator: %s of transaction %s: %s %s (rule %q)",
+			req.Operation, req.TransactionUUID, outcome, code, ruleName,
+		)
+	}
+
+	if outcome == OutcomeTimeout {
+		latency = time.Duration(s.config.Timeout)
+	}
+	if err := wait(ctx, latency); err != nil {
+		return &model.ProviderError{Code: timeoutCode}
+	}
+
+	switch outcome {
+	case OutcomeDecline:
+		return &model.DeclineError{Code: code}
+	case OutcomeError:
+		return &model.ProviderError{Code: code}
+	case OutcomeTimeout:
+		return &model.ProviderError{Code: timeoutCode}
+	default:
+		return nil
+	}
+}
+
+// decide возвращает исход первого сработавшего правила, а без него — случайный исход
+// по долям из конфигурации.
+func (s *simulator) decide(req model.ProviderRequest) (outcome, code string, latency time.Duration, ruleName string) {
+	latency = time.Duration(s.config.Latency)
+	if s.config.LatencyJitter > 0 {
+		latency += rand.N(time.Duration(s.config.LatencyJitter)) // #nosec G404 -- симулятору не нужна криптостойкость
+	}
+
+	for _, rule := range s.config.Rules {
+		if !rule.matches(s.method, req) || !roll(rule.probability()) {
+			continue
+		}
+		if rule.Latency != nil {
+			latency = time.Duration(*rule.Latency)
+		}
+		return rule.Outcome, rule.Code, latency, rule.Name
+	}
+
+	switch {
+	case roll(s.config.DeclineRate):
+		return OutcomeDecline, s.config.DeclineCode, latency, "decline_rate"
+	case roll(s.config.ErrorRate):
+		return OutcomeError, defaultErrorCode, latency, "error_rate"
+	case roll(s.config.TimeoutRate):
+		return OutcomeTimeout, timeoutCode, latency, "timeout_rate"
+	default:
+		return OutcomeApprove, "", latency, ""
+	}
+}
+
+// matches сообщает, что операция удовлетворяет всем заданным условиям правила.
+func (r Rule) matches(method model.PaymentMethod, req model.ProviderRequest) bool {
+	if len(r.operations) > 0 {
+		if _, ok := r.operations[req.Operation]; !ok {
+			return false
+		}
+	}
+	if len(r.methods) > 0 {
+		if _, ok := r.methods[method]; !ok {
+			return false
+		}
+	}
+	if len(r.UserUUIDs) > 0 && !slices.Contains(r.UserUUIDs, req.UserUUID) {
+		return false
+	}
+	if len(r.OrderUUIDs) > 0 && !slices.Contains(r.OrderUUIDs, req.OrderUUID) {
+		return false
+	}
+	if r.CurrencyCode != "" && r.CurrencyCode != req.Amount.CurrencyCode {
+		return false
+	}
+	if r.amountOver != nil && req.Amount.Cmp(*r.amountOver) <= 0 {
+		return false
+	}
+	if r.amountUnder != nil && req.Amount.Cmp(*r.amountUnder) >= 0 {
+		return false
+	}
+	return true
+}
+
+func (r Rule) probability() float64 {
+	if r.Probability == nil {
+		return 1
+	}
+	return *r.Probability
+}
+
+// roll возвращает true с вероятностью p.
+func roll(p float64) bool {
+	return p > 0 && rand.Float64() < p // #nosec G404 -- симулятору не нужна криптостойкость
+}
+
+// wait выдерживает задержку d или возвращает ошибку контекста, если запрос отменён раньше.
+func wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+// Package receipt отрисовывает чеки об оплате заказов в HTML и простой текст.
+package receipt
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmlTemplate "html/template"
+	"strings"
+	textTemplate "text/template"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var (
+	htmlReceipt = htmlTemplate.Must(htmlTemplate.ParseFS(templates, "templates/receipt.html.tmpl"))
+	textReceipt = textTemplate.Must(textTemplate.ParseFS(templates, "templates/receipt.txt.tmpl"))
+)
+
+// paidAtLayout — формат времени оплаты в чеке.
+const paidAtLayout = "02.01.2006 15:04 MST"
+
+// view — данные чека, подготовленные для шаблонов: суммы уже записаны строками.
+type view struct {
+	Seller          string
+	ReceiptUUID     string
+	OrderUUID       string
+	TransactionUUID string
+	PaidAt          string
+	PaymentMethod   string
+	Items           []itemView
+	// Total — сумма строк, Paid — списанная при оплате часть, а Credit — остаток,
+	// который спишут платежи рассрочки; пустой Credit означает оплату целиком.
+	Total    string
+	Paid     string
+	Credit   string
+	TaxTotal string
+	Currency string
+}
+
+type itemView struct {
+	Number    int
+	SKU       string
+	Name      string
+	Quantity  int
+	UnitPrice string
+	Amount    string
+	TaxRate   string
+	Tax       string
+}
+
+// Render отрисовывает чек самостоятельным HTML-документом и простым текстом.
+// seller — название продавца в шапке чека.
+func Render(r model.Receipt, seller string) (html, text string, err error) {
+	v := newView(r, seller)
+
+	var buf bytes.Buffer
+	if err := htmlReceipt.Execute(&buf, v); err != nil {
+		return "", "", fmt.Errorf("failed to render html receipt: %w", err)
+	}
+	html = buf.String()
+
+	buf.Reset()
+	if err := textReceipt.Execute(&buf, v); err != nil {
+		return "", "", fmt.Errorf("failed to render text receipt: %w", err)
+	}
+	text = buf.String()
+
+	return html, text, nil
+}
+
+func newView(r model.Receipt, seller string) view {
+	items := make([]itemView, 0, len(r.Items))
+	for i, item := range r.Items {
+		items = append(items, itemView{
+			Number:    i + 1,
+			SKU:       item.SKU,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.Decimal(),
+			Amount:    item.Amount.Decimal(),
+			TaxRate:   taxRate(item.TaxRateBasisPoints),
+			Tax:       item.Tax.Decimal(),
+		})
+	}
+
+	var credit string
+	if r.CreditAmount.IsPositive() {
+		credit = r.CreditAmount.Decimal()
+	}
+
+	return view{
+		Seller:          seller,
+		ReceiptUUID:     r.UUID,
+		OrderUUID:       r.OrderUUID,
+		TransactionUUID: r.TransactionUUID,
+		PaidAt:          r.PaidAt.In(time.UTC).Format(paidAtLayout),
+		PaymentMethod:   paymentMethod(r),
+		Items:           items,
+		Total:           r.Total.Add(r.CreditAmount).Decimal(),
+		Paid:            r.Total.Decimal(),
+		Credit:          credit,
+		TaxTotal:        r.TaxTotal.Decimal(),
+		Currency:        r.Total.CurrencyCode,
+	}
+}
+
+// paymentMethod описывает способ оплаты так, как он печатается в чеке.
+func paymentMethod(r model.Receipt) string {
+	switch r.PaymentMethod {
+	case model.PaymentMethodCard:
+		if r.MaskedCardNumber != "" {
+			return "Банковская карта " + r.MaskedCardNumber
+		}
+		return "Банковская карта"
+	case model.PaymentMethodSBP:
+		return "Система быстрых платежей"
+	case model.PaymentMethodCreditCard:
+		if r.InstallmentTermMonths > 0 {
+			return fmt.Sprintf("Кредитная карта, рассрочка на %d мес.", r.InstallmentTermMonths)
+		}
+		return "Кредитная карта"
+	case model.PaymentMethodInvestorMoney:
+		return "Средства инвестора"
+	default:
+		return "Не указан"
+	}
+}
+
+// taxRate записывает ставку в базисных пунктах процентами, например "20%" или "12.5%".
+func taxRate(bps int64) string {
+	rate := fmt.Sprintf("%d.%02d", bps/100, bps%100)
+	return strings.TrimSuffix(strings.TrimRight(rate, "0"), ".") + "%"
+}
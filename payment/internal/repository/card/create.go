@@ -0,0 +1,21 @@
+package card
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, card model.PaymentCard) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cards[card.Token] = converter.PaymentCardToRepoModel(card)
+	if err := r.save(); err != nil {
+		delete(r.cards, card.Token)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package card
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func (r *repository) Delete(_ context.Context, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	card, ok := r.cards[token]
+	if !ok {
+		return model.ErrCardNotFound
+	}
+
+	delete(r.cards, token)
+	if err := r.save(); err != nil {
+		r.cards[token] = card
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package card
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, token string) (model.PaymentCard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	card, ok := r.cards[token]
+	if !ok {
+		return model.PaymentCard{}, model.ErrCardNotFound
+	}
+
+	return converter.PaymentCardToModel(card), nil
+}
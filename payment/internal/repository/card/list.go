@@ -0,0 +1,22 @@
+package card
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) List(_ context.Context, userUUID string) ([]model.PaymentCard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.PaymentCard, 0)
+	for _, card := range r.sortedCards() {
+		if userUUID == "" || card.UserUUID == userUUID {
+			result = append(result, converter.PaymentCardToModel(card))
+		}
+	}
+
+	return result, nil
+}
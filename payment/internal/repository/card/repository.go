@@ -0,0 +1,73 @@
+package card
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.CardRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище сохранённых карт.
+// Если задан path, карты сохраняются в JSON-файл и переживают перезапуск сервиса.
+type repository struct {
+	mu    sync.RWMutex
+	cards map[string]*repoModel.PaymentCard
+	path  string
+}
+
+// state — содержимое файла хранилища.
+type state struct {
+	Cards []*repoModel.PaymentCard `json:"cards"`
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		cards: make(map[string]*repoModel.PaymentCard),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее карты в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	var loaded state
+	if err := file.Load(path, &loaded); err != nil {
+		return nil, err
+	}
+	for _, card := range loaded.Cards {
+		r.cards[card.Token] = card
+	}
+
+	return r, nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	return file.Save(r.path, state{Cards: r.sortedCards()})
+}
+
+// sortedCards возвращает карты по времени сохранения. Вызывается под r.mu.
+func (r *repository) sortedCards() []*repoModel.PaymentCard {
+	cards := make([]*repoModel.PaymentCard, 0, len(r.cards))
+	for _, card := range r.cards {
+		cards = append(cards, card)
+	}
+	slices.SortFunc(cards, func(a, b *repoModel.PaymentCard) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Token, b.Token)
+	})
+	return cards
+}
@@ -0,0 +1,26 @@
+package card
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Update(_ context.Context, card model.PaymentCard) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.cards[card.Token]
+	if !ok {
+		return model.ErrCardNotFound
+	}
+
+	r.cards[card.Token] = converter.PaymentCardToRepoModel(card)
+	if err := r.save(); err != nil {
+		r.cards[card.Token] = previous
+		return err
+	}
+
+	return nil
+}
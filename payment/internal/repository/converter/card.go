@@ -0,0 +1,44 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func PaymentCardToModel(card *repoModel.PaymentCard) model.PaymentCard {
+	return model.PaymentCard{
+		Token:        card.Token,
+		UserUUID:     card.UserUUID,
+		Brand:        model.CardBrand(card.Brand),
+		MaskedNumber: card.MaskedNumber,
+		Last4:        card.Last4,
+		ExpiryMonth:  card.ExpiryMonth,
+		ExpiryYear:   card.ExpiryYear,
+		Sealed: model.SealedData{
+			KeyID:      card.KeyID,
+			Nonce:      slices.Clone(card.Nonce),
+			Ciphertext: slices.Clone(card.Ciphertext),
+		},
+		CreatedAt: card.CreatedAt,
+		UpdatedAt: card.UpdatedAt,
+	}
+}
+
+func PaymentCardToRepoModel(card model.PaymentCard) *repoModel.PaymentCard {
+	return &repoModel.PaymentCard{
+		Token:        card.Token,
+		UserUUID:     card.UserUUID,
+		Brand:        repoModel.CardBrand(card.Brand),
+		MaskedNumber: card.MaskedNumber,
+		Last4:        card.Last4,
+		ExpiryMonth:  card.ExpiryMonth,
+		ExpiryYear:   card.ExpiryYear,
+		KeyID:        card.Sealed.KeyID,
+		Nonce:        slices.Clone(card.Sealed.Nonce),
+		Ciphertext:   slices.Clone(card.Sealed.Ciphertext),
+		CreatedAt:    card.CreatedAt,
+		UpdatedAt:    card.UpdatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func DisputeToModel(dispute *repoModel.Dispute) model.Dispute {
+	var evidence *model.DisputeEvidence
+	if dispute.Evidence != nil {
+		evidence = &model.DisputeEvidence{
+			Description:    dispute.Evidence.Description,
+			TrackingNumber: dispute.Evidence.TrackingNumber,
+			DocumentURLs:   slices.Clone(dispute.Evidence.DocumentURLs),
+			SubmittedAt:    dispute.Evidence.SubmittedAt,
+		}
+	}
+
+	return model.Dispute{
+		UUID:            dispute.UUID,
+		TransactionUUID: dispute.TransactionUUID,
+		OrderUUID:       dispute.OrderUUID,
+		UserUUID:        dispute.UserUUID,
+		Amount:          money.Money(dispute.Amount),
+		Reason:          model.DisputeReason(dispute.Reason),
+		Status:          model.DisputeStatus(dispute.Status),
+		EvidenceDueBy:   dispute.EvidenceDueBy,
+		Evidence:        evidence,
+		CreatedAt:       dispute.CreatedAt,
+		UpdatedAt:       dispute.UpdatedAt,
+		ResolvedAt:      dispute.ResolvedAt,
+	}
+}
+
+func DisputeToRepoModel(dispute model.Dispute) *repoModel.Dispute {
+	var evidence *repoModel.DisputeEvidence
+	if dispute.Evidence != nil {
+		evidence = &repoModel.DisputeEvidence{
+			Description:    dispute.Evidence.Description,
+			TrackingNumber: dispute.Evidence.TrackingNumber,
+			DocumentURLs:   slices.Clone(dispute.Evidence.DocumentURLs),
+			SubmittedAt:    dispute.Evidence.SubmittedAt,
+		}
+	}
+
+	return &repoModel.Dispute{
+		UUID:            dispute.UUID,
+		TransactionUUID: dispute.TransactionUUID,
+		OrderUUID:       dispute.OrderUUID,
+		UserUUID:        dispute.UserUUID,
+		Amount:          repoModel.Money(dispute.Amount),
+		Reason:          repoModel.DisputeReason(dispute.Reason),
+		Status:          repoModel.DisputeStatus(dispute.Status),
+		EvidenceDueBy:   dispute.EvidenceDueBy,
+		Evidence:        evidence,
+		CreatedAt:       dispute.CreatedAt,
+		UpdatedAt:       dispute.UpdatedAt,
+		ResolvedAt:      dispute.ResolvedAt,
+	}
+}
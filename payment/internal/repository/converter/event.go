@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func PaymentEventToModel(event *repoModel.PaymentEvent) model.PaymentEvent {
+	return model.PaymentEvent{
+		Sequence:          event.Sequence,
+		Type:              model.PaymentEventType(event.Type),
+		TransactionUUID:   event.TransactionUUID,
+		OrderUUID:         event.OrderUUID,
+		UserUUID:          event.UserUUID,
+		PaymentMethod:     model.PaymentMethod(event.PaymentMethod),
+		TransactionStatus: model.TransactionStatus(event.TransactionStatus),
+		Amount:            money.Money(event.Amount),
+		RefundUUID:        event.RefundUUID,
+		DisputeUUID:       event.DisputeUUID,
+		Reason:            event.Reason,
+		CreatedAt:         event.CreatedAt,
+	}
+}
+
+func PaymentEventToRepoModel(event model.PaymentEvent) *repoModel.PaymentEvent {
+	return &repoModel.PaymentEvent{
+		Sequence:          event.Sequence,
+		Type:              repoModel.PaymentEventType(event.Type),
+		TransactionUUID:   event.TransactionUUID,
+		OrderUUID:         event.OrderUUID,
+		UserUUID:          event.UserUUID,
+		PaymentMethod:     repoModel.PaymentMethod(event.PaymentMethod),
+		TransactionStatus: repoModel.TransactionStatus(event.TransactionStatus),
+		Amount:            repoModel.Money(event.Amount),
+		RefundUUID:        event.RefundUUID,
+		DisputeUUID:       event.DisputeUUID,
+		Reason:            event.Reason,
+		CreatedAt:         event.CreatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func FraudDecisionToModel(decision *repoModel.FraudDecision) model.FraudDecision {
+	return model.FraudDecision{
+		UUID:          decision.UUID,
+		OrderUUID:     decision.OrderUUID,
+		UserUUID:      decision.UserUUID,
+		PaymentMethod: model.PaymentMethod(decision.PaymentMethod),
+		Amount:        money.Money(decision.Amount),
+		Outcome:       model.FraudOutcome(decision.Outcome),
+		Reason:        model.FraudReason(decision.Reason),
+		Rule:          decision.Rule,
+		Description:   decision.Description,
+		CreatedAt:     decision.CreatedAt,
+	}
+}
+
+func FraudDecisionToRepoModel(decision model.FraudDecision) *repoModel.FraudDecision {
+	return &repoModel.FraudDecision{
+		UUID:          decision.UUID,
+		OrderUUID:     decision.OrderUUID,
+		UserUUID:      decision.UserUUID,
+		PaymentMethod: repoModel.PaymentMethod(decision.PaymentMethod),
+		Amount:        repoModel.Money(decision.Amount),
+		Outcome:       repoModel.FraudOutcome(decision.Outcome),
+		Reason:        repoModel.FraudReason(decision.Reason),
+		Rule:          decision.Rule,
+		Description:   decision.Description,
+		CreatedAt:     decision.CreatedAt,
+	}
+}
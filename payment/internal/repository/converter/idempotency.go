@@ -0,0 +1,15 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func IdempotencyRecordToModel(record *repoModel.IdempotencyRecord) model.IdempotencyRecord {
+	return model.IdempotencyRecord(*record)
+}
+
+func IdempotencyRecordToRepoModel(record model.IdempotencyRecord) *repoModel.IdempotencyRecord {
+	r := repoModel.IdempotencyRecord(record)
+	return &r
+}
@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func InstallmentPlanToModel(plan *repoModel.InstallmentPlan) model.InstallmentPlan {
+	installments := make([]model.Installment, 0, len(plan.Installments))
+	for _, installment := range plan.Installments {
+		installments = append(installments, model.Installment{
+			Number:          installment.Number,
+			DueAt:           installment.DueAt,
+			Principal:       money.Money(installment.Principal),
+			Interest:        money.Money(installment.Interest),
+			Amount:          money.Money(installment.Amount),
+			Status:          model.InstallmentStatus(installment.Status),
+			PaidAt:          installment.PaidAt,
+			Attempts:        installment.Attempts,
+			NextAttemptAt:   installment.NextAttemptAt,
+			LastDeclineCode: installment.LastDeclineCode,
+		})
+	}
+
+	return model.InstallmentPlan{
+		UUID:            plan.UUID,
+		TransactionUUID: plan.TransactionUUID,
+		OrderUUID:       plan.OrderUUID,
+		UserUUID:        plan.UserUUID,
+		Status:          model.InstallmentPlanStatus(plan.Status),
+		InstallmentQuote: model.InstallmentQuote{
+			Principal:             money.Money(plan.Principal),
+			TermMonths:            plan.TermMonths,
+			AnnualRateBasisPoints: plan.AnnualRateBasisPoints,
+			Installments:          installments,
+			TotalInterest:         money.Money(plan.TotalInterest),
+			Total:                 money.Money(plan.Total),
+		},
+		MissedCount: plan.MissedCount,
+		CreatedAt:   plan.CreatedAt,
+		UpdatedAt:   plan.UpdatedAt,
+	}
+}
+
+func InstallmentPlanToRepoModel(plan model.InstallmentPlan) *repoModel.InstallmentPlan {
+	installments := make([]repoModel.Installment, 0, len(plan.Installments))
+	for _, installment := range plan.Installments {
+		installments = append(installments, repoModel.Installment{
+			Number:          installment.Number,
+			DueAt:           installment.DueAt,
+			Principal:       repoModel.Money(installment.Principal),
+			Interest:        repoModel.Money(installment.Interest),
+			Amount:          repoModel.Money(installment.Amount),
+			Status:          repoModel.InstallmentStatus(installment.Status),
+			PaidAt:          installment.PaidAt,
+			Attempts:        installment.Attempts,
+			NextAttemptAt:   installment.NextAttemptAt,
+			LastDeclineCode: installment.LastDeclineCode,
+		})
+	}
+
+	return &repoModel.InstallmentPlan{
+		UUID:                  plan.UUID,
+		TransactionUUID:       plan.TransactionUUID,
+		OrderUUID:             plan.OrderUUID,
+		UserUUID:              plan.UserUUID,
+		Status:                repoModel.InstallmentPlanStatus(plan.Status),
+		Principal:             repoModel.Money(plan.Principal),
+		TermMonths:            plan.TermMonths,
+		AnnualRateBasisPoints: plan.AnnualRateBasisPoints,
+		Installments:          installments,
+		TotalInterest:         repoModel.Money(plan.TotalInterest),
+		Total:                 repoModel.Money(plan.Total),
+		MissedCount:           plan.MissedCount,
+		CreatedAt:             plan.CreatedAt,
+		UpdatedAt:             plan.UpdatedAt,
+	}
+}
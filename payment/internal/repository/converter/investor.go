@@ -0,0 +1,59 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func InvestorToModel(investor *repoModel.Investor) model.Investor {
+	return model.Investor{
+		UUID:                investor.UUID,
+		Name:                investor.Name,
+		Available:           money.Money(investor.Available),
+		Held:                money.Money(investor.Held),
+		Spent:               money.Money(investor.Spent),
+		AuthorizedUserUUIDs: slices.Clone(investor.AuthorizedUserUUIDs),
+		CreatedAt:           investor.CreatedAt,
+		UpdatedAt:           investor.UpdatedAt,
+	}
+}
+
+func InvestorToRepoModel(investor model.Investor) *repoModel.Investor {
+	return &repoModel.Investor{
+		UUID:                investor.UUID,
+		Name:                investor.Name,
+		Available:           repoModel.Money(investor.Available),
+		Held:                repoModel.Money(investor.Held),
+		Spent:               repoModel.Money(investor.Spent),
+		AuthorizedUserUUIDs: slices.Clone(investor.AuthorizedUserUUIDs),
+		CreatedAt:           investor.CreatedAt,
+		UpdatedAt:           investor.UpdatedAt,
+	}
+}
+
+func InvestorMovementToModel(movement *repoModel.InvestorMovement) model.InvestorMovement {
+	return model.InvestorMovement{
+		UUID:            movement.UUID,
+		InvestorUUID:    movement.InvestorUUID,
+		Kind:            model.InvestorMovementKind(movement.Kind),
+		Amount:          money.Money(movement.Amount),
+		TransactionUUID: movement.TransactionUUID,
+		UserUUID:        movement.UserUUID,
+		CreatedAt:       movement.CreatedAt,
+	}
+}
+
+func InvestorMovementToRepoModel(movement model.InvestorMovement) *repoModel.InvestorMovement {
+	return &repoModel.InvestorMovement{
+		UUID:            movement.UUID,
+		InvestorUUID:    movement.InvestorUUID,
+		Kind:            repoModel.InvestorMovementKind(movement.Kind),
+		Amount:          repoModel.Money(movement.Amount),
+		TransactionUUID: movement.TransactionUUID,
+		UserUUID:        movement.UserUUID,
+		CreatedAt:       movement.CreatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func JournalEntryToModel(entry *repoModel.JournalEntry) model.JournalEntry {
+	postings := make([]model.Posting, 0, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		postings = append(postings, model.Posting{
+			Account: model.LedgerAccount{
+				Type:      model.LedgerAccountType(posting.Account.Type),
+				OwnerUUID: posting.Account.OwnerUUID,
+			},
+			Direction: model.PostingDirection(posting.Direction),
+			Amount:    money.Money(posting.Amount),
+		})
+	}
+
+	return model.JournalEntry{
+		UUID:              entry.UUID,
+		Kind:              model.JournalEntryKind(entry.Kind),
+		TransactionUUID:   entry.TransactionUUID,
+		RefundUUID:        entry.RefundUUID,
+		DisputeUUID:       entry.DisputeUUID,
+		ReversesEntryUUID: entry.ReversesEntryUUID,
+		Postings:          postings,
+		CreatedAt:         entry.CreatedAt,
+	}
+}
+
+func JournalEntryToRepoModel(entry model.JournalEntry) *repoModel.JournalEntry {
+	postings := make([]repoModel.Posting, 0, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		postings = append(postings, repoModel.Posting{
+			Account: repoModel.LedgerAccount{
+				Type:      repoModel.LedgerAccountType(posting.Account.Type),
+				OwnerUUID: posting.Account.OwnerUUID,
+			},
+			Direction: repoModel.PostingDirection(posting.Direction),
+			Amount:    repoModel.Money(posting.Amount),
+		})
+	}
+
+	return &repoModel.JournalEntry{
+		UUID:              entry.UUID,
+		Kind:              repoModel.JournalEntryKind(entry.Kind),
+		TransactionUUID:   entry.TransactionUUID,
+		RefundUUID:        entry.RefundUUID,
+		DisputeUUID:       entry.DisputeUUID,
+		ReversesEntryUUID: entry.ReversesEntryUUID,
+		Postings:          postings,
+		CreatedAt:         entry.CreatedAt,
+	}
+}
@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func ReceiptToModel(receipt *repoModel.Receipt) model.Receipt {
+	items := make([]model.ReceiptItem, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, model.ReceiptItem{
+			SKU:                item.SKU,
+			Name:               item.Name,
+			Quantity:           item.Quantity,
+			UnitPrice:          money.Money(item.UnitPrice),
+			Amount:             money.Money(item.Amount),
+			TaxRateBasisPoints: item.TaxRateBasisPoints,
+			Tax:                money.Money(item.Tax),
+		})
+	}
+
+	return model.Receipt{
+		UUID:                  receipt.UUID,
+		TransactionUUID:       receipt.TransactionUUID,
+		OrderUUID:             receipt.OrderUUID,
+		UserUUID:              receipt.UserUUID,
+		PaymentMethod:         model.PaymentMethod(receipt.PaymentMethod),
+		MaskedCardNumber:      receipt.MaskedCardNumber,
+		InstallmentTermMonths: receipt.InstallmentTermMonths,
+		Items:                 items,
+		Total:                 money.Money(receipt.Total),
+		TaxTotal:              money.Money(receipt.TaxTotal),
+		CreditAmount:          creditAmountToModel(receipt),
+		PaidAt:                receipt.PaidAt,
+		HTML:                  receipt.HTML,
+		Text:                  receipt.Text,
+		CreatedAt:             receipt.CreatedAt,
+	}
+}
+
+func ReceiptToRepoModel(receipt model.Receipt) *repoModel.Receipt {
+	items := make([]repoModel.ReceiptItem, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, repoModel.ReceiptItem{
+			SKU:                item.SKU,
+			Name:               item.Name,
+			Quantity:           item.Quantity,
+			UnitPrice:          repoModel.Money(item.UnitPrice),
+			Amount:             repoModel.Money(item.Amount),
+			TaxRateBasisPoints: item.TaxRateBasisPoints,
+			Tax:                repoModel.Money(item.Tax),
+		})
+	}
+
+	return &repoModel.Receipt{
+		UUID:                  receipt.UUID,
+		TransactionUUID:       receipt.TransactionUUID,
+		OrderUUID:             receipt.OrderUUID,
+		UserUUID:              receipt.UserUUID,
+		PaymentMethod:         repoModel.PaymentMethod(receipt.PaymentMethod),
+		MaskedCardNumber:      receipt.MaskedCardNumber,
+		InstallmentTermMonths: receipt.InstallmentTermMonths,
+		Items:                 items,
+		Total:                 repoModel.Money(receipt.Total),
+		TaxTotal:              repoModel.Money(receipt.TaxTotal),
+		CreditAmount:          optionalMoneyToRepoModel(receipt.CreditAmount),
+		PaidAt:                receipt.PaidAt,
+		HTML:                  receipt.HTML,
+		Text:                  receipt.Text,
+		CreatedAt:             receipt.CreatedAt,
+	}
+}
+
+// creditAmountToModel возвращает ноль в валюте чека для оплаты целиком.
+func creditAmountToModel(receipt *repoModel.Receipt) money.Money {
+	if receipt.CreditAmount == nil {
+		return money.Money{CurrencyCode: receipt.Total.CurrencyCode}
+	}
+	return money.Money(*receipt.CreditAmount)
+}
@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func RefundToModel(refund *repoModel.Refund) model.Refund {
+	return model.Refund{
+		UUID:             refund.UUID,
+		TransactionUUID:  refund.TransactionUUID,
+		Amount:           money.Money(refund.Amount),
+		WrittenOffAmount: writtenOffAmountToModel(refund),
+		Reason:           model.RefundReason(refund.Reason),
+		Status:           model.RefundStatus(refund.Status),
+		CreatedAt:        refund.CreatedAt,
+	}
+}
+
+func RefundToRepoModel(refund model.Refund) *repoModel.Refund {
+	return &repoModel.Refund{
+		UUID:             refund.UUID,
+		TransactionUUID:  refund.TransactionUUID,
+		Amount:           repoModel.Money(refund.Amount),
+		WrittenOffAmount: optionalMoneyToRepoModel(refund.WrittenOffAmount),
+		Reason:           repoModel.RefundReason(refund.Reason),
+		Status:           repoModel.RefundStatus(refund.Status),
+		CreatedAt:        refund.CreatedAt,
+	}
+}
+
+// writtenOffAmountToModel возвращает ноль в валюте возврата, если долг рассрочки не уменьшался.
+func writtenOffAmountToModel(refund *repoModel.Refund) money.Money {
+	if refund.WrittenOffAmount == nil {
+		return money.Money{CurrencyCode: refund.Amount.CurrencyCode}
+	}
+	return money.Money(*refund.WrittenOffAmount)
+}
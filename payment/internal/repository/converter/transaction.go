@@ -0,0 +1,128 @@
+package converter
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func TransactionToModel(transaction *repoModel.Transaction) model.Transaction {
+	return model.Transaction{
+		UUID:                   transaction.UUID,
+		OrderUUID:              transaction.OrderUUID,
+		UserUUID:               transaction.UserUUID,
+		PaymentMethod:          model.PaymentMethod(transaction.PaymentMethod),
+		Status:                 model.TransactionStatus(transaction.Status),
+		Amount:                 money.Money(transaction.Amount),
+		SettlementAmount:       money.Money(transaction.SettlementAmount),
+		ExchangeRate:           transaction.ExchangeRate,
+		RefundedAmount:         money.Money(transaction.RefundedAmount),
+		ChargedBackAmount:      chargedBackAmountToModel(transaction),
+		CollectedAmount:        collectedAmountToModel(transaction),
+		AuthorizedAmount:       money.Money(transaction.AuthorizedAmount),
+		AuthorizationExpiresAt: transaction.AuthorizationExpiresAt,
+		CapturedAt:             transaction.CapturedAt,
+		DeclineCode:            transaction.DeclineCode,
+		InvestorUUID:           transaction.InvestorUUID,
+		InstallmentTermMonths:  transaction.InstallmentTermMonths,
+		PaymentToken:           transaction.PaymentToken,
+		MaskedCardNumber:       transaction.MaskedCardNumber,
+		LineItems:              lineItemsToModel(transaction.LineItems),
+		CreatedAt:              transaction.CreatedAt,
+	}
+}
+
+func TransactionToRepoModel(transaction model.Transaction) *repoModel.Transaction {
+	return &repoModel.Transaction{
+		UUID:                   transaction.UUID,
+		OrderUUID:              transaction.OrderUUID,
+		UserUUID:               transaction.UserUUID,
+		PaymentMethod:          repoModel.PaymentMethod(transaction.PaymentMethod),
+		Status:                 repoModel.TransactionStatus(transaction.Status),
+		Amount:                 repoModel.Money(transaction.Amount),
+		SettlementAmount:       repoModel.Money(transaction.SettlementAmount),
+		ExchangeRate:           transaction.ExchangeRate,
+		RefundedAmount:         repoModel.Money(transaction.RefundedAmount),
+		ChargedBackAmount:      optionalMoneyToRepoModel(transaction.ChargedBackAmount),
+		CollectedAmount:        optionalMoneyToRepoModel(transaction.CollectedAmount),
+		AuthorizedAmount:       repoModel.Money(transaction.AuthorizedAmount),
+		AuthorizationExpiresAt: transaction.AuthorizationExpiresAt,
+		CapturedAt:             transaction.CapturedAt,
+		DeclineCode:            transaction.DeclineCode,
+		InvestorUUID:           transaction.InvestorUUID,
+		InstallmentTermMonths:  transaction.InstallmentTermMonths,
+		PaymentToken:           transaction.PaymentToken,
+		MaskedCardNumber:       transaction.MaskedCardNumber,
+		LineItems:              lineItemsToRepoModel(transaction.LineItems),
+		CreatedAt:              transaction.CreatedAt,
+	}
+}
+
+func lineItemsToModel(items []repoModel.LineItem) []model.LineItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	result := make([]model.LineItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.LineItem{
+			SKU:       item.SKU,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: money.Money(item.UnitPrice),
+		})
+	}
+	return result
+}
+
+func lineItemsToRepoModel(items []model.LineItem) []repoModel.LineItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	result := make([]repoModel.LineItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, repoModel.LineItem{
+			SKU:       item.SKU,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: repoModel.Money(item.UnitPrice),
+		})
+	}
+	return result
+}
+
+// chargedBackAmountToModel восполняет сумму споров у транзакций, сохранённых до их появления.
+func chargedBackAmountToModel(transaction *repoModel.Transaction) money.Money {
+	if transaction.ChargedBackAmount == nil {
+		return money.Money{CurrencyCode: transaction.Amount.CurrencyCode}
+	}
+	return money.Money(*transaction.ChargedBackAmount)
+}
+
+// collectedAmountToModel восполняет собранную сумму у транзакций, сохранённых до её
+// появления: тогда списанная транзакция проводилась в книге на всю сумму.
+func collectedAmountToModel(transaction *repoModel.Transaction) money.Money {
+	if transaction.CollectedAmount != nil {
+		return money.Money(*transaction.CollectedAmount)
+	}
+
+	switch model.TransactionStatus(transaction.Status) {
+	case model.TransactionStatusPaid,
+		model.TransactionStatusPartiallyRefunded,
+		model.TransactionStatusRefunded,
+		model.TransactionStatusChargedBack:
+		return money.Money(transaction.Amount)
+	default:
+		return money.Money{CurrencyCode: transaction.Amount.CurrencyCode}
+	}
+}
+
+// optionalMoneyToRepoModel не сохраняет нулевую сумму.
+func optionalMoneyToRepoModel(amount money.Money) *repoModel.Money {
+	if amount.IsZero() {
+		return nil
+	}
+	converted := repoModel.Money(amount)
+	return &converted
+}
@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func WebhookSubscriptionToModel(subscription *repoModel.WebhookSubscription) model.WebhookSubscription {
+	eventTypes := make([]model.PaymentEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, model.PaymentEventType(eventType))
+	}
+
+	return model.WebhookSubscription{
+		UUID:       subscription.UUID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		Secret:     subscription.Secret,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+func WebhookSubscriptionToRepoModel(subscription model.WebhookSubscription) *repoModel.WebhookSubscription {
+	eventTypes := make([]repoModel.PaymentEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, repoModel.PaymentEventType(eventType))
+	}
+
+	return &repoModel.WebhookSubscription{
+		UUID:       subscription.UUID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		Secret:     subscription.Secret,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+func WebhookDeliveryToModel(delivery *repoModel.WebhookDelivery) model.WebhookDelivery {
+	return model.WebhookDelivery{
+		UUID:             delivery.UUID,
+		SubscriptionUUID: delivery.SubscriptionUUID,
+		EventSequence:    delivery.EventSequence,
+		EventType:        model.PaymentEventType(delivery.EventType),
+		Payload:          slices.Clone(delivery.Payload),
+		Status:           model.WebhookDeliveryStatus(delivery.Status),
+		Attempts:         delivery.Attempts,
+		NextAttemptAt:    delivery.NextAttemptAt,
+		LastError:        delivery.LastError,
+		DeliveredAt:      delivery.DeliveredAt,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+	}
+}
+
+func WebhookDeliveryToRepoModel(delivery model.WebhookDelivery) *repoModel.WebhookDelivery {
+	return &repoModel.WebhookDelivery{
+		UUID:             delivery.UUID,
+		SubscriptionUUID: delivery.SubscriptionUUID,
+		EventSequence:    delivery.EventSequence,
+		EventType:        repoModel.PaymentEventType(delivery.EventType),
+		Payload:          slices.Clone(delivery.Payload),
+		Status:           repoModel.WebhookDeliveryStatus(delivery.Status),
+		Attempts:         delivery.Attempts,
+		NextAttemptAt:    delivery.NextAttemptAt,
+		LastError:        delivery.LastError,
+		DeliveredAt:      delivery.DeliveredAt,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+	}
+}
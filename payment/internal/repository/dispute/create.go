@@ -0,0 +1,25 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, dispute model.Dispute) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.disputes[dispute.UUID]; ok {
+		return model.ErrDisputeAlreadyExists
+	}
+
+	r.disputes[dispute.UUID] = converter.DisputeToRepoModel(dispute)
+	if err := r.save(); err != nil {
+		delete(r.disputes, dispute.UUID)
+		return err
+	}
+
+	return nil
+}
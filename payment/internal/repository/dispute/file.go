@@ -0,0 +1,35 @@
+package dispute
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+// load читает споры из файла. Отсутствующий файл означает пустое хранилище.
+func (r *repository) load() error {
+	var disputes []*repoModel.Dispute
+	if err := file.Load(r.path, &disputes); err != nil {
+		return err
+	}
+
+	for _, dispute := range disputes {
+		r.disputes[dispute.UUID] = dispute
+	}
+
+	return nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	disputes := make([]*repoModel.Dispute, 0, len(r.disputes))
+	for _, dispute := range r.disputes {
+		disputes = append(disputes, dispute)
+	}
+	sortByCreatedAt(disputes)
+
+	return file.Save(r.path, disputes)
+}
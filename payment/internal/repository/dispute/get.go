@@ -0,0 +1,20 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.Dispute, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dispute, ok := r.disputes[uuid]
+	if !ok {
+		return model.Dispute{}, model.ErrDisputeNotFound
+	}
+
+	return converter.DisputeToModel(dispute), nil
+}
@@ -0,0 +1,51 @@
+package dispute
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func (r *repository) List(_ context.Context, filter model.DisputesFilter) ([]model.Dispute, error) {
+	r.mu.RLock()
+	matched := make([]*repoModel.Dispute, 0)
+	for _, dispute := range r.disputes {
+		if matches(dispute, filter) {
+			matched = append(matched, dispute)
+		}
+	}
+	r.mu.RUnlock()
+
+	sortByCreatedAt(matched)
+
+	result := make([]model.Dispute, 0, len(matched))
+	for _, dispute := range matched {
+		result = append(result, converter.DisputeToModel(dispute))
+	}
+
+	return result, nil
+}
+
+func matches(dispute *repoModel.Dispute, filter model.DisputesFilter) bool {
+	switch {
+	case filter.TransactionUUID != "" && dispute.TransactionUUID != filter.TransactionUUID:
+		return false
+	case len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, model.DisputeStatus(dispute.Status)):
+		return false
+	default:
+		return true
+	}
+}
+
+func sortByCreatedAt(disputes []*repoModel.Dispute) {
+	slices.SortFunc(disputes, func(a, b *repoModel.Dispute) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+}
@@ -0,0 +1,38 @@
+package dispute
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.DisputeRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище споров.
+// Если задан path, каждое изменение сохраняется в файл.
+type repository struct {
+	mu       sync.RWMutex
+	disputes map[string]*repoModel.Dispute
+	path     string
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		disputes: make(map[string]*repoModel.Dispute),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее споры в JSON-файл по пути path.
+// Если файл уже существует, споры загружаются из него.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,26 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Update(_ context.Context, dispute model.Dispute) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.disputes[dispute.UUID]
+	if !ok {
+		return model.ErrDisputeNotFound
+	}
+
+	r.disputes[dispute.UUID] = converter.DisputeToRepoModel(dispute)
+	if err := r.save(); err != nil {
+		r.disputes[dispute.UUID] = previous
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+)
+
+func (r *repository) Append(_ context.Context, event model.PaymentEvent) (model.PaymentEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Номер события совпадает с его позицией в журнале, начиная с 1.
+	event.Sequence = int64(len(r.events)) + 1
+	r.events = append(r.events, converter.PaymentEventToRepoModel(event))
+	if r.path == "" {
+		return event, nil
+	}
+	if err := file.Save(r.path, r.events); err != nil {
+		r.events = r.events[:len(r.events)-1]
+		return model.PaymentEvent{}, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,23 @@
+package event
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) List(_ context.Context, afterSequence int64, limit int) ([]model.PaymentEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	start := min(max(afterSequence, 0), int64(len(r.events)))
+	end := min(start+int64(limit), int64(len(r.events)))
+
+	result := make([]model.PaymentEvent, 0, end-start)
+	for _, event := range r.events[start:end] {
+		result = append(result, converter.PaymentEventToModel(event))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package event
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.PaymentEventRepository = (*repository)(nil)
+
+// repository представляет потокобезопасный журнал событий платежей.
+// Если задан path, каждое новое событие сохраняется в файл.
+type repository struct {
+	mu     sync.RWMutex
+	events []*repoModel.PaymentEvent
+	path   string
+}
+
+// NewRepository создаёт in-memory журнал, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// NewFileRepository создаёт журнал, сохраняющий события в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := file.Load(path, &r.events); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,25 @@
+package fraud
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+)
+
+func (r *repository) Create(_ context.Context, decision model.FraudDecision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.decisions = append(r.decisions, converter.FraudDecisionToRepoModel(decision))
+	if r.path == "" {
+		return nil
+	}
+	if err := file.Save(r.path, r.decisions); err != nil {
+		r.decisions = r.decisions[:len(r.decisions)-1]
+		return err
+	}
+
+	return nil
+}
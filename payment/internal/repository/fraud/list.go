@@ -0,0 +1,38 @@
+package fraud
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func (r *repository) List(_ context.Context, filter model.FraudDecisionsFilter) ([]model.FraudDecision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.FraudDecision, 0)
+	for _, decision := range r.decisions {
+		if matches(decision, filter) {
+			result = append(result, converter.FraudDecisionToModel(decision))
+		}
+	}
+
+	return result, nil
+}
+
+func matches(decision *repoModel.FraudDecision, filter model.FraudDecisionsFilter) bool {
+	switch {
+	case filter.UserUUID != "" && decision.UserUUID != filter.UserUUID:
+		return false
+	case filter.OrderUUID != "" && decision.OrderUUID != filter.OrderUUID:
+		return false
+	case filter.Outcome != model.FraudOutcomeUnspecified && model.FraudOutcome(decision.Outcome) != filter.Outcome:
+		return false
+	case !filter.CreatedFrom.IsZero() && decision.CreatedAt.Before(filter.CreatedFrom):
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,36 @@
+package fraud
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.FraudDecisionRepository = (*repository)(nil)
+
+// repository представляет потокобезопасный журнал решений антифрода.
+// Если задан path, каждое новое решение сохраняется в файл.
+type repository struct {
+	mu        sync.RWMutex
+	decisions []*repoModel.FraudDecision
+	path      string
+}
+
+// NewRepository создаёт in-memory журнал, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// NewFileRepository создаёт журнал, сохраняющий решения в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := file.Load(path, &r.decisions); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,30 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+func (r *repository) DeleteExpired(_ context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for key, record := range r.records {
+		if !now.Before(record.ExpiresAt) {
+			delete(r.records, key)
+			deleted++
+		}
+	}
+	if deleted == 0 {
+		return 0, nil
+	}
+
+	// Ключи уже удалены из памяти; если файл не перезаписался, при загрузке
+	// истёкшие ключи всё равно будут пропущены.
+	if err := r.save(); err != nil {
+		return deleted, err
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,45 @@
+package idempotency
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+// load читает ключи из файла. Отсутствующий файл означает пустое хранилище,
+// а ключи, истёкшие за время остановки сервиса, не загружаются.
+func (r *repository) load() error {
+	var records []*repoModel.IdempotencyRecord
+	if err := file.Load(r.path, &records); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, record := range records {
+		if now.Before(record.ExpiresAt) {
+			r.records[record.Key] = record
+		}
+	}
+
+	return nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	records := make([]*repoModel.IdempotencyRecord, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, record)
+	}
+	slices.SortFunc(records, func(a, b *repoModel.IdempotencyRecord) int {
+		return cmp.Or(a.ExpiresAt.Compare(b.ExpiresAt), cmp.Compare(a.Key, b.Key))
+	})
+
+	return file.Save(r.path, records)
+}
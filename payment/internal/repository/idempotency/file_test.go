@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func TestFileRepositoryKeepsKeysAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "idempotency_keys.json")
+
+	r, err := NewFileRepository(path)
+	if err != nil {
+		t.Fatalf("NewFileRepository() error = %v", err)
+	}
+	records := []model.IdempotencyRecord{
+		{Key: "active", RequestHash: "hash", TransactionUUID: "6f1c1d0e-8a8b-4c53-9d4e-0b1a2c3d4e5f", ExpiresAt: time.Now().Add(time.Hour)},
+		{Key: "expired", RequestHash: "hash", TransactionUUID: "0d9e8f7a-6b5c-4d3e-8f1a-2b3c4d5e6f70", ExpiresAt: time.Now().Add(-time.Minute)},
+	}
+	for _, record := range records {
+		if err := r.Save(ctx, record); err != nil {
+			t.Fatalf("Save(%q) error = %v", record.Key, err)
+		}
+	}
+
+	reopened, err := NewFileRepository(path)
+	if err != nil {
+		t.Fatalf("NewFileRepository() after restart error = %v", err)
+	}
+
+	got, err := reopened.Get(ctx, "active")
+	if err != nil {
+		t.Fatalf("Get(active) error = %v", err)
+	}
+	if got.RequestHash != records[0].RequestHash || got.TransactionUUID != records[0].TransactionUUID {
+		t.Fatalf("Get(active) = %+v, want %+v", got, records[0])
+	}
+	if _, err := reopened.Get(ctx, "expired"); !errors.Is(err, model.ErrIdempotencyKeyNotFound) {
+		t.Fatalf("Get(expired) error = %v, want %v", err, model.ErrIdempotencyKeyNotFound)
+	}
+	if len(reopened.records) != 1 {
+		t.Fatalf("loaded %d keys, want only the active one", len(reopened.records))
+	}
+}
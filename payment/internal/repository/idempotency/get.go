@@ -0,0 +1,22 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, key string) (model.IdempotencyRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Истёкший ключ может ещё не быть удалён очисткой, но уже не действует.
+	record, ok := r.records[key]
+	if !ok || !time.Now().Before(record.ExpiresAt) {
+		return model.IdempotencyRecord{}, model.ErrIdempotencyKeyNotFound
+	}
+
+	return converter.IdempotencyRecordToModel(record), nil
+}
@@ -0,0 +1,38 @@
+package idempotency
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.IdempotencyRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище ключей идемпотентности.
+// Если задан path, каждое изменение сохраняется в файл.
+type repository struct {
+	mu      sync.RWMutex
+	records map[string]*repoModel.IdempotencyRecord
+	path    string
+}
+
+// NewRepository создаёт in-memory хранилище, ключи которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		records: make(map[string]*repoModel.IdempotencyRecord),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее ключи в JSON-файл по пути path.
+// Если файл уже существует, из него загружаются ключи, срок которых ещё не истёк.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Save(_ context.Context, record model.IdempotencyRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, existed := r.records[record.Key]
+	r.records[record.Key] = converter.IdempotencyRecordToRepoModel(record)
+	if err := r.save(); err != nil {
+		if existed {
+			r.records[record.Key] = previous
+		} else {
+			delete(r.records, record.Key)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package installment
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, plan model.InstallmentPlan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// У транзакции может быть только один план.
+	for _, existing := range r.plans {
+		if existing.UUID == plan.UUID || existing.TransactionUUID == plan.TransactionUUID {
+			return model.ErrInstallmentPlanAlreadyExists
+		}
+	}
+
+	r.plans[plan.UUID] = converter.InstallmentPlanToRepoModel(plan)
+	if err := r.save(); err != nil {
+		delete(r.plans, plan.UUID)
+		return err
+	}
+
+	return nil
+}
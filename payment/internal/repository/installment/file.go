@@ -0,0 +1,35 @@
+package installment
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+// load читает планы из файла. Отсутствующий файл означает пустое хранилище.
+func (r *repository) load() error {
+	var plans []*repoModel.InstallmentPlan
+	if err := file.Load(r.path, &plans); err != nil {
+		return err
+	}
+
+	for _, plan := range plans {
+		r.plans[plan.UUID] = plan
+	}
+
+	return nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	plans := make([]*repoModel.InstallmentPlan, 0, len(r.plans))
+	for _, plan := range r.plans {
+		plans = append(plans, plan)
+	}
+	sortByCreatedAt(plans)
+
+	return file.Save(r.path, plans)
+}
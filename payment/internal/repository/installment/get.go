@@ -0,0 +1,20 @@
+package installment
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.InstallmentPlan, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	plan, ok := r.plans[uuid]
+	if !ok {
+		return model.InstallmentPlan{}, model.ErrInstallmentPlanNotFound
+	}
+
+	return converter.InstallmentPlanToModel(plan), nil
+}
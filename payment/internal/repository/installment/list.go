@@ -0,0 +1,53 @@
+package installment
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func (r *repository) List(_ context.Context, filter model.InstallmentPlansFilter) ([]model.InstallmentPlan, error) {
+	r.mu.RLock()
+	matched := make([]*repoModel.InstallmentPlan, 0)
+	for _, plan := range r.plans {
+		if matches(plan, filter) {
+			matched = append(matched, plan)
+		}
+	}
+	r.mu.RUnlock()
+
+	sortByCreatedAt(matched)
+
+	result := make([]model.InstallmentPlan, 0, len(matched))
+	for _, plan := range matched {
+		result = append(result, converter.InstallmentPlanToModel(plan))
+	}
+
+	return result, nil
+}
+
+func matches(plan *repoModel.InstallmentPlan, filter model.InstallmentPlansFilter) bool {
+	switch {
+	case filter.UserUUID != "" && plan.UserUUID != filter.UserUUID:
+		return false
+	case filter.TransactionUUID != "" && plan.TransactionUUID != filter.TransactionUUID:
+		return false
+	case len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, model.InstallmentPlanStatus(plan.Status)):
+		return false
+	default:
+		return true
+	}
+}
+
+func sortByCreatedAt(plans []*repoModel.InstallmentPlan) {
+	slices.SortFunc(plans, func(a, b *repoModel.InstallmentPlan) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+}
@@ -0,0 +1,38 @@
+package installment
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.InstallmentPlanRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище планов рассрочки.
+// Если задан path, каждое изменение сохраняется в файл.
+type repository struct {
+	mu    sync.RWMutex
+	plans map[string]*repoModel.InstallmentPlan
+	path  string
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		plans: make(map[string]*repoModel.InstallmentPlan),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее планы в JSON-файл по пути path.
+// Если файл уже существует, планы загружаются из него.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
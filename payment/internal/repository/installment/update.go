@@ -0,0 +1,26 @@
+package installment
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Update(_ context.Context, plan model.InstallmentPlan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.plans[plan.UUID]
+	if !ok {
+		return model.ErrInstallmentPlanNotFound
+	}
+
+	r.plans[plan.UUID] = converter.InstallmentPlanToRepoModel(plan)
+	if err := r.save(); err != nil {
+		r.plans[plan.UUID] = previous
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package investor
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, investor model.Investor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.investors[investor.UUID] = converter.InvestorToRepoModel(investor)
+	if err := r.save(); err != nil {
+		delete(r.investors, investor.UUID)
+		return err
+	}
+
+	return nil
+}
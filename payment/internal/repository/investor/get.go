@@ -0,0 +1,20 @@
+package investor
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.Investor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	investor, ok := r.investors[uuid]
+	if !ok {
+		return model.Investor{}, model.ErrInvestorNotFound
+	}
+
+	return converter.InvestorToModel(investor), nil
+}
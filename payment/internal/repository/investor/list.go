@@ -0,0 +1,41 @@
+package investor
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func (r *repository) List(_ context.Context) ([]model.Investor, error) {
+	r.mu.RLock()
+	investors := make([]*repoModel.Investor, 0, len(r.investors))
+	for _, investor := range r.investors {
+		investors = append(investors, investor)
+	}
+	r.mu.RUnlock()
+
+	sortByCreatedAt(investors)
+
+	result := make([]model.Investor, 0, len(investors))
+	for _, investor := range investors {
+		result = append(result, converter.InvestorToModel(investor))
+	}
+
+	return result, nil
+}
+
+func (r *repository) ListMovements(_ context.Context, investorUUID string) ([]model.InvestorMovement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.InvestorMovement, 0)
+	for _, movement := range r.movements {
+		if movement.InvestorUUID == investorUUID {
+			result = append(result, converter.InvestorMovementToModel(movement))
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package investor
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.InvestorRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище инвесторов и движений их средств.
+// Если задан path, инвесторы и движения сохраняются в один файл, чтобы баланс
+// и объясняющие его движения не расходились после сбоя.
+type repository struct {
+	mu        sync.RWMutex
+	investors map[string]*repoModel.Investor
+	movements []*repoModel.InvestorMovement
+	path      string
+}
+
+// state — содержимое файла хранилища.
+type state struct {
+	Investors []*repoModel.Investor         `json:"investors"`
+	Movements []*repoModel.InvestorMovement `json:"movements"`
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		investors: make(map[string]*repoModel.Investor),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее инвесторов в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	var loaded state
+	if err := file.Load(path, &loaded); err != nil {
+		return nil, err
+	}
+	for _, investor := range loaded.Investors {
+		r.investors[investor.UUID] = investor
+	}
+	r.movements = loaded.Movements
+
+	return r, nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	investors := make([]*repoModel.Investor, 0, len(r.investors))
+	for _, investor := range r.investors {
+		investors = append(investors, investor)
+	}
+	sortByCreatedAt(investors)
+
+	return file.Save(r.path, state{Investors: investors, Movements: r.movements})
+}
+
+func sortByCreatedAt(investors []*repoModel.Investor) {
+	slices.SortFunc(investors, func(a, b *repoModel.Investor) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+}
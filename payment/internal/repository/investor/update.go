@@ -0,0 +1,42 @@
+package investor
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Update(
+	_ context.Context,
+	uuid string,
+	update func(investor *model.Investor) ([]model.InvestorMovement, error),
+) (model.Investor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.investors[uuid]
+	if !ok {
+		return model.Investor{}, model.ErrInvestorNotFound
+	}
+
+	investor := converter.InvestorToModel(stored)
+	movements, err := update(&investor)
+	if err != nil {
+		return model.Investor{}, err
+	}
+
+	r.investors[uuid] = converter.InvestorToRepoModel(investor)
+	count := len(r.movements)
+	for _, movement := range movements {
+		r.movements = append(r.movements, converter.InvestorMovementToRepoModel(movement))
+	}
+
+	if err := r.save(); err != nil {
+		r.investors[uuid] = stored
+		r.movements = r.movements[:count]
+		return model.Investor{}, err
+	}
+
+	return investor, nil
+}
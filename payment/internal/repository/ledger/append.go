@@ -0,0 +1,25 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+)
+
+func (r *repository) Append(_ context.Context, entry model.JournalEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, converter.JournalEntryToRepoModel(entry))
+	if r.path == "" {
+		return nil
+	}
+	if err := file.Save(r.path, r.entries); err != nil {
+		r.entries = r.entries[:len(r.entries)-1]
+		return err
+	}
+
+	return nil
+}
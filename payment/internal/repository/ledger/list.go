@@ -0,0 +1,23 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) List(_ context.Context, transactionUUID string) ([]model.JournalEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.JournalEntry, 0, len(r.entries))
+	for _, entry := range r.entries {
+		if transactionUUID != "" && entry.TransactionUUID != transactionUUID {
+			continue
+		}
+		result = append(result, converter.JournalEntryToModel(entry))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,36 @@
+package ledger
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.LedgerRepository = (*repository)(nil)
+
+// repository представляет потокобезопасный журнал проводок.
+// Если задан path, каждая новая запись сохраняется в файл.
+type repository struct {
+	mu      sync.RWMutex
+	entries []*repoModel.JournalEntry
+	path    string
+}
+
+// NewRepository создаёт in-memory журнал, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// NewFileRepository создаёт журнал, сохраняющий записи в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := file.Load(path, &r.entries); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+type CardBrand int32
+
+// PaymentCard хранится в файле как JSON, поэтому поля размечены тегами.
+// Nonce и Ciphertext кодируются в base64, номер карты в открытом виде не хранится.
+type PaymentCard struct {
+	Token        string    `json:"token"`
+	UserUUID     string    `json:"user_uuid"`
+	Brand        CardBrand `json:"brand"`
+	MaskedNumber string    `json:"masked_number"`
+	Last4        string    `json:"last4"`
+	ExpiryMonth  int       `json:"expiry_month"`
+	ExpiryYear   int       `json:"expiry_year"`
+	KeyID        string    `json:"key_id"`
+	Nonce        []byte    `json:"nonce"`
+	Ciphertext   []byte    `json:"ciphertext"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
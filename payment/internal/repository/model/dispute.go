@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type DisputeReason int32
+
+type DisputeStatus int32
+
+type DisputeEvidence struct {
+	Description    string    `json:"description"`
+	TrackingNumber string    `json:"tracking_number,omitempty"`
+	DocumentURLs   []string  `json:"document_urls,omitempty"`
+	SubmittedAt    time.Time `json:"submitted_at"`
+}
+
+// Dispute хранится в файле как JSON, поэтому поля размечены тегами.
+type Dispute struct {
+	UUID            string           `json:"uuid"`
+	TransactionUUID string           `json:"transaction_uuid"`
+	OrderUUID       string           `json:"order_uuid"`
+	UserUUID        string           `json:"user_uuid"`
+	Amount          Money            `json:"amount"`
+	Reason          DisputeReason    `json:"reason"`
+	Status          DisputeStatus    `json:"status"`
+	EvidenceDueBy   time.Time        `json:"evidence_due_by"`
+	Evidence        *DisputeEvidence `json:"evidence,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+	ResolvedAt      time.Time        `json:"resolved_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type PaymentEventType int32
+
+// PaymentEvent хранится в файле как JSON, поэтому поля размечены тегами.
+type PaymentEvent struct {
+	Sequence          int64             `json:"sequence"`
+	Type              PaymentEventType  `json:"type"`
+	TransactionUUID   string            `json:"transaction_uuid"`
+	OrderUUID         string            `json:"order_uuid"`
+	UserUUID          string            `json:"user_uuid"`
+	PaymentMethod     PaymentMethod     `json:"payment_method"`
+	TransactionStatus TransactionStatus `json:"transaction_status"`
+	Amount            Money             `json:"amount"`
+	RefundUUID        string            `json:"refund_uuid,omitempty"`
+	DisputeUUID       string            `json:"dispute_uuid,omitempty"`
+	Reason            string            `json:"reason,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type FraudOutcome int32
+
+type FraudReason int32
+
+// FraudDecision хранится в файле как JSON, поэтому поля размечены тегами.
+type FraudDecision struct {
+	UUID          string        `json:"uuid"`
+	OrderUUID     string        `json:"order_uuid"`
+	UserUUID      string        `json:"user_uuid"`
+	PaymentMethod PaymentMethod `json:"payment_method"`
+	Amount        Money         `json:"amount"`
+	Outcome       FraudOutcome  `json:"outcome"`
+	Reason        FraudReason   `json:"reason,omitempty"`
+	Rule          string        `json:"rule,omitempty"`
+	Description   string        `json:"description,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// IdempotencyRecord хранится в файле как JSON, поэтому поля размечены тегами.
+type IdempotencyRecord struct {
+	Key             string    `json:"key"`
+	RequestHash     string    `json:"request_hash"`
+	TransactionUUID string    `json:"transaction_uuid"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+type InstallmentPlanStatus int32
+
+type InstallmentStatus int32
+
+type Installment struct {
+	Number          int               `json:"number"`
+	DueAt           time.Time         `json:"due_at"`
+	Principal       Money             `json:"principal"`
+	Interest        Money             `json:"interest"`
+	Amount          Money             `json:"amount"`
+	Status          InstallmentStatus `json:"status"`
+	PaidAt          time.Time         `json:"paid_at"`
+	Attempts        int               `json:"attempts,omitempty"`
+	NextAttemptAt   time.Time         `json:"next_attempt_at"`
+	LastDeclineCode string            `json:"last_decline_code,omitempty"`
+}
+
+// InstallmentPlan хранится в файле как JSON, поэтому поля размечены тегами.
+type InstallmentPlan struct {
+	UUID                  string                `json:"uuid"`
+	TransactionUUID       string                `json:"transaction_uuid"`
+	OrderUUID             string                `json:"order_uuid"`
+	UserUUID              string                `json:"user_uuid"`
+	Status                InstallmentPlanStatus `json:"status"`
+	Principal             Money                 `json:"principal"`
+	TermMonths            int                   `json:"term_months"`
+	AnnualRateBasisPoints int64                 `json:"annual_rate_basis_points"`
+	Installments          []Installment         `json:"installments"`
+	TotalInterest         Money                 `json:"total_interest"`
+	Total                 Money                 `json:"total"`
+	MissedCount           int                   `json:"missed_count"`
+	CreatedAt             time.Time             `json:"created_at"`
+	UpdatedAt             time.Time             `json:"updated_at"`
+}
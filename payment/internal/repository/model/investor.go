@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type InvestorMovementKind int32
+
+// Investor хранится в файле как JSON, поэтому поля размечены тегами.
+type Investor struct {
+	UUID                string    `json:"uuid"`
+	Name                string    `json:"name"`
+	Available           Money     `json:"available"`
+	Held                Money     `json:"held"`
+	Spent               Money     `json:"spent"`
+	AuthorizedUserUUIDs []string  `json:"authorized_user_uuids"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+type InvestorMovement struct {
+	UUID            string               `json:"uuid"`
+	InvestorUUID    string               `json:"investor_uuid"`
+	Kind            InvestorMovementKind `json:"kind"`
+	Amount          Money                `json:"amount"`
+	TransactionUUID string               `json:"transaction_uuid,omitempty"`
+	UserUUID        string               `json:"user_uuid,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+}
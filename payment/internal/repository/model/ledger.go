@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type LedgerAccountType int32
+
+type PostingDirection int32
+
+type JournalEntryKind int32
+
+type LedgerAccount struct {
+	Type      LedgerAccountType `json:"type"`
+	OwnerUUID string            `json:"owner_uuid,omitempty"`
+}
+
+type Posting struct {
+	Account   LedgerAccount    `json:"account"`
+	Direction PostingDirection `json:"direction"`
+	Amount    Money            `json:"amount"`
+}
+
+// JournalEntry хранится в файле как JSON, поэтому поля размечены тегами.
+type JournalEntry struct {
+	UUID              string           `json:"uuid"`
+	Kind              JournalEntryKind `json:"kind"`
+	TransactionUUID   string           `json:"transaction_uuid"`
+	RefundUUID        string           `json:"refund_uuid,omitempty"`
+	DisputeUUID       string           `json:"dispute_uuid,omitempty"`
+	ReversesEntryUUID string           `json:"reverses_entry_uuid,omitempty"`
+	Postings          []Posting        `json:"postings"`
+	CreatedAt         time.Time        `json:"created_at"`
+}
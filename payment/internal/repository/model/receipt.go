@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Receipt хранится в файле как JSON, поэтому поля размечены тегами.
+type Receipt struct {
+	UUID                  string        `json:"uuid"`
+	TransactionUUID       string        `json:"transaction_uuid"`
+	OrderUUID             string        `json:"order_uuid"`
+	UserUUID              string        `json:"user_uuid"`
+	PaymentMethod         PaymentMethod `json:"payment_method"`
+	MaskedCardNumber      string        `json:"masked_card_number,omitempty"`
+	InstallmentTermMonths int           `json:"installment_term_months,omitempty"`
+	Items                 []ReceiptItem `json:"items"`
+	Total                 Money         `json:"total"`
+	TaxTotal              Money         `json:"tax_total"`
+	CreditAmount          *Money        `json:"credit_amount,omitempty"`
+	PaidAt                time.Time     `json:"paid_at"`
+	HTML                  string        `json:"html"`
+	Text                  string        `json:"text"`
+	CreatedAt             time.Time     `json:"created_at"`
+}
+
+type ReceiptItem struct {
+	SKU                string `json:"sku,omitempty"`
+	Name               string `json:"name"`
+	Quantity           int    `json:"quantity"`
+	UnitPrice          Money  `json:"unit_price"`
+	Amount             Money  `json:"amount"`
+	TaxRateBasisPoints int64  `json:"tax_rate_basis_points"`
+	Tax                Money  `json:"tax"`
+}
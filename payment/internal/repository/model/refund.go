@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+type RefundReason int32
+
+type RefundStatus int32
+
+type Refund struct {
+	UUID             string       `json:"uuid"`
+	TransactionUUID  string       `json:"transaction_uuid"`
+	Amount           Money        `json:"amount"`
+	WrittenOffAmount *Money       `json:"written_off_amount,omitempty"`
+	Reason           RefundReason `json:"reason"`
+	Status           RefundStatus `json:"status"`
+	CreatedAt        time.Time    `json:"created_at"`
+}
@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+type PaymentMethod int32
+
+type TransactionStatus int32
+
+// Transaction хранится в файле как JSON, поэтому поля размечены тегами.
+type Transaction struct {
+	UUID                   string            `json:"uuid"`
+	OrderUUID              string            `json:"order_uuid"`
+	UserUUID               string            `json:"user_uuid"`
+	PaymentMethod          PaymentMethod     `json:"payment_method"`
+	Status                 TransactionStatus `json:"status"`
+	Amount                 Money             `json:"amount"`
+	SettlementAmount       Money             `json:"settlement_amount"`
+	ExchangeRate           string            `json:"exchange_rate,omitempty"`
+	RefundedAmount         Money             `json:"refunded_amount"`
+	ChargedBackAmount      *Money            `json:"charged_back_amount,omitempty"`
+	CollectedAmount        *Money            `json:"collected_amount,omitempty"`
+	AuthorizedAmount       Money             `json:"authorized_amount"`
+	AuthorizationExpiresAt time.Time         `json:"authorization_expires_at"`
+	CapturedAt             time.Time         `json:"captured_at"`
+	DeclineCode            string            `json:"decline_code,omitempty"`
+	InvestorUUID           string            `json:"investor_uuid,omitempty"`
+	InstallmentTermMonths  int               `json:"installment_term_months,omitempty"`
+	PaymentToken           string            `json:"payment_token,omitempty"`
+	MaskedCardNumber       string            `json:"masked_card_number,omitempty"`
+	LineItems              []LineItem        `json:"line_items,omitempty"`
+	CreatedAt              time.Time         `json:"created_at"`
+}
+
+type LineItem struct {
+	SKU       string `json:"sku,omitempty"`
+	Name      string `json:"name"`
+	Quantity  int    `json:"quantity"`
+	UnitPrice Money  `json:"unit_price"`
+}
+
+type Money struct {
+	CurrencyCode string `json:"currency_code"`
+	Units        int64  `json:"units"`
+	Nanos        int32  `json:"nanos"`
+}
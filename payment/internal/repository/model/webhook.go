@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+type WebhookDeliveryStatus int32
+
+// WebhookSubscription хранится в файле как JSON, поэтому поля размечены тегами.
+type WebhookSubscription struct {
+	UUID       string             `json:"uuid"`
+	URL        string             `json:"url"`
+	EventTypes []PaymentEventType `json:"event_types,omitempty"`
+	Secret     string             `json:"secret"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// WebhookDelivery хранится в файле как JSON, поэтому поля размечены тегами.
+// Payload сохраняется как есть и кодируется в base64.
+type WebhookDelivery struct {
+	UUID             string                `json:"uuid"`
+	SubscriptionUUID string                `json:"subscription_uuid"`
+	EventSequence    int64                 `json:"event_sequence"`
+	EventType        PaymentEventType      `json:"event_type"`
+	Payload          []byte                `json:"payload"`
+	Status           WebhookDeliveryStatus `json:"status"`
+	Attempts         int                   `json:"attempts"`
+	NextAttemptAt    time.Time             `json:"next_attempt_at"`
+	LastError        string                `json:"last_error,omitempty"`
+	DeliveredAt      time.Time             `json:"delivered_at"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package receipt
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, receipt model.Receipt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.receipts[receipt.TransactionUUID]; ok {
+		return model.ErrReceiptAlreadyExists
+	}
+
+	r.receipts[receipt.TransactionUUID] = converter.ReceiptToRepoModel(receipt)
+	if err := r.save(); err != nil {
+		delete(r.receipts, receipt.TransactionUUID)
+		return err
+	}
+
+	return nil
+}
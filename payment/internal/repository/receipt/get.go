@@ -0,0 +1,20 @@
+package receipt
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) GetByTransaction(_ context.Context, transactionUUID string) (model.Receipt, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	receipt, ok := r.receipts[transactionUUID]
+	if !ok {
+		return model.Receipt{}, model.ErrReceiptNotFound
+	}
+
+	return converter.ReceiptToModel(receipt), nil
+}
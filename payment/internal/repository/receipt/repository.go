@@ -0,0 +1,69 @@
+package receipt
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.ReceiptRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище чеков по UUID транзакций.
+// Если задан path, чеки сохраняются в JSON-файл и переживают перезапуск сервиса.
+type repository struct {
+	mu       sync.RWMutex
+	receipts map[string]*repoModel.Receipt
+	path     string
+}
+
+// state — содержимое файла хранилища.
+type state struct {
+	Receipts []*repoModel.Receipt `json:"receipts"`
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		receipts: make(map[string]*repoModel.Receipt),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее чеки в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	var loaded state
+	if err := file.Load(path, &loaded); err != nil {
+		return nil, err
+	}
+	for _, receipt := range loaded.Receipts {
+		r.receipts[receipt.TransactionUUID] = receipt
+	}
+
+	return r, nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	receipts := make([]*repoModel.Receipt, 0, len(r.receipts))
+	for _, receipt := range r.receipts {
+		receipts = append(receipts, receipt)
+	}
+	slices.SortFunc(receipts, func(a, b *repoModel.Receipt) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+
+	return file.Save(r.path, state{Receipts: receipts})
+}
@@ -0,0 +1,21 @@
+package refund
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, refund model.Refund) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refunds[refund.UUID] = converter.RefundToRepoModel(refund)
+	if err := r.save(); err != nil {
+		delete(r.refunds, refund.UUID)
+		return err
+	}
+
+	return nil
+}
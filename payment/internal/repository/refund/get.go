@@ -0,0 +1,20 @@
+package refund
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.Refund, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	refund, ok := r.refunds[uuid]
+	if !ok {
+		return model.Refund{}, model.ErrRefundNotFound
+	}
+
+	return converter.RefundToModel(refund), nil
+}
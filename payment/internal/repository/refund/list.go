@@ -0,0 +1,29 @@
+package refund
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func (r *repository) ListByTransaction(_ context.Context, transactionUUID string) ([]model.Refund, error) {
+	r.mu.RLock()
+	matched := make([]*repoModel.Refund, 0)
+	for _, refund := range r.refunds {
+		if refund.TransactionUUID == transactionUUID {
+			matched = append(matched, refund)
+		}
+	}
+	r.mu.RUnlock()
+
+	sortByCreatedAt(matched)
+
+	result := make([]model.Refund, 0, len(matched))
+	for _, refund := range matched {
+		result = append(result, converter.RefundToModel(refund))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package refund
+
+import (
+	"cmp"
+	"slices"
+
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.RefundRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище возвратов.
+// Если задан path, каждое изменение сохраняется в файл.
+type repository struct {
+	mu      sync.RWMutex
+	refunds map[string]*repoModel.Refund
+	path    string
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		refunds: make(map[string]*repoModel.Refund),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее возвраты в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	var refunds []*repoModel.Refund
+	if err := file.Load(path, &refunds); err != nil {
+		return nil, err
+	}
+	for _, refund := range refunds {
+		r.refunds[refund.UUID] = refund
+	}
+
+	return r, nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	refunds := make([]*repoModel.Refund, 0, len(r.refunds))
+	for _, refund := range r.refunds {
+		refunds = append(refunds, refund)
+	}
+	sortByCreatedAt(refunds)
+
+	return file.Save(r.path, refunds)
+}
+
+func sortByCreatedAt(refunds []*repoModel.Refund) {
+	slices.SortFunc(refunds, func(a, b *repoModel.Refund) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+}
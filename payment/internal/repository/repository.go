@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+type TransactionRepository interface {
+	Create(ctx context.Context, transaction model.Transaction) error
+	Get(ctx context.Context, uuid string) (model.Transaction, error)
+	// List возвращает транзакции, упорядоченные по времени создания.
+	List(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error)
+	Update(ctx context.Context, transaction model.Transaction) error
+}
+
+type RefundRepository interface {
+	Create(ctx context.Context, refund model.Refund) error
+	Get(ctx context.Context, uuid string) (model.Refund, error)
+	// ListByTransaction возвращает возвраты транзакции, упорядоченные по времени создания.
+	ListByTransaction(ctx context.Context, transactionUUID string) ([]model.Refund, error)
+}
+
+// IdempotencyRepository хранит ключи идемпотентности до истечения срока хранения.
+type IdempotencyRepository interface {
+	// Get возвращает ErrIdempotencyKeyNotFound для отсутствующих и истёкших ключей.
+	Get(ctx context.Context, key string) (model.IdempotencyRecord, error)
+	Save(ctx context.Context, record model.IdempotencyRecord) error
+	// DeleteExpired удаляет ключи, истёкшие к моменту now, и возвращает их количество.
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// LedgerRepository — журнал проводок. Записи только добавляются и не изменяются.
+type LedgerRepository interface {
+	Append(ctx context.Context, entry model.JournalEntry) error
+	// List возвращает записи в порядке добавления. Пустой transactionUUID означает все записи.
+	List(ctx context.Context, transactionUUID string) ([]model.JournalEntry, error)
+}
+
+// InvestorRepository хранит инвесторов и движения их средств.
+type InvestorRepository interface {
+	Create(ctx context.Context, investor model.Investor) error
+	Get(ctx context.Context, uuid string) (model.Investor, error)
+	// List возвращает инвесторов, упорядоченных по времени создания.
+	List(ctx context.Context) ([]model.Investor, error)
+	// Update атомарно изменяет инвестора функцией update и сохраняет возвращённые ею движения.
+	// Если update вернула ошибку, она возвращается без изменений, а инвестор не меняется.
+	Update(ctx context.Context, uuid string, update func(investor *model.Investor) ([]model.InvestorMovement, error)) (model.Investor, error)
+	// ListMovements возвращает движения средств инвестора в порядке их проведения.
+	ListMovements(ctx context.Context, investorUUID string) ([]model.InvestorMovement, error)
+}
+
+// FraudDecisionRepository — журнал решений антифрода. Решения только добавляются.
+type FraudDecisionRepository interface {
+	Create(ctx context.Context, decision model.FraudDecision) error
+	// List возвращает решения, подходящие под filter, в порядке их принятия.
+	List(ctx context.Context, filter model.FraudDecisionsFilter) ([]model.FraudDecision, error)
+}
+
+// InstallmentPlanRepository хранит планы рассрочки. У транзакции не больше одного плана.
+type InstallmentPlanRepository interface {
+	Create(ctx context.Context, plan model.InstallmentPlan) error
+	Get(ctx context.Context, uuid string) (model.InstallmentPlan, error)
+	// List возвращает планы, подходящие под filter, упорядоченные по времени создания.
+	List(ctx context.Context, filter model.InstallmentPlansFilter) ([]model.InstallmentPlan, error)
+	Update(ctx context.Context, plan model.InstallmentPlan) error
+}
+
+// DisputeRepository хранит споры по транзакциям.
+type DisputeRepository interface {
+	Create(ctx context.Context, dispute model.Dispute) error
+	Get(ctx context.Context, uuid string) (model.Dispute, error)
+	// List возвращает споры, подходящие под filter, упорядоченные по времени открытия.
+	List(ctx context.Context, filter model.DisputesFilter) ([]model.Dispute, error)
+	Update(ctx context.Context, dispute model.Dispute) error
+}
+
+// PaymentEventRepository — журнал событий платежей. События только добавляются.
+type PaymentEventRepository interface {
+	// Append присваивает событию следующий номер, сохраняет его и возвращает с номером.
+	Append(ctx context.Context, event model.PaymentEvent) (model.PaymentEvent, error)
+	// List возвращает не больше limit событий с номерами больше afterSequence по возрастанию номеров.
+	List(ctx context.Context, afterSequence int64, limit int) ([]model.PaymentEvent, error)
+}
+
+// WebhookRepository хранит подписки на вебхуки и очередь доставок событий подписчикам.
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription model.WebhookSubscription) error
+	GetSubscription(ctx context.Context, uuid string) (model.WebhookSubscription, error)
+	// ListSubscriptions возвращает подписки, упорядоченные по времени создания.
+	ListSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, uuid string) error
+	// CreateDeliveries ставит в очередь все доставки одного события или ни одной.
+	CreateDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	// ListDeliveries возвращает доставки, подходящие под filter, в порядке номеров событий.
+	ListDeliveries(ctx context.Context, filter model.WebhookDeliveriesFilter) ([]model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+}
+
+// CardRepository хранит сохранённые карты. Номера карт в нём только зашифрованы.
+type CardRepository interface {
+	Create(ctx context.Context, card model.PaymentCard) error
+	Get(ctx context.Context, token string) (model.PaymentCard, error)
+	// List возвращает карты пользователя по времени сохранения. Пустой userUUID означает все карты.
+	List(ctx context.Context, userUUID string) ([]model.PaymentCard, error)
+	Update(ctx context.Context, card model.PaymentCard) error
+	Delete(ctx context.Context, token string) error
+}
+
+// ReceiptRepository хранит чеки. У транзакции не больше одного чека.
+type ReceiptRepository interface {
+	// Create возвращает ErrReceiptAlreadyExists, если у транзакции уже есть чек.
+	Create(ctx context.Context, receipt model.Receipt) error
+	GetByTransaction(ctx context.Context, transactionUUID string) (model.Receipt, error)
+}
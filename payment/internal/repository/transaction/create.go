@@ -0,0 +1,25 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(_ context.Context, transaction model.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.transactions[transaction.UUID]; ok {
+		return model.ErrTransactionAlreadyExists
+	}
+
+	r.transactions[transaction.UUID] = converter.TransactionToRepoModel(transaction)
+	if err := r.save(); err != nil {
+		delete(r.transactions, transaction.UUID)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package transaction
+
+import (
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+// load читает транзакции из файла. Отсутствующий файл означает пустое хранилище.
+func (r *repository) load() error {
+	var transactions []*repoModel.Transaction
+	if err := file.Load(r.path, &transactions); err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		r.transactions[transaction.UUID] = transaction
+	}
+
+	return nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	transactions := make([]*repoModel.Transaction, 0, len(r.transactions))
+	for _, transaction := range r.transactions {
+		transactions = append(transactions, transaction)
+	}
+	sortByCreatedAt(transactions)
+
+	return file.Save(r.path, transactions)
+}
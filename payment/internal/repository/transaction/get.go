@@ -0,0 +1,20 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Get(_ context.Context, uuid string) (model.Transaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	transaction, ok := r.transactions[uuid]
+	if !ok {
+		return model.Transaction{}, model.ErrTransactionNotFound
+	}
+
+	return converter.TransactionToModel(transaction), nil
+}
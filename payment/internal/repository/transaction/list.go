@@ -0,0 +1,114 @@
+package transaction
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+type transactionFilter func(*repoModel.Transaction) bool
+
+// List returns transactions ordered by creation time, with optional filtering.
+func (r *repository) List(_ context.Context, filter model.TransactionsFilter) ([]model.Transaction, error) {
+	filters := buildTransactionFilters(filter)
+
+	r.mu.RLock()
+	matched := make([]*repoModel.Transaction, 0, len(r.transactions))
+	for _, transaction := range r.transactions {
+		matchesAll := true
+		for _, f := range filters {
+			if !f(transaction) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			matched = append(matched, transaction)
+		}
+	}
+	r.mu.RUnlock()
+
+	sortByCreatedAt(matched)
+
+	result := make([]model.Transaction, 0, len(matched))
+	for _, transaction := range matched {
+		result = append(result, converter.TransactionToModel(transaction))
+	}
+
+	return result, nil
+}
+
+func sortByCreatedAt(transactions []*repoModel.Transaction) {
+	slices.SortFunc(transactions, func(a, b *repoModel.Transaction) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+}
+
+func buildTransactionFilters(filter model.TransactionsFilter) []transactionFilter {
+	var filters []transactionFilter
+
+	if len(filter.OrderUUIDs) > 0 {
+		orderSet := make(map[string]struct{})
+		for _, uuid := range filter.OrderUUIDs {
+			orderSet[uuid] = struct{}{}
+		}
+		filters = append(filters, func(transaction *repoModel.Transaction) bool {
+			_, ok := orderSet[transaction.OrderUUID]
+			return ok
+		})
+	}
+
+	if len(filter.UserUUIDs) > 0 {
+		userSet := make(map[string]struct{})
+		for _, uuid := range filter.UserUUIDs {
+			userSet[uuid] = struct{}{}
+		}
+		filters = append(filters, func(transaction *repoModel.Transaction) bool {
+			_, ok := userSet[transaction.UserUUID]
+			return ok
+		})
+	}
+
+	if len(filter.PaymentMethods) > 0 {
+		methodSet := make(map[repoModel.PaymentMethod]struct{})
+		for _, method := range filter.PaymentMethods {
+			methodSet[repoModel.PaymentMethod(method)] = struct{}{}
+		}
+		filters = append(filters, func(transaction *repoModel.Transaction) bool {
+			_, ok := methodSet[transaction.PaymentMethod]
+			return ok
+		})
+	}
+
+	if len(filter.Statuses) > 0 {
+		statusSet := make(map[repoModel.TransactionStatus]struct{})
+		for _, status := range filter.Statuses {
+			statusSet[repoModel.TransactionStatus(status)] = struct{}{}
+		}
+		filters = append(filters, func(transaction *repoModel.Transaction) bool {
+			_, ok := statusSet[transaction.Status]
+			return ok
+		})
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		filters = append(filters, func(transaction *repoModel.Transaction) bool {
+			return !transaction.CreatedAt.Before(filter.CreatedFrom)
+		})
+	}
+
+	if !filter.CreatedTo.IsZero() {
+		filters = append(filters, func(transaction *repoModel.Transaction) bool {
+			return transaction.CreatedAt.Before(filter.CreatedTo)
+		})
+	}
+
+	return filters
+}
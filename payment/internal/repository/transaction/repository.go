@@ -0,0 +1,38 @@
+package transaction
+
+import (
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.TransactionRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище транзакций.
+// Если задан path, каждое изменение сохраняется в файл.
+type repository struct {
+	mu           sync.RWMutex
+	transactions map[string]*repoModel.Transaction
+	path         string
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		transactions: make(map[string]*repoModel.Transaction),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее транзакции в JSON-файл по пути path.
+// Если файл уже существует, транзакции загружаются из него.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
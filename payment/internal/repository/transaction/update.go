@@ -0,0 +1,26 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) Update(_ context.Context, transaction model.Transaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.transactions[transaction.UUID]
+	if !ok {
+		return model.ErrTransactionNotFound
+	}
+
+	r.transactions[transaction.UUID] = converter.TransactionToRepoModel(transaction)
+	if err := r.save(); err != nil {
+		r.transactions[transaction.UUID] = previous
+		return err
+	}
+
+	return nil
+}
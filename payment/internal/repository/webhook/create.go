@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) CreateSubscription(_ context.Context, subscription model.WebhookSubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subscriptions[subscription.UUID] = converter.WebhookSubscriptionToRepoModel(subscription)
+	if err := r.save(); err != nil {
+		delete(r.subscriptions, subscription.UUID)
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) CreateDeliveries(_ context.Context, deliveries []model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, delivery := range deliveries {
+		r.deliveries[delivery.UUID] = converter.WebhookDeliveryToRepoModel(delivery)
+	}
+	if err := r.save(); err != nil {
+		for _, delivery := range deliveries {
+			delete(r.deliveries, delivery.UUID)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func (r *repository) DeleteSubscription(_ context.Context, uuid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscription, ok := r.subscriptions[uuid]
+	if !ok {
+		return model.ErrWebhookSubscriptionNotFound
+	}
+
+	delete(r.subscriptions, uuid)
+	if err := r.save(); err != nil {
+		r.subscriptions[uuid] = subscription
+		return err
+	}
+
+	return nil
+}
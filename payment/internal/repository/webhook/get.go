@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) GetSubscription(_ context.Context, uuid string) (model.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscription, ok := r.subscriptions[uuid]
+	if !ok {
+		return model.WebhookSubscription{}, model.ErrWebhookSubscriptionNotFound
+	}
+
+	return converter.WebhookSubscriptionToModel(subscription), nil
+}
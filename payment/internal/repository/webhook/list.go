@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+func (r *repository) ListSubscriptions(_ context.Context) ([]model.WebhookSubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscriptions := r.sortedSubscriptions()
+	result := make([]model.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, converter.WebhookSubscriptionToModel(subscription))
+	}
+
+	return result, nil
+}
+
+func (r *repository) ListDeliveries(_ context.Context, filter model.WebhookDeliveriesFilter) ([]model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]model.WebhookDelivery, 0)
+	for _, delivery := range r.sortedDeliveries() {
+		if matches(delivery, filter) {
+			result = append(result, converter.WebhookDeliveryToModel(delivery))
+		}
+	}
+
+	return result, nil
+}
+
+func matches(delivery *repoModel.WebhookDelivery, filter model.WebhookDeliveriesFilter) bool {
+	switch {
+	case filter.SubscriptionUUID != "" && delivery.SubscriptionUUID != filter.SubscriptionUUID:
+		return false
+	case len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, model.WebhookDeliveryStatus(delivery.Status)):
+		return false
+	case !filter.DueBy.IsZero() && delivery.NextAttemptAt.After(filter.DueBy):
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	def "github.com/Denisz0785/spaceyard/payment/internal/repository"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/file"
+	repoModel "github.com/Denisz0785/spaceyard/payment/internal/repository/model"
+)
+
+var _ def.WebhookRepository = (*repository)(nil)
+
+// repository представляет потокобезопасное хранилище подписок на вебхуки и очереди доставок.
+// Если задан path, подписки и доставки сохраняются в один файл, поэтому очередь
+// переживает перезапуск сервиса.
+type repository struct {
+	mu            sync.RWMutex
+	subscriptions map[string]*repoModel.WebhookSubscription
+	deliveries    map[string]*repoModel.WebhookDelivery
+	path          string
+}
+
+// state — содержимое файла хранилища.
+type state struct {
+	Subscriptions []*repoModel.WebhookSubscription `json:"subscriptions"`
+	Deliveries    []*repoModel.WebhookDelivery     `json:"deliveries"`
+}
+
+// NewRepository создаёт in-memory хранилище, данные которого теряются при перезапуске.
+func NewRepository() *repository {
+	return &repository{
+		subscriptions: make(map[string]*repoModel.WebhookSubscription),
+		deliveries:    make(map[string]*repoModel.WebhookDelivery),
+	}
+}
+
+// NewFileRepository создаёт хранилище, сохраняющее подписки и доставки в JSON-файл по пути path.
+func NewFileRepository(path string) (*repository, error) {
+	r := NewRepository()
+	r.path = path
+
+	var loaded state
+	if err := file.Load(path, &loaded); err != nil {
+		return nil, err
+	}
+	for _, subscription := range loaded.Subscriptions {
+		r.subscriptions[subscription.UUID] = subscription
+	}
+	for _, delivery := range loaded.Deliveries {
+		r.deliveries[delivery.UUID] = delivery
+	}
+
+	return r, nil
+}
+
+// save перезаписывает файл текущим состоянием хранилища. Вызывается под r.mu.
+func (r *repository) save() error {
+	if r.path == "" {
+		return nil
+	}
+
+	return file.Save(r.path, state{
+		Subscriptions: r.sortedSubscriptions(),
+		Deliveries:    r.sortedDeliveries(),
+	})
+}
+
+// sortedSubscriptions возвращает подписки по времени создания. Вызывается под r.mu.
+func (r *repository) sortedSubscriptions() []*repoModel.WebhookSubscription {
+	subscriptions := make([]*repoModel.WebhookSubscription, 0, len(r.subscriptions))
+	for _, subscription := range r.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	slices.SortFunc(subscriptions, func(a, b *repoModel.WebhookSubscription) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+	return subscriptions
+}
+
+// sortedDeliveries возвращает доставки в порядке событий. Вызывается под r.mu.
+func (r *repository) sortedDeliveries() []*repoModel.WebhookDelivery {
+	deliveries := make([]*repoModel.WebhookDelivery, 0, len(r.deliveries))
+	for _, delivery := range r.deliveries {
+		deliveries = append(deliveries, delivery)
+	}
+	slices.SortFunc(deliveries, func(a, b *repoModel.WebhookDelivery) int {
+		if c := cmp.Compare(a.EventSequence, b.EventSequence); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+	return deliveries
+}
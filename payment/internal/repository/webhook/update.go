@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository/converter"
+)
+
+func (r *repository) UpdateDelivery(_ context.Context, delivery model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.deliveries[delivery.UUID]
+	if !ok {
+		return model.ErrWebhookDeliveryNotFound
+	}
+
+	r.deliveries[delivery.UUID] = converter.WebhookDeliveryToRepoModel(delivery)
+	if err := r.save(); err != nil {
+		r.deliveries[delivery.UUID] = previous
+		return err
+	}
+
+	return nil
+}
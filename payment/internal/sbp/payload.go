@@ -0,0 +1,73 @@
+package sbp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// Параметры ссылки НСПК для динамического QR-кода, который действует для одного платежа.
+const (
+	payloadHost   = "https://qr.nspk.ru/"
+	dynamicQRType = "02"
+	// qrIDPrefix начинает идентификатор динамического QR-кода.
+	qrIDPrefix = "AD"
+	qrIDLength = 32
+	currency   = "RUB"
+	// nanosPerKopeck — нано-единиц рубля в одной копейке.
+	nanosPerKopeck = 10_000_000
+)
+
+// Payload возвращает ссылку НСПК для оплаты суммы amount банку-получателю bankID:
+//
+//	https://qr.nspk.ru/AD…?type=02&bank=…&sum=<копейки>&cur=RUB&crc=<CRC16>
+//
+// Идентификатор QR-кода выводится из transactionUUID, поэтому повторный вызов
+// для той же транзакции возвращает ту же ссылку. СБП принимает только рубли
+// с точностью до копейки.
+func Payload(bankID, transactionUUID string, amount money.Money) (string, error) {
+	if amount.CurrencyCode != currency {
+		return "", fmt.Errorf("%w: SBP accepts only %s", model.ErrInvalidAmount, currency)
+	}
+	if amount.Nanos%nanosPerKopeck != 0 {
+		return "", fmt.Errorf("%w: SBP amount must be a whole number of kopecks", model.ErrInvalidAmount)
+	}
+
+	kopecks := amount.Units*100 + int64(amount.Nanos/nanosPerKopeck)
+
+	link := fmt.Sprintf("%s%s?type=%s&bank=%s&sum=%d&cur=%s",
+		payloadHost,
+		qrID(transactionUUID),
+		dynamicQRType,
+		url.QueryEscape(bankID),
+		kopecks,
+		currency,
+	)
+
+	return fmt.Sprintf("%s&crc=%04X", link, crc16(link)), nil
+}
+
+// qrID возвращает 32-символьный идентификатор QR-кода из UUID транзакции.
+func qrID(transactionUUID string) string {
+	id := qrIDPrefix + strings.ToUpper(strings.ReplaceAll(transactionUUID, "-", ""))
+	return id[:qrIDLength]
+}
+
+// crc16 считает CRC-16/CCITT-FALSE (полином 0x1021, начальное значение 0xFFFF).
+func crc16(s string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,91 @@
+package sbp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func TestCRC16(t *testing.T) {
+	tests := []struct {
+		s    string
+		want uint16
+	}{
+		// Контрольное значение CRC-16/CCITT-FALSE из каталога параметров CRC.
+		{s: "123456789", want: 0x29B1},
+		{s: "", want: 0xFFFF},
+		{s: "A", want: 0xB915},
+	}
+
+	for _, tt := range tests {
+		if got := crc16(tt.s); got != tt.want {
+			t.Errorf("crc16(%q) = %04X, want %04X", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestPayload(t *testing.T) {
+	const transactionUUID = "3f2504e0-4f89-11d3-9a0c-0305e82c3301"
+
+	tests := []struct {
+		name    string
+		bankID  string
+		amount  money.Money
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "rubles and kopecks",
+			bankID: "100000000111",
+			amount: money.Money{CurrencyCode: "RUB", Units: 1234, Nanos: 560_000_000},
+			want:   "https://qr.nspk.ru/AD3F2504E04F8911D39A0C0305E82C33?type=02&bank=100000000111&sum=123456&cur=RUB",
+		},
+		{
+			name:   "bank id is escaped",
+			bankID: "bank&sum=1",
+			amount: money.Money{CurrencyCode: "RUB", Units: 1},
+			want:   "https://qr.nspk.ru/AD3F2504E04F8911D39A0C0305E82C33?type=02&bank=bank%26sum%3D1&sum=100&cur=RUB",
+		},
+		{
+			name:    "not rubles",
+			amount:  money.Money{CurrencyCode: "USD", Units: 1},
+			wantErr: model.ErrInvalidAmount,
+		},
+		{
+			name:    "fraction of a kopeck",
+			amount:  money.Money{CurrencyCode: "RUB", Units: 1, Nanos: 5_000_000},
+			wantErr: model.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Payload(tt.bankID, transactionUUID, tt.amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Payload() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			link, crc, ok := strings.Cut(got, "&crc=")
+			if !ok || link != tt.want {
+				t.Fatalf("Payload() = %q, want %q with crc", got, tt.want)
+			}
+			// Контрольная сумма считается по всей ссылке до параметра crc.
+			if want := fmt.Sprintf("%04X", crc16(link)); crc != want {
+				t.Fatalf("crc = %q, want %q", crc, want)
+			}
+
+			// Ссылка для той же транзакции не меняется.
+			again, err := Payload(tt.bankID, transactionUUID, tt.amount)
+			if err != nil || again != got {
+				t.Fatalf("repeated Payload() = %q, error = %v, want %q", again, err, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package sbp
+
+import (
+	"bytes"
+	"fmt"
+
+	"rsc.io/qr"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+const (
+	// pngScale — размер модуля QR-кода в пикселях PNG.
+	pngScale = 8
+	// quietZone — ширина обязательного белого поля вокруг кода в модулях.
+	quietZone = 4
+)
+
+// QRCode кодирует payload в QR-код и возвращает изображение и его MIME-тип.
+// Изображение строится локально, без обращения к внешним сервисам.
+// Неуказанный формат означает PNG.
+func QRCode(payload string, format model.QRImageFormat) ([]byte, string, error) {
+	code, err := qr.Encode(payload, qr.M)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode QR code: %w", err)
+	}
+
+	switch format {
+	case model.QRImageFormatSVG:
+		return svg(code), "image/svg+xml", nil
+	default:
+		code.Scale = pngScale
+		return code.PNG(), "image/png", nil
+	}
+}
+
+// svg рисует QR-код одним путём: каждый тёмный модуль — квадрат 1×1 в координатах модулей.
+func svg(code *qr.Code) []byte {
+	side := code.Size + 2*quietZone
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, side, side)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, side, side)
+	for y := range code.Size {
+		for x := range code.Size {
+			if code.Black(x, y) {
+				fmt.Fprintf(&b, "M%d %dh1v1h-1z", x+quietZone, y+quietZone)
+			}
+		}
+	}
+	b.WriteString(`"/></svg>`)
+
+	return b.Bytes()
+}
@@ -0,0 +1,32 @@
+package payment
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// maxNanos — наибольшее по модулю значение нано-единиц.
+const maxNanos = 999_999_999
+
+var currencyCodeRe = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// validateAmount проверяет, что сумма корректна и положительна.
+func validateAmount(amount money.Money) error {
+	if !currencyCodeRe.MatchString(amount.CurrencyCode) {
+		return fmt.Errorf("%w: currency_code must be a three-letter ISO 4217 code", model.ErrInvalidAmount)
+	}
+	if amount.Nanos < -maxNanos || amount.Nanos > maxNanos {
+		return fmt.Errorf("%w: nanos must be within ±%d", model.ErrInvalidAmount, maxNanos)
+	}
+	if (amount.Units > 0 && amount.Nanos < 0) || (amount.Units < 0 && amount.Nanos > 0) {
+		return fmt.Errorf("%w: units and nanos must have the same sign", model.ErrInvalidAmount)
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("%w: amount must be positive", model.ErrInvalidAmount)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// AuthorizePayment удерживает сумму до списания, отмены или истечения авторизации.
+// Платёж СБП так не удержать: покупатель переводит деньги сам по QR-коду, поэтому
+// для него создаётся платёж СБП через CreateSBPPaymentIntent или PayOrder.
+func (s *service) AuthorizePayment(ctx context.Context, info model.PayOrderInfo) (model.Transaction, error) {
+	if err := s.validatePayOrderInfo(info); err != nil {
+		return model.Transaction{}, err
+	}
+	if info.PaymentMethod == model.PaymentMethodSBP {
+		return model.Transaction{}, model.ErrSBPAuthorizationNotAllowed
+	}
+
+	return s.idempotent(ctx, operationAuthorize, info, func(ctx context.Context) (model.Transaction, error) {
+		return s.authorize(ctx, info)
+	})
+}
+
+// authorize удерживает сумму у провайдера и создаёт транзакцию в статусе AUTHORIZED.
+// Отказ провайдера сохраняется как транзакция в статусе DECLINED.
+func (s *service) authorize(ctx context.Context, info model.PayOrderInfo) (model.Transaction, error) {
+	p, err := s.provider(info.PaymentMethod)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	now := time.Now()
+
+	// Сохранённая карта проверяется до антифрода: попытка с чужим или истёкшим токеном не доходит до оплаты.
+	var card model.PaymentCard
+	var details *model.CardDetails
+	if info.PaymentToken != "" {
+		var opened model.CardDetails
+		card, opened, err = s.openCard(ctx, info.UserUUID, info.PaymentToken, now)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+		details = &opened
+	}
+
+	if err := s.screen(ctx, info); err != nil {
+		return model.Transaction{}, err
+	}
+
+	investorUUID := info.InvestorUUID
+	if info.PaymentMethod == model.PaymentMethodInvestorMoney && investorUUID == "" {
+		investorUUID, err = s.chooseInvestor(ctx, info)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+	}
+
+	transaction := model.Transaction{
+		UUID:          uuid.NewString(),
+		OrderUUID:     info.OrderUUID,
+		UserUUID:      info.UserUUID,
+		PaymentMethod: info.PaymentMethod,
+		Status:        model.TransactionStatusAuthorized,
+		Amount:        info.Amount,
+		RefundedAmount: money.Money{
+			CurrencyCode: info.Amount.CurrencyCode,
+		},
+		ChargedBackAmount: money.Money{
+			CurrencyCode: info.Amount.CurrencyCode,
+		},
+		CollectedAmount: money.Money{
+			CurrencyCode: info.Amount.CurrencyCode,
+		},
+		AuthorizedAmount:       info.Amount,
+		AuthorizationExpiresAt: now.Add(s.config.AuthorizationTTL),
+		InvestorUUID:           investorUUID,
+		InstallmentTermMonths:  info.InstallmentTermMonths,
+		PaymentToken:           info.PaymentToken,
+		MaskedCardNumber:       card.MaskedNumber,
+		LineItems:              info.LineItems,
+		CreatedAt:              now,
+	}
+	if err := s.settle(&transaction, now); err != nil {
+		return model.Transaction{}, err
+	}
+
+	req := providerRequest(model.ProviderOperationAuthorize, transaction, transaction.Amount)
+	req.Card = details
+	if err := p.Authorize(ctx, req); err != nil {
+		var decline *model.DeclineError
+		if !errors.As(err, &decline) {
+			return model.Transaction{}, err
+		}
+
+		transaction.Status = model.TransactionStatusDeclined
+		transaction.AuthorizationExpiresAt = time.Time{}
+		transaction.DeclineCode = decline.Code
+		if createErr := s.transactionRepository.Create(ctx, transaction); createErr != nil {
+			return model.Transaction{}, createErr
+		}
+
+		log.Printf("Провайдер отклонил авторизацию, transaction_uuid: %s, decline_code: %s", transaction.UUID, decline.Code)
+		s.publish(ctx, failureEvent(transaction, decline.Code))
+
+		return model.Transaction{}, err
+	}
+
+	if err := s.transactionRepository.Create(ctx, transaction); err != nil {
+		s.releaseHold(ctx, p, transaction)
+		return model.Transaction{}, err
+	}
+
+	log.Printf("Сумма авторизована, transaction_uuid: %s", transaction.UUID)
+	s.publish(ctx, paymentEvent(model.PaymentEventTypeAuthorized, transaction, transaction.AuthorizedAmount))
+
+	return transaction, nil
+}
@@ -0,0 +1,47 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository"
+)
+
+var errStorage = errors.New("storage is unavailable")
+
+// failingTransactions не сохраняет новые транзакции.
+type failingTransactions struct {
+	repository.TransactionRepository
+}
+
+func (failingTransactions) Create(context.Context, model.Transaction) error {
+	return errStorage
+}
+
+// recordingProvider запоминает транзакции, удержание по которым снято.
+type recordingProvider struct {
+	provider.Provider
+	voided []string
+}
+
+func (p *recordingProvider) Void(ctx context.Context, req model.ProviderRequest) error {
+	p.voided = append(p.voided, req.TransactionUUID)
+	return p.Provider.Void(ctx, req)
+}
+
+func TestAuthorizePaymentVoidsUnsavedAuthorization(t *testing.T) {
+	s := newTestService(t)
+	s.transactionRepository = failingTransactions{s.transactionRepository}
+	p := &recordingProvider{Provider: s.providers[model.PaymentMethodCard]}
+	s.providers[model.PaymentMethodCard] = p
+
+	if _, err := s.AuthorizePayment(context.Background(), payOrderInfo(t, "450.00")); !errors.Is(err, errStorage) {
+		t.Fatalf("AuthorizePayment() error = %v, want %v", err, errStorage)
+	}
+	if len(p.voided) != 1 {
+		t.Fatalf("provider voided %d authorizations, want 1", len(p.voided))
+	}
+}
@@ -0,0 +1,141 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// CapturePayment списывает всю или часть авторизованной суммы.
+func (s *service) CapturePayment(ctx context.Context, transactionUUID string, amount *money.Money) (model.Transaction, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.Transaction{}, model.ErrInvalidUUID
+	}
+	if amount != nil {
+		if err := validateAmount(*amount); err != nil {
+			return model.Transaction{}, err
+		}
+	}
+
+	return s.capture(ctx, transactionUUID, amount)
+}
+
+// capture списывает авторизованную сумму. Пустой amount означает всю авторизованную сумму.
+func (s *service) capture(ctx context.Context, transactionUUID string, amount *money.Money) (model.Transaction, error) {
+	unlock := s.transactionLocks.lock(transactionUUID)
+	defer unlock()
+
+	transaction, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	switch {
+	case isCaptured(transaction.Status):
+		// Повтор списания той же суммы возвращает транзакцию без изменений.
+		if amount == nil || *amount == transaction.Amount {
+			return transaction, nil
+		}
+		return model.Transaction{}, model.ErrInvalidTransactionState
+	case transaction.Status != model.TransactionStatusAuthorized:
+		return model.Transaction{}, model.ErrInvalidTransactionState
+	}
+
+	now := time.Now()
+	if !now.Before(transaction.AuthorizationExpiresAt) {
+		s.expire(ctx, transaction)
+		return model.Transaction{}, model.ErrAuthorizationExpired
+	}
+
+	captured := transaction.AuthorizedAmount
+	if amount != nil {
+		if amount.CurrencyCode != captured.CurrencyCode {
+			return model.Transaction{}, fmt.Errorf("%w: currency_code must be %s", model.ErrInvalidAmount, captured.CurrencyCode)
+		}
+		if amount.Cmp(captured) > 0 {
+			return model.Transaction{}, fmt.Errorf("%w: amount must not exceed the authorized amount", model.ErrInvalidAmount)
+		}
+		captured = *amount
+	}
+
+	// В рассрочку заказ оплачивается полностью, но с карты сразу списывается только
+	// первый платёж графика, остальные списывает планировщик. Собранной считается
+	// только внесённая часть основного долга.
+	charged, collected, interest := captured, captured, money.Zero(captured.CurrencyCode)
+	var plan model.InstallmentPlan
+	if transaction.InstallmentTermMonths > 0 {
+		quote, err := s.quoteInstallments(captured, transaction.InstallmentTermMonths, now)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+		plan = newInstallmentPlan(transaction, quote, now)
+		charged = plan.Installments[0].Amount
+		collected = plan.Installments[0].Principal
+		interest = plan.Installments[0].Interest
+	}
+
+	p, err := s.provider(transaction.PaymentMethod)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+	// При отказе или сбое провайдера авторизация остаётся в силе, списание можно повторить.
+	if err := p.Capture(ctx, providerRequest(model.ProviderOperationCapture, transaction, charged)); err != nil {
+		return model.Transaction{}, err
+	}
+
+	authorized := transaction
+	transaction.Amount = captured
+	transaction.CollectedAmount = collected
+	if err := s.resettle(&transaction); err != nil {
+		return model.Transaction{}, err
+	}
+	transaction.Status = model.TransactionStatusPaid
+	transaction.CapturedAt = now
+
+	if err := s.transactionRepository.Update(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+	entry := s.captureEntry(transaction, collected, interest)
+	if err := s.post(ctx, entry); err != nil {
+		// Списание без записи в книге не считается проведённым.
+		if rollbackErr := s.transactionRepository.Update(ctx, authorized); rollbackErr != nil {
+			log.Printf("failed to roll back transaction %s after ledger failure: %v", transaction.UUID, rollbackErr)
+		}
+		return model.Transaction{}, err
+	}
+	if plan.UUID != "" {
+		if err := s.installmentRepository.Create(ctx, plan); err != nil {
+			if rollbackErr := s.transactionRepository.Update(ctx, authorized); rollbackErr != nil {
+				log.Printf("failed to roll back transaction %s after installment plan failure: %v", transaction.UUID, rollbackErr)
+			}
+			s.reverse(ctx, entry)
+			return model.Transaction{}, err
+		}
+		log.Printf("Оформлена рассрочка, plan_uuid: %s, transaction_uuid: %s", plan.UUID, transaction.UUID)
+	}
+
+	log.Printf("Оплата прошла успешно, transaction_uuid: %s", transaction.UUID)
+	s.publish(ctx, paymentEvent(model.PaymentEventTypeCaptured, transaction, collected))
+	s.issueReceipt(ctx, transaction)
+
+	return transaction, nil
+}
+
+// isCaptured сообщает, что сумма транзакции уже списана.
+func isCaptured(status model.TransactionStatus) bool {
+	switch status {
+	case model.TransactionStatusPaid,
+		model.TransactionStatusPartiallyRefunded,
+		model.TransactionStatusRefunded,
+		model.TransactionStatusChargedBack:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,283 @@
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// paymentTokenPrefix — префикс токена сохранённой карты, по которому его легко отличить от номера.
+const paymentTokenPrefix = "card_"
+
+// SaveCard проверяет карту, шифрует её номер активным ключом и сохраняет под новым токеном.
+// Номер карты не возвращается и не пишется в лог.
+func (s *service) SaveCard(ctx context.Context, info model.CardInfo) (model.PaymentCard, error) {
+	if err := uuid.Validate(info.UserUUID); err != nil {
+		return model.PaymentCard{}, model.ErrInvalidUUID
+	}
+	now := time.Now()
+	if err := validateCard(info, now); err != nil {
+		return model.PaymentCard{}, err
+	}
+
+	token, err := paymentToken()
+	if err != nil {
+		return model.PaymentCard{}, err
+	}
+	sealed, err := s.keyring.Seal([]byte(info.Number), cardAAD(token, info.UserUUID))
+	if err != nil {
+		return model.PaymentCard{}, err
+	}
+
+	card := model.PaymentCard{
+		Token:        token,
+		UserUUID:     info.UserUUID,
+		Brand:        cardBrand(info.Number),
+		MaskedNumber: model.MaskCardNumber(info.Number),
+		Last4:        info.Number[len(info.Number)-4:],
+		ExpiryMonth:  info.ExpiryMonth,
+		ExpiryYear:   info.ExpiryYear,
+		Sealed:       sealed,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := s.cardRepository.Create(ctx, card); err != nil {
+		return model.PaymentCard{}, err
+	}
+
+	log.Printf("Карта сохранена, user_uuid: %s, card: %s, key_id: %s", card.UserUUID, card.MaskedNumber, sealed.KeyID)
+
+	// Зашифрованный номер нужен только сервису.
+	card.Sealed = model.SealedData{}
+	return card, nil
+}
+
+// ListCards возвращает сохранённые карты пользователя без зашифрованных номеров.
+func (s *service) ListCards(ctx context.Context, userUUID string) ([]model.PaymentCard, error) {
+	if err := uuid.Validate(userUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	cards, err := s.cardRepository.List(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cards {
+		cards[i].Sealed = model.SealedData{}
+	}
+
+	return cards, nil
+}
+
+// DeleteCard удаляет сохранённую карту пользователя. Карта другого пользователя считается ненайденной.
+func (s *service) DeleteCard(ctx context.Context, userUUID, token string) error {
+	if err := uuid.Validate(userUUID); err != nil {
+		return model.ErrInvalidUUID
+	}
+
+	unlock := s.cardLocks.lock(token)
+	defer unlock()
+
+	card, err := s.cardRepository.Get(ctx, token)
+	if err != nil {
+		return err
+	}
+	if card.UserUUID != userUUID {
+		return model.ErrCardNotFound
+	}
+
+	if err := s.cardRepository.Delete(ctx, token); err != nil {
+		return err
+	}
+
+	log.Printf("Карта удалена, user_uuid: %s, card: %s", card.UserUUID, card.MaskedNumber)
+
+	return nil
+}
+
+// ReencryptCards перешифровывает активным ключом карты, зашифрованные другими ключами.
+// После этого выведенные из оборота ключи можно удалить из файла связки.
+func (s *service) ReencryptCards(ctx context.Context) (model.CardReencryption, error) {
+	activeKeyID := s.keyring.ActiveKeyID()
+	if activeKeyID == "" {
+		return model.CardReencryption{}, model.ErrCardVaultUnavailable
+	}
+
+	cards, err := s.cardRepository.List(ctx, "")
+	if err != nil {
+		return model.CardReencryption{}, err
+	}
+
+	result := model.CardReencryption{ActiveKeyID: activeKeyID}
+	for _, card := range cards {
+		if card.Sealed.KeyID == activeKeyID {
+			continue
+		}
+
+		reencrypted, err := s.reencryptCard(ctx, card.Token, activeKeyID)
+		if err != nil {
+			return model.CardReencryption{}, fmt.Errorf("failed to reencrypt card %s: %w", card.MaskedNumber, err)
+		}
+		if reencrypted {
+			result.Reencrypted++
+		}
+	}
+
+	log.Printf("Карты перешифрованы ключом %s: %d", activeKeyID, result.Reencrypted)
+
+	return result, nil
+}
+
+// reencryptCard перешифровывает одну карту под её блокировкой. Карту, удалённую
+// или уже перешифрованную с момента выборки, пропускает.
+func (s *service) reencryptCard(ctx context.Context, token, activeKeyID string) (bool, error) {
+	unlock := s.cardLocks.lock(token)
+	defer unlock()
+
+	card, err := s.cardRepository.Get(ctx, token)
+	if errors.Is(err, model.ErrCardNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if card.Sealed.KeyID == activeKeyID {
+		return false, nil
+	}
+
+	aad := cardAAD(card.Token, card.UserUUID)
+	number, err := s.keyring.Open(card.Sealed, aad)
+	if err != nil {
+		return false, err
+	}
+	sealed, err := s.keyring.Seal(number, aad)
+	clear(number)
+	if err != nil {
+		return false, err
+	}
+
+	card.Sealed = sealed
+	card.UpdatedAt = time.Now()
+	if err := s.cardRepository.Update(ctx, card); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// openCard находит сохранённую карту пользователя по токену и расшифровывает её для провайдера.
+func (s *service) openCard(ctx context.Context, userUUID, token string, now time.Time) (model.PaymentCard, model.CardDetails, error) {
+	card, err := s.cardRepository.Get(ctx, token)
+	if err != nil {
+		return model.PaymentCard{}, model.CardDetails{}, err
+	}
+	// Чужая карта неотличима от несуществующей, чтобы по токену нельзя было узнать о картах других пользователей.
+	if card.UserUUID != userUUID {
+		return model.PaymentCard{}, model.CardDetails{}, model.ErrCardNotFound
+	}
+	if card.Expired(now) {
+		return model.PaymentCard{}, model.CardDetails{}, model.ErrCardExpired
+	}
+
+	number, err := s.keyring.Open(card.Sealed, cardAAD(card.Token, card.UserUUID))
+	if err != nil {
+		return model.PaymentCard{}, model.CardDetails{}, err
+	}
+	details := model.CardDetails{
+		Number:      string(number),
+		ExpiryMonth: card.ExpiryMonth,
+		ExpiryYear:  card.ExpiryYear,
+	}
+	clear(number)
+
+	return card, details, nil
+}
+
+// validateCard проверяет номер карты по длине и контрольной цифре и что срок её действия не истёк.
+func validateCard(info model.CardInfo, now time.Time) error {
+	if len(info.Number) < 12 || len(info.Number) > 19 || strings.Trim(info.Number, "0123456789") != "" {
+		return fmt.Errorf("%w: card number must have 12 to 19 digits", model.ErrInvalidCard)
+	}
+	if !luhnValid(info.Number) {
+		return fmt.Errorf("%w: card number has an invalid check digit", model.ErrInvalidCard)
+	}
+	if info.ExpiryMonth < 1 || info.ExpiryMonth > 12 || info.ExpiryYear < 2000 || info.ExpiryYear > 2099 {
+		return fmt.Errorf("%w: invalid expiry date", model.ErrInvalidCard)
+	}
+
+	card := model.PaymentCard{ExpiryMonth: info.ExpiryMonth, ExpiryYear: info.ExpiryYear}
+	if card.Expired(now) {
+		return model.ErrCardExpired
+	}
+
+	return nil
+}
+
+// luhnValid проверяет контрольную цифру номера карты по алгоритму Луна.
+func luhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// cardBrand определяет платёжную систему по первым цифрам номера карты.
+func cardBrand(number string) model.CardBrand {
+	// Номер уже проверен: в нём не меньше 12 цифр.
+	prefix2, _ := strconv.Atoi(number[:2])
+	prefix4, _ := strconv.Atoi(number[:4])
+
+	switch {
+	case number[0] == '4':
+		return model.CardBrandVisa
+	case prefix4 >= 2200 && prefix4 <= 2204:
+		return model.CardBrandMir
+	case (prefix2 >= 51 && prefix2 <= 55) || (prefix4 >= 2221 && prefix4 <= 2720):
+		return model.CardBrandMastercard
+	case prefix2 == 34 || prefix2 == 37:
+		return model.CardBrandAmex
+	case prefix4 >= 3528 && prefix4 <= 3589:
+		return model.CardBrandJCB
+	case prefix2 == 62:
+		return model.CardBrandUnionPay
+	default:
+		return model.CardBrandUnspecified
+	}
+}
+
+// cardAAD привязывает шифротекст номера к токену и владельцу карты: подменить
+// зашифрованный номер в файле хранилища номером другой карты не получится.
+func cardAAD(token, userUUID string) []byte {
+	return []byte(token + "\x00" + userUUID)
+}
+
+// paymentToken создаёт случайный непрозрачный токен карты из 16 байт.
+func paymentToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return paymentTokenPrefix + hex.EncodeToString(b), nil
+}
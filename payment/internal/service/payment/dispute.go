@@ -0,0 +1,290 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// openDisputeStatuses — статусы споров, по которым ещё не принято решение.
+var openDisputeStatuses = []model.DisputeStatus{
+	model.DisputeStatusNeedsResponse,
+	model.DisputeStatusUnderReview,
+}
+
+// OpenDispute регистрирует спор эмитента по списанной картой транзакции. Пока спор
+// не решён, по транзакции нельзя ни вернуть деньги, ни открыть другой спор.
+func (s *service) OpenDispute(ctx context.Context, info model.DisputeInfo) (model.Dispute, error) {
+	if err := uuid.Validate(info.TransactionUUID); err != nil {
+		return model.Dispute{}, model.ErrInvalidUUID
+	}
+
+	unlock := s.transactionLocks.lock(info.TransactionUUID)
+	defer unlock()
+
+	transaction, err := s.transactionRepository.Get(ctx, info.TransactionUUID)
+	if err != nil {
+		return model.Dispute{}, err
+	}
+
+	// Споры открывают эмитенты карт, у остальных способов оплаты их не бывает.
+	if transaction.PaymentMethod != model.PaymentMethodCard &&
+		transaction.PaymentMethod != model.PaymentMethodCreditCard {
+		return model.Dispute{}, fmt.Errorf("%w: payment method does not support disputes", model.ErrTransactionNotDisputable)
+	}
+	if transaction.Status != model.TransactionStatusPaid &&
+		transaction.Status != model.TransactionStatusPartiallyRefunded {
+		return model.Dispute{}, model.ErrTransactionNotDisputable
+	}
+
+	open, err := s.hasOpenDispute(ctx, transaction.UUID)
+	if err != nil {
+		return model.Dispute{}, err
+	}
+	if open {
+		return model.Dispute{}, model.ErrDisputeAlreadyOpen
+	}
+
+	remaining := disputableAmount(transaction)
+
+	amount := remaining
+	if info.Amount != nil {
+		amount = *info.Amount
+		if err := validateAmount(amount); err != nil {
+			return model.Dispute{}, err
+		}
+		if amount.CurrencyCode != transaction.Amount.CurrencyCode {
+			return model.Dispute{}, fmt.Errorf("%w: currency_code must be %s", model.ErrInvalidAmount, transaction.Amount.CurrencyCode)
+		}
+		if amount.Cmp(remaining) > 0 {
+			return model.Dispute{}, fmt.Errorf("%w: amount exceeds %s not yet refunded", model.ErrInvalidAmount, remaining)
+		}
+	}
+	if !amount.IsPositive() {
+		return model.Dispute{}, model.ErrTransactionNotDisputable
+	}
+
+	now := time.Now()
+	dispute := model.Dispute{
+		UUID:            uuid.NewString(),
+		TransactionUUID: transaction.UUID,
+		OrderUUID:       transaction.OrderUUID,
+		UserUUID:        transaction.UserUUID,
+		Amount:          amount,
+		Reason:          info.Reason,
+		Status:          model.DisputeStatusNeedsResponse,
+		EvidenceDueBy:   now.Add(s.config.DisputeEvidenceWindow),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := s.disputeRepository.Create(ctx, dispute); err != nil {
+		return model.Dispute{}, err
+	}
+
+	log.Printf("Открыт спор, dispute_uuid: %s, transaction_uuid: %s", dispute.UUID, transaction.UUID)
+	s.publish(ctx, disputeEvent(model.PaymentEventTypeDisputeOpened, transaction, dispute))
+
+	return dispute, nil
+}
+
+func (s *service) GetDispute(ctx context.Context, disputeUUID string) (model.Dispute, error) {
+	if err := uuid.Validate(disputeUUID); err != nil {
+		return model.Dispute{}, model.ErrInvalidUUID
+	}
+
+	return s.disputeRepository.Get(ctx, disputeUUID)
+}
+
+func (s *service) ListDisputes(ctx context.Context, filter model.DisputesFilter) ([]model.Dispute, error) {
+	if filter.TransactionUUID != "" {
+		if err := uuid.Validate(filter.TransactionUUID); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+	}
+
+	return s.disputeRepository.List(ctx, filter)
+}
+
+// SubmitDisputeEvidence оспаривает спор доказательствами. Отправить их можно один раз
+// и только до EvidenceDueBy, после этого спор ждёт решения эмитента.
+func (s *service) SubmitDisputeEvidence(ctx context.Context, disputeUUID string, evidence model.DisputeEvidence) (model.Dispute, error) {
+	if strings.TrimSpace(evidence.Description) == "" {
+		return model.Dispute{}, fmt.Errorf("%w: description is required", model.ErrInvalidDisputeEvidence)
+	}
+
+	return s.changeDispute(ctx, disputeUUID, func(dispute model.Dispute, now time.Time) (model.Dispute, error) {
+		if dispute.Status != model.DisputeStatusNeedsResponse {
+			return model.Dispute{}, fmt.Errorf("%w: evidence can only be submitted while the dispute needs response", model.ErrInvalidDisputeState)
+		}
+		if !now.Before(dispute.EvidenceDueBy) {
+			return model.Dispute{}, model.ErrDisputeEvidenceOverdue
+		}
+
+		evidence.DocumentURLs = slices.Clone(evidence.DocumentURLs)
+		evidence.SubmittedAt = now
+		dispute.Evidence = &evidence
+		dispute.Status = model.DisputeStatusUnderReview
+		return dispute, nil
+	})
+}
+
+// AcceptDispute соглашается со спором без доказательств: спор сразу считается проигранным.
+func (s *service) AcceptDispute(ctx context.Context, disputeUUID string) (model.Dispute, error) {
+	return s.changeDispute(ctx, disputeUUID, func(dispute model.Dispute, _ time.Time) (model.Dispute, error) {
+		if dispute.Status != model.DisputeStatusNeedsResponse {
+			return model.Dispute{}, fmt.Errorf("%w: only a dispute that needs response can be accepted", model.ErrInvalidDisputeState)
+		}
+
+		dispute.Status = model.DisputeStatusLost
+		return dispute, nil
+	})
+}
+
+// ResolveDispute фиксирует решение эмитента по спору.
+func (s *service) ResolveDispute(ctx context.Context, disputeUUID string, outcome model.DisputeOutcome) (model.Dispute, error) {
+	return s.changeDispute(ctx, disputeUUID, func(dispute model.Dispute, _ time.Time) (model.Dispute, error) {
+		if dispute.Status.IsResolved() {
+			return model.Dispute{}, fmt.Errorf("%w: dispute is already resolved", model.ErrInvalidDisputeState)
+		}
+
+		switch outcome {
+		case model.DisputeOutcomeWon:
+			dispute.Status = model.DisputeStatusWon
+		case model.DisputeOutcomeLost:
+			dispute.Status = model.DisputeStatusLost
+		default:
+			return model.Dispute{}, fmt.Errorf("%w: unknown dispute outcome", model.ErrInvalidDisputeState)
+		}
+		return dispute, nil
+	})
+}
+
+// changeDispute применяет change к спору под блокировкой его транзакции и сохраняет результат.
+// Проигранный спор возвращает спорную сумму покупателю записью в книге.
+func (s *service) changeDispute(
+	ctx context.Context,
+	disputeUUID string,
+	change func(dispute model.Dispute, now time.Time) (model.Dispute, error),
+) (model.Dispute, error) {
+	if err := uuid.Validate(disputeUUID); err != nil {
+		return model.Dispute{}, model.ErrInvalidUUID
+	}
+
+	dispute, err := s.disputeRepository.Get(ctx, disputeUUID)
+	if err != nil {
+		return model.Dispute{}, err
+	}
+
+	unlock := s.transactionLocks.lock(dispute.TransactionUUID)
+	defer unlock()
+
+	// Спор перечитывается под блокировкой: его могли изменить, пока блокировка ожидалась.
+	dispute, err = s.disputeRepository.Get(ctx, disputeUUID)
+	if err != nil {
+		return model.Dispute{}, err
+	}
+
+	now := time.Now()
+	updated, err := change(dispute, now)
+	if err != nil {
+		return model.Dispute{}, err
+	}
+	updated.UpdatedAt = now
+	if updated.Status.IsResolved() {
+		updated.ResolvedAt = now
+	}
+
+	if updated.Status == model.DisputeStatusLost {
+		return s.loseDispute(ctx, updated)
+	}
+
+	if err := s.disputeRepository.Update(ctx, updated); err != nil {
+		return model.Dispute{}, err
+	}
+
+	if updated.Status == model.DisputeStatusWon {
+		transaction, err := s.transactionRepository.Get(ctx, updated.TransactionUUID)
+		if err != nil {
+			log.Printf("failed to publish won dispute %s: %v", updated.UUID, err)
+			return updated, nil
+		}
+		log.Printf("Спор выигран, dispute_uuid: %s, transaction_uuid: %s", updated.UUID, transaction.UUID)
+		s.publish(ctx, disputeEvent(model.PaymentEventTypeDisputeWon, transaction, updated))
+	}
+
+	return updated, nil
+}
+
+// loseDispute возвращает спорную сумму покупателю. Вызывается под блокировкой транзакции.
+func (s *service) loseDispute(ctx context.Context, dispute model.Dispute) (model.Dispute, error) {
+	transaction, err := s.transactionRepository.Get(ctx, dispute.TransactionUUID)
+	if err != nil {
+		return model.Dispute{}, err
+	}
+
+	updated := transaction
+	updated.ChargedBackAmount = dispute.Amount.Add(transaction.ChargedBackAmount)
+	if disputableAmount(updated).IsZero() {
+		updated.Status = model.TransactionStatusChargedBack
+	}
+
+	if err := s.transactionRepository.Update(ctx, updated); err != nil {
+		return model.Dispute{}, err
+	}
+	entry := chargebackEntry(transaction, dispute)
+	if err := s.post(ctx, entry); err != nil {
+		if rollbackErr := s.transactionRepository.Update(ctx, transaction); rollbackErr != nil {
+			log.Printf("failed to roll back transaction %s after ledger failure: %v", transaction.UUID, rollbackErr)
+		}
+		return model.Dispute{}, err
+	}
+	if err := s.disputeRepository.Update(ctx, dispute); err != nil {
+		// Пока спор не сохранён проигранным, сумма в транзакции и книге не должна меняться.
+		if rollbackErr := s.transactionRepository.Update(ctx, transaction); rollbackErr != nil {
+			log.Printf("failed to roll back transaction %s after dispute failure: %v", transaction.UUID, rollbackErr)
+		}
+		s.reverse(ctx, entry)
+		return model.Dispute{}, err
+	}
+
+	log.Printf("Спор проигран, dispute_uuid: %s, transaction_uuid: %s", dispute.UUID, transaction.UUID)
+	s.publish(ctx, disputeEvent(model.PaymentEventTypeDisputeLost, updated, dispute))
+
+	if updated.Status == model.TransactionStatusChargedBack && transaction.InstallmentTermMonths > 0 {
+		s.cancelInstallmentPlan(ctx, transaction.UUID)
+	}
+
+	return dispute, nil
+}
+
+// disputableAmount — собранная сумма, которая ещё не вернулась покупателю ни возвратом, ни спором.
+func disputableAmount(transaction model.Transaction) money.Money {
+	return transaction.CollectedAmount.Sub(transaction.RefundedAmount).Sub(transaction.ChargedBackAmount)
+}
+
+// disputeEvent описывает изменение спора по транзакции на спорную сумму.
+func disputeEvent(eventType model.PaymentEventType, transaction model.Transaction, dispute model.Dispute) model.PaymentEvent {
+	event := paymentEvent(eventType, transaction, dispute.Amount)
+	event.DisputeUUID = dispute.UUID
+	return event
+}
+
+// hasOpenDispute сообщает, что по транзакции есть спор без решения.
+func (s *service) hasOpenDispute(ctx context.Context, transactionUUID string) (bool, error) {
+	open, err := s.disputeRepository.List(ctx, model.DisputesFilter{
+		TransactionUUID: transactionUUID,
+		Statuses:        openDisputeStatuses,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(open) > 0, nil
+}
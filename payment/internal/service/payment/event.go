@@ -0,0 +1,110 @@
+package payment
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// eventBatchSize — сколько событий читается из журнала за раз при отправке подписчику.
+const eventBatchSize = 100
+
+// SubscribePaymentEvents отправляет в send события с номерами больше afterSequence,
+// а затем новые события по мере появления, пока не отменён ctx или send не вернул ошибку.
+func (s *service) SubscribePaymentEvents(
+	ctx context.Context,
+	afterSequence int64,
+	send func(event model.PaymentEvent) error,
+) error {
+	for {
+		// Канал берётся до чтения журнала: событие, добавленное сразу после чтения, разбудит подписчика.
+		wake := s.eventNotifier.wait()
+
+		events, err := s.eventRepository.List(ctx, afterSequence, eventBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+			afterSequence = event.Sequence
+		}
+		if len(events) == eventBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		}
+	}
+}
+
+// publish записывает событие о транзакции в журнал, будит подписчиков и ставит событие
+// в очередь вебхуков.
+// Ошибка журнала только логируется: операция над транзакцией уже проведена.
+func (s *service) publish(ctx context.Context, event model.PaymentEvent) {
+	event.CreatedAt = time.Now()
+
+	published, err := s.eventRepository.Append(ctx, event)
+	if err != nil {
+		log.Printf("failed to publish %v event of transaction %s: %v", event.Type, event.TransactionUUID, err)
+		return
+	}
+
+	s.eventNotifier.notify()
+	s.enqueueWebhooks(ctx, published)
+}
+
+// paymentEvent описывает событие типа eventType о транзакции в её текущем статусе.
+func paymentEvent(eventType model.PaymentEventType, transaction model.Transaction, amount money.Money) model.PaymentEvent {
+	return model.PaymentEvent{
+		Type:              eventType,
+		TransactionUUID:   transaction.UUID,
+		OrderUUID:         transaction.OrderUUID,
+		UserUUID:          transaction.UserUUID,
+		PaymentMethod:     transaction.PaymentMethod,
+		TransactionStatus: transaction.Status,
+		Amount:            amount,
+	}
+}
+
+// failureEvent описывает неуспешную оплату транзакции с причиной reason.
+func failureEvent(transaction model.Transaction, reason string) model.PaymentEvent {
+	event := paymentEvent(model.PaymentEventTypeFailed, transaction, transaction.Amount)
+	event.Reason = reason
+	return event
+}
+
+// eventNotifier будит подписчиков, ожидающих новых событий.
+type eventNotifier struct {
+	mu   sync.Mutex
+	wake chan struct{}
+}
+
+func newEventNotifier() *eventNotifier {
+	return &eventNotifier{wake: make(chan struct{})}
+}
+
+// wait возвращает канал, который закроется при следующем событии.
+func (n *eventNotifier) wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.wake
+}
+
+// notify будит всех ожидающих и готовит канал для следующего события.
+func (n *eventNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	close(n.wake)
+	n.wake = make(chan struct{})
+}
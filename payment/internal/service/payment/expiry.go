@@ -0,0 +1,88 @@
+package payment
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// RunAuthorizationExpiry периодически переводит просроченные авторизации и неоплаченные
+// платежи СБП в EXPIRED до отмены ctx.
+func (s *service) RunAuthorizationExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.expireAuthorizations(ctx, now)
+		}
+	}
+}
+
+func (s *service) expireAuthorizations(ctx context.Context, now time.Time) {
+	authorized, err := s.transactionRepository.List(ctx, model.TransactionsFilter{
+		Statuses: []model.TransactionStatus{model.TransactionStatusAuthorized, model.TransactionStatusPending},
+	})
+	if err != nil {
+		log.Printf("failed to list authorized transactions: %v", err)
+		return
+	}
+
+	for _, transaction := range authorized {
+		if now.Before(transaction.AuthorizationExpiresAt) {
+			continue
+		}
+
+		unlock := s.transactionLocks.lock(transaction.UUID)
+		// Транзакцию могли списать или отменить, пока мы получали список.
+		current, err := s.transactionRepository.Get(ctx, transaction.UUID)
+		if err == nil && current.Status == transaction.Status {
+			s.expire(ctx, current)
+		}
+		unlock()
+	}
+}
+
+// expire снимает удержание у провайдера и переводит авторизацию в EXPIRED.
+// Если провайдер недоступен, авторизация остаётся AUTHORIZED до следующей попытки.
+// Вызывается под блокировкой транзакции.
+func (s *service) expire(ctx context.Context, transaction model.Transaction) {
+	if transaction.Status == model.TransactionStatusPending {
+		// У неоплаченного платежа СБП нет удержания у провайдера.
+		s.markExpired(ctx, transaction)
+		return
+	}
+
+	p, err := s.provider(transaction.PaymentMethod)
+	if err != nil {
+		log.Printf("failed to expire authorization %s: %v", transaction.UUID, err)
+		return
+	}
+	if err := p.Void(ctx, providerRequest(model.ProviderOperationVoid, transaction, transaction.AuthorizedAmount)); err != nil {
+		log.Printf("failed to release expired authorization %s: %v", transaction.UUID, err)
+		return
+	}
+
+	s.markExpired(ctx, transaction)
+}
+
+func (s *service) markExpired(ctx context.Context, transaction model.Transaction) {
+	reason := model.PaymentFailureAuthorizationExpired
+	if transaction.Status == model.TransactionStatusPending {
+		reason = model.PaymentFailureIntentExpired
+	}
+
+	transaction.Status = model.TransactionStatusExpired
+	if err := s.transactionRepository.Update(ctx, transaction); err != nil {
+		log.Printf("failed to expire authorization %s: %v", transaction.UUID, err)
+		return
+	}
+
+	log.Printf("Авторизация истекла, transaction_uuid: %s", transaction.UUID)
+	s.publish(ctx, failureEvent(transaction, reason))
+}
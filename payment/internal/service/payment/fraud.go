@@ -0,0 +1,81 @@
+package payment
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/fraud"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// screen проверяет попытку оплаты правилами антифрода и записывает решение.
+// Заблокированная попытка возвращает *model.FraudError.
+func (s *service) screen(ctx context.Context, info model.PayOrderInfo) error {
+	// Проверка и запись решения идут под блокировкой пользователя, чтобы параллельные
+	// попытки не обошли лимит частоты, не увидев друг друга.
+	unlock := s.userLocks.lock(info.UserUUID)
+	defer unlock()
+
+	now := time.Now()
+
+	var history fraud.History
+	if window := s.screener.HistoryWindow(); window > 0 {
+		from := now.Add(-window)
+
+		attempts, err := s.fraudRepository.List(ctx, model.FraudDecisionsFilter{
+			UserUUID:    info.UserUUID,
+			CreatedFrom: from,
+		})
+		if err != nil {
+			return err
+		}
+		declined, err := s.transactionRepository.List(ctx, model.TransactionsFilter{
+			UserUUIDs:   []string{info.UserUUID},
+			Statuses:    []model.TransactionStatus{model.TransactionStatusDeclined},
+			CreatedFrom: from,
+		})
+		if err != nil {
+			return err
+		}
+
+		history.Attempts = attempts
+		for _, transaction := range declined {
+			history.Failures = append(history.Failures, transaction.CreatedAt)
+		}
+	}
+
+	decision := s.screener.Screen(info, history, now)
+	decision.UUID = uuid.NewString()
+	decision.CreatedAt = now
+
+	if err := s.fraudRepository.Create(ctx, decision); err != nil {
+		return err
+	}
+
+	if decision.Outcome == model.FraudOutcomeBlock {
+		log.Printf(
+			"Антифрод заблокировал оплату, order_uuid: %s, user_uuid: %s, rule: %s, reason: %s",
+			info.OrderUUID, info.UserUUID, decision.Rule, decision.Reason,
+		)
+		return &model.FraudError{Reason: decision.Reason, Rule: decision.Rule}
+	}
+
+	return nil
+}
+
+// ListFraudDecisions возвращает решения антифрода в порядке их принятия.
+func (s *service) ListFraudDecisions(ctx context.Context, filter model.FraudDecisionsFilter) ([]model.FraudDecision, error) {
+	for _, value := range []string{filter.UserUUID, filter.OrderUUID} {
+		if value == "" {
+			continue
+		}
+		if err := uuid.Validate(value); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+	}
+
+	return s.fraudRepository.List(ctx, filter)
+}
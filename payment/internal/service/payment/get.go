@@ -0,0 +1,17 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func (s *service) GetTransaction(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.Transaction{}, model.ErrInvalidUUID
+	}
+
+	return s.transactionRepository.Get(ctx, transactionUUID)
+}
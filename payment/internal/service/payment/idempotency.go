@@ -0,0 +1,181 @@
+package payment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// Операции, для которых ключ идемпотентности действует раздельно:
+// ключ оплаты нельзя повторно использовать для авторизации и наоборот.
+const (
+	operationPay       = "pay"
+	operationAuthorize = "authorize"
+	operationSBPIntent = "sbp_intent"
+)
+
+// idempotent выполняет do не более одного раза для ключа идемпотентности из info.
+// Повтор с тем же ключом и запросом возвращает созданную ранее транзакцию,
+// повтор с другим запросом — ErrIdempotencyKeyReused. Если транзакция ключа
+// отменена, истекла или отклонена, денег по ней не списано, и ключ считается
+// свободным: повтор, в том числе другим способом оплаты, проводит новую оплату.
+func (s *service) idempotent(
+	ctx context.Context,
+	operation string,
+	info model.PayOrderInfo,
+	do func(ctx context.Context) (model.Transaction, error),
+) (model.Transaction, error) {
+	if info.IdempotencyKey == "" {
+		return do(ctx)
+	}
+
+	// Запросы с одним ключом обрабатываются последовательно, чтобы параллельный
+	// повтор не успел провести вторую оплату до сохранения ключа.
+	unlock := s.keyLocks.lock(info.IdempotencyKey)
+	defer unlock()
+
+	requestHash := payOrderHash(operation, info)
+
+	record, err := s.idempotencyRepository.Get(ctx, info.IdempotencyKey)
+	switch {
+	case err == nil:
+		previous, err := s.transactionRepository.Get(ctx, record.TransactionUUID)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+		if isAbandoned(previous) {
+			log.Printf("Ключ идемпотентности освобождён: прежняя транзакция не списала деньги, transaction_uuid: %s",
+				previous.UUID)
+			break
+		}
+		if record.RequestHash != requestHash {
+			return model.Transaction{}, model.ErrIdempotencyKeyReused
+		}
+		log.Printf("Повтор запроса по ключу идемпотентности, transaction_uuid: %s", record.TransactionUUID)
+		return previous, nil
+	case !errors.Is(err, model.ErrIdempotencyKeyNotFound):
+		return model.Transaction{}, err
+	}
+
+	transaction, err := do(ctx)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	err = s.idempotencyRepository.Save(ctx, model.IdempotencyRecord{
+		Key:             info.IdempotencyKey,
+		RequestHash:     requestHash,
+		TransactionUUID: transaction.UUID,
+		ExpiresAt:       transaction.CreatedAt.Add(s.config.IdempotencyRetention),
+	})
+	if err != nil {
+		// Оплата уже проведена: ошибка здесь заставила бы клиента повторить запрос и заплатить дважды.
+		log.Printf("failed to save idempotency key for transaction %s: %v", transaction.UUID, err)
+	}
+
+	return transaction, nil
+}
+
+// isAbandoned сообщает, что транзакция завершилась, не списав и не удерживая деньги.
+func isAbandoned(transaction model.Transaction) bool {
+	switch transaction.Status {
+	case model.TransactionStatusVoided, model.TransactionStatusExpired, model.TransactionStatusDeclined:
+		return true
+	default:
+		return false
+	}
+}
+
+// payOrderHash возвращает отпечаток операции и полезной нагрузки запроса без ключа идемпотентности.
+func payOrderHash(operation string, info model.PayOrderInfo) string {
+	payload := fmt.Appendf(nil, "%s\x00%s\x00%s\x00%d\x00%s\x00%d\x00%d",
+		operation,
+		info.OrderUUID,
+		info.UserUUID,
+		info.PaymentMethod,
+		info.Amount.CurrencyCode,
+		info.Amount.Units,
+		info.Amount.Nanos,
+	)
+	// Инвестор добавляется только если указан, чтобы отпечатки сохранённых ранее ключей не изменились.
+	if info.InvestorUUID != "" {
+		payload = fmt.Appendf(payload, "\x00%s", info.InvestorUUID)
+	}
+	if info.InstallmentTermMonths != 0 {
+		payload = fmt.Appendf(payload, "\x00installments:%d", info.InstallmentTermMonths)
+	}
+	if info.PaymentToken != "" {
+		payload = fmt.Appendf(payload, "\x00token:%s", info.PaymentToken)
+	}
+	for _, item := range info.LineItems {
+		payload = fmt.Appendf(payload, "\x00item:%s\x00%s\x00%d\x00%s",
+			item.SKU, item.Name, item.Quantity, item.UnitPrice.Decimal())
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+// RunIdempotencyCleanup периодически удаляет истёкшие ключи идемпотентности до отмены ctx.
+func (s *service) RunIdempotencyCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			deleted, err := s.idempotencyRepository.DeleteExpired(ctx, now)
+			if err != nil {
+				log.Printf("failed to delete expired idempotency keys: %v", err)
+				continue
+			}
+			if deleted > 0 {
+				log.Printf("deleted %d expired idempotency keys", deleted)
+			}
+		}
+	}
+}
+
+// keyLocks выдаёт мьютекс на каждый ключ и удаляет его, когда ключ никто не держит.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock захватывает мьютекс ключа и возвращает функцию освобождения.
+func (k *keyLocks) lock(key string) func() {
+	k.mu.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.mu.Lock()
+
+	return func() {
+		l.mu.Unlock()
+
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
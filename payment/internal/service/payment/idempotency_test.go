@@ -0,0 +1,134 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func TestAuthorizePaymentRetryAfterVoid(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	info := payOrderInfo(t, "450.00")
+	info.IdempotencyKey = info.OrderUUID
+
+	first, err := s.AuthorizePayment(ctx, info)
+	if err != nil {
+		t.Fatalf("AuthorizePayment() error = %v", err)
+	}
+	if _, err := s.VoidAuthorization(ctx, first.UUID); err != nil {
+		t.Fatalf("VoidAuthorization() error = %v", err)
+	}
+
+	// Заказ оплачивается повторно с тем же ключом: отменённая авторизация не должна вернуться.
+	second, err := s.AuthorizePayment(ctx, info)
+	if err != nil {
+		t.Fatalf("AuthorizePayment() retry error = %v", err)
+	}
+	if second.UUID == first.UUID {
+		t.Fatalf("AuthorizePayment() retry returned voided transaction %s", first.UUID)
+	}
+	if _, err := s.CapturePayment(ctx, second.UUID, nil); err != nil {
+		t.Fatalf("CapturePayment() error = %v", err)
+	}
+}
+
+func TestAuthorizePaymentRetryWithAnotherMethodAfterVoid(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	info := payOrderInfo(t, "450.00")
+	info.IdempotencyKey = info.OrderUUID
+
+	first, err := s.AuthorizePayment(ctx, info)
+	if err != nil {
+		t.Fatalf("AuthorizePayment() error = %v", err)
+	}
+	if _, err := s.VoidAuthorization(ctx, first.UUID); err != nil {
+		t.Fatalf("VoidAuthorization() error = %v", err)
+	}
+
+	// Отменённая попытка не занимает ключ, поэтому другой способ оплаты не считается его повторным использованием.
+	info.PaymentMethod = model.PaymentMethodCreditCard
+	if _, err := s.AuthorizePayment(ctx, info); err != nil {
+		t.Fatalf("AuthorizePayment() with another method error = %v", err)
+	}
+}
+
+func TestPayOrderIdempotency(t *testing.T) {
+	tests := []struct {
+		name string
+		// change меняет повторный запрос; nil повторяет запрос без изменений.
+		change      func(info *model.PayOrderInfo)
+		wantErr     error
+		wantReplay  bool
+		wantEntries int
+	}{
+		{
+			name:        "same request",
+			wantReplay:  true,
+			wantEntries: 1,
+		},
+		{
+			name:        "another amount",
+			change:      func(info *model.PayOrderInfo) { info.Amount = info.Amount.Add(info.Amount) },
+			wantErr:     model.ErrIdempotencyKeyReused,
+			wantEntries: 1,
+		},
+		{
+			name:        "another payment method",
+			change:      func(info *model.PayOrderInfo) { info.PaymentMethod = model.PaymentMethodCreditCard },
+			wantErr:     model.ErrIdempotencyKeyReused,
+			wantEntries: 1,
+		},
+		{
+			name:        "another order",
+			change:      func(info *model.PayOrderInfo) { info.OrderUUID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d" },
+			wantErr:     model.ErrIdempotencyKeyReused,
+			wantEntries: 1,
+		},
+		{
+			name:        "another key",
+			change:      func(info *model.PayOrderInfo) { info.IdempotencyKey = "another-key" },
+			wantEntries: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+
+			info := payOrderInfo(t, "450.00")
+			info.IdempotencyKey = info.OrderUUID
+			first, err := s.PayOrder(ctx, info)
+			if err != nil {
+				t.Fatalf("PayOrder() error = %v", err)
+			}
+
+			retry := info
+			if tt.change != nil {
+				tt.change(&retry)
+			}
+			second, err := s.PayOrder(ctx, retry)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PayOrder() retry error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (second.UUID == first.UUID) != tt.wantReplay {
+				t.Fatalf("PayOrder() retry returned %s, first payment is %s, want replay %v", second.UUID, first.UUID, tt.wantReplay)
+			}
+
+			// Повтор не списывает деньги второй раз: в книге одна запись на каждую оплату.
+			entries, err := s.ListJournalEntries(ctx, "")
+			if err != nil {
+				t.Fatalf("ListJournalEntries() error = %v", err)
+			}
+			if len(entries) != tt.wantEntries {
+				t.Fatalf("journal has %d entries, want %d", len(entries), tt.wantEntries)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/installment"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// QuoteInstallmentPlan рассчитывает график рассрочки суммы amount на termMonths месяцев.
+func (s *service) QuoteInstallmentPlan(_ context.Context, amount money.Money, termMonths int) (model.InstallmentQuote, error) {
+	if err := validateAmount(amount); err != nil {
+		return model.InstallmentQuote{}, err
+	}
+
+	return s.quoteInstallments(amount, termMonths, time.Now())
+}
+
+func (s *service) GetInstallmentPlan(ctx context.Context, planUUID string) (model.InstallmentPlan, error) {
+	if err := uuid.Validate(planUUID); err != nil {
+		return model.InstallmentPlan{}, model.ErrInvalidUUID
+	}
+
+	return s.installmentRepository.Get(ctx, planUUID)
+}
+
+func (s *service) ListInstallmentPlans(ctx context.Context, filter model.InstallmentPlansFilter) ([]model.InstallmentPlan, error) {
+	return s.installmentRepository.List(ctx, filter)
+}
+
+// quoteInstallments строит график с первым платежом в момент start по ставке для срока termMonths.
+func (s *service) quoteInstallments(amount money.Money, termMonths int, start time.Time) (model.InstallmentQuote, error) {
+	rate, ok := s.config.InstallmentRates[termMonths]
+	if !ok {
+		terms := make([]int, 0, len(s.config.InstallmentRates))
+		for term := range s.config.InstallmentRates {
+			terms = append(terms, term)
+		}
+		slices.Sort(terms)
+		return model.InstallmentQuote{}, fmt.Errorf("%w: term_months must be one of %v", model.ErrInvalidInstallmentTerm, terms)
+	}
+
+	quote := installment.Schedule(amount, termMonths, rate, start, s.config.InstallmentPeriod)
+	for _, i := range quote.Installments {
+		if !i.Amount.IsPositive() {
+			return model.InstallmentQuote{}, fmt.Errorf("%w: amount is too small to split into %d installments", model.ErrInvalidAmount, termMonths)
+		}
+	}
+
+	return quote, nil
+}
+
+// validateInstallmentTerm проверяет, что рассрочку можно оформить на сумму и способ оплаты запроса.
+func (s *service) validateInstallmentTerm(info model.PayOrderInfo) error {
+	if info.InstallmentTermMonths == 0 {
+		return nil
+	}
+	if info.PaymentMethod != model.PaymentMethodCreditCard {
+		return fmt.Errorf("%w: installments are available only for CREDIT_CARD", model.ErrInvalidInstallmentTerm)
+	}
+
+	_, err := s.quoteInstallments(info.Amount, info.InstallmentTermMonths, time.Now())
+	return err
+}
+
+// newInstallmentPlan создаёт план по графику quote. Первый платёж вносится при списании
+// транзакции, поэтому сразу отмечается оплаченным.
+func newInstallmentPlan(transaction model.Transaction, quote model.InstallmentQuote, now time.Time) model.InstallmentPlan {
+	plan := model.InstallmentPlan{
+		UUID:             uuid.NewString(),
+		TransactionUUID:  transaction.UUID,
+		OrderUUID:        transaction.OrderUUID,
+		UserUUID:         transaction.UserUUID,
+		InstallmentQuote: quote,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	plan.Installments[0].Status = model.InstallmentStatusPaid
+	plan.Installments[0].PaidAt = now
+	plan.Status = installmentPlanStatus(plan, 0)
+
+	return plan
+}
+
+// RunInstallmentScheduler периодически списывает наступившие платежи рассрочки до отмены ctx.
+func (s *service) RunInstallmentScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.chargeDueInstallments(ctx, now)
+		}
+	}
+}
+
+// chargeDueInstallments списывает наступившие платежи всех планов, по которым идут списания.
+func (s *service) chargeDueInstallments(ctx context.Context, now time.Time) {
+	plans, err := s.installmentRepository.List(ctx, model.InstallmentPlansFilter{
+		Statuses: []model.InstallmentPlanStatus{
+			model.InstallmentPlanStatusActive,
+			model.InstallmentPlanStatusDelinquent,
+		},
+	})
+	if err != nil {
+		log.Printf("failed to list installment plans: %v", err)
+		return
+	}
+
+	for _, plan := range plans {
+		if err := s.chargeInstallments(ctx, plan, now); err != nil {
+			log.Printf("failed to charge installments of plan %s: %v", plan.UUID, err)
+		}
+	}
+}
+
+// chargeInstallments списывает наступившие платежи плана по порядку: пока более ранний
+// платёж не внесён, следующие не списываются. Платёж, не списанный до конца льготного
+// периода, отмечается пропущенным, но попытки списать его продолжаются.
+func (s *service) chargeInstallments(ctx context.Context, plan model.InstallmentPlan, now time.Time) error {
+	// Списания по плану не должны пересекаться с возвратом по той же транзакции.
+	unlock := s.transactionLocks.lock(plan.TransactionUUID)
+	defer unlock()
+
+	plan, err := s.installmentRepository.Get(ctx, plan.UUID)
+	if err != nil {
+		return err
+	}
+	if plan.Status != model.InstallmentPlanStatusActive && plan.Status != model.InstallmentPlanStatusDelinquent {
+		return nil
+	}
+	transaction, err := s.transactionRepository.Get(ctx, plan.TransactionUUID)
+	if err != nil {
+		return err
+	}
+	p, err := s.provider(transaction.PaymentMethod)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	blocked := false
+	var paid []model.Installment
+	for i := range plan.Installments {
+		installment := &plan.Installments[i]
+		if installment.Status == model.InstallmentStatusPaid || installment.DueAt.After(now) {
+			continue
+		}
+
+		if !blocked && !installment.NextAttemptAt.After(now) {
+			changed = true
+			// После уменьшения долга возвратом последние платежи графика могут обнулиться.
+			var err error
+			if installment.Amount.IsPositive() {
+				err = p.Charge(ctx, providerRequest(model.ProviderOperationCharge, transaction, installment.Amount))
+			}
+			var decline *model.DeclineError
+			switch {
+			case err == nil:
+				installment.Status = model.InstallmentStatusPaid
+				installment.PaidAt = now
+				installment.NextAttemptAt = time.Time{}
+				paid = append(paid, *installment)
+				log.Printf("Платёж рассрочки внесён, plan_uuid: %s, number: %d", plan.UUID, installment.Number)
+			case errors.As(err, &decline):
+				installment.Attempts++
+				installment.LastDeclineCode = decline.Code
+				installment.NextAttemptAt = now.Add(s.config.InstallmentRetryInterval)
+				log.Printf("Провайдер отклонил платёж рассрочки, plan_uuid: %s, number: %d, decline_code: %s",
+					plan.UUID, installment.Number, decline.Code)
+			default:
+				// Сбой провайдера не считается попыткой: платёж повторится на следующем запуске.
+				log.Printf("failed to charge installment %d of plan %s: %v", installment.Number, plan.UUID, err)
+			}
+		}
+
+		if installment.Status == model.InstallmentStatusScheduled &&
+			!now.Before(installment.DueAt.Add(s.config.InstallmentGracePeriod)) {
+			changed = true
+			installment.Status = model.InstallmentStatusMissed
+			plan.MissedCount++
+			log.Printf("Платёж рассрочки пропущен, plan_uuid: %s, number: %d", plan.UUID, installment.Number)
+		}
+
+		if installment.Status != model.InstallmentStatusPaid {
+			blocked = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	plan.Status = installmentPlanStatus(plan, s.config.InstallmentMaxMissed)
+	plan.UpdatedAt = now
+
+	// План сохраняется первым: платёж, не отмеченный внесённым, спишется повторно.
+	if err := s.installmentRepository.Update(ctx, plan); err != nil {
+		return err
+	}
+	if len(paid) == 0 {
+		return nil
+	}
+
+	return s.collectInstallments(ctx, transaction, paid)
+}
+
+// collectInstallments добавляет основной долг внесённых платежей к собранной сумме
+// транзакции, проводит платежи в книге вместе с процентами и сообщает о каждом подписчикам.
+// Деньги к этому моменту уже списаны, поэтому сбой книги только пишется в лог
+// и обнаружится при сверке.
+func (s *service) collectInstallments(ctx context.Context, transaction model.Transaction, paid []model.Installment) error {
+	updated := transaction
+	for _, installment := range paid {
+		updated.CollectedAmount = updated.CollectedAmount.Add(installment.Principal)
+	}
+	if err := s.transactionRepository.Update(ctx, updated); err != nil {
+		return err
+	}
+
+	for _, installment := range paid {
+		if !installment.Amount.IsPositive() {
+			continue
+		}
+		if err := s.post(ctx, s.captureEntry(updated, installment.Principal, installment.Interest)); err != nil {
+			log.Printf("failed to post installment %d of transaction %s: %v", installment.Number, transaction.UUID, err)
+		}
+		s.publish(ctx, paymentEvent(model.PaymentEventTypeInstallmentCharged, updated, installment.Amount))
+	}
+	return nil
+}
+
+// installmentPlanStatus определяет статус плана по его платежам. Нулевой maxMissed
+// означает, что план не признаётся дефолтным.
+func installmentPlanStatus(plan model.InstallmentPlan, maxMissed int) model.InstallmentPlanStatus {
+	paid := true
+	overdue := false
+	for _, installment := range plan.Installments {
+		if installment.Status != model.InstallmentStatusPaid {
+			paid = false
+		}
+		if installment.Status == model.InstallmentStatusMissed {
+			overdue = true
+		}
+	}
+
+	switch {
+	case paid:
+		return model.InstallmentPlanStatusCompleted
+	case maxMissed > 0 && plan.MissedCount >= maxMissed:
+		return model.InstallmentPlanStatusDefaulted
+	case overdue:
+		return model.InstallmentPlanStatusDelinquent
+	default:
+		return model.InstallmentPlanStatusActive
+	}
+}
+
+// outstandingPrincipal возвращает основной долг невнесённых платежей плана.
+func outstandingPrincipal(plan model.InstallmentPlan) money.Money {
+	outstanding := money.Zero(plan.Principal.CurrencyCode)
+	for _, i := range plan.Installments {
+		if i.Status != model.InstallmentStatusPaid {
+			outstanding = outstanding.Add(i.Principal)
+		}
+	}
+	return outstanding
+}
+
+// unpaidInstallmentPlan возвращает действующий план транзакции с невнесённым долгом.
+// Пустой UUID плана означает, что такого плана нет.
+func (s *service) unpaidInstallmentPlan(ctx context.Context, transactionUUID string) (model.InstallmentPlan, error) {
+	plans, err := s.installmentRepository.List(ctx, model.InstallmentPlansFilter{TransactionUUID: transactionUUID})
+	if err != nil {
+		return model.InstallmentPlan{}, err
+	}
+
+	for _, plan := range plans {
+		if plan.Status != model.InstallmentPlanStatusCancelled && outstandingPrincipal(plan).IsPositive() {
+			return plan, nil
+		}
+	}
+	return model.InstallmentPlan{}, nil
+}
+
+// reduceInstallmentPlan уменьшает невнесённый основной долг плана на amount и заново
+// рассчитывает оставшиеся платежи по ставке плана: их число, сроки и попытки списания
+// не меняются, уменьшаются суммы. План, долг по которому погашен целиком, отменяется.
+func reduceInstallmentPlan(plan model.InstallmentPlan, amount money.Money, now time.Time) model.InstallmentPlan {
+	plan.Installments = slices.Clone(plan.Installments)
+	plan.Principal = plan.Principal.Sub(amount)
+	plan.UpdatedAt = now
+
+	outstanding := outstandingPrincipal(plan).Sub(amount)
+	if !outstanding.IsPositive() {
+		plan.Status = model.InstallmentPlanStatusCancelled
+		return plan
+	}
+
+	var unpaid []int
+	for i, planned := range plan.Installments {
+		if planned.Status != model.InstallmentStatusPaid {
+			unpaid = append(unpaid, i)
+		}
+	}
+	schedule := installment.Schedule(outstanding, len(unpaid), plan.AnnualRateBasisPoints, now, 0)
+	for k, i := range unpaid {
+		plan.Installments[i].Principal = schedule.Installments[k].Principal
+		plan.Installments[i].Interest = schedule.Installments[k].Interest
+		plan.Installments[i].Amount = schedule.Installments[k].Amount
+	}
+
+	plan.TotalInterest = money.Zero(plan.Principal.CurrencyCode)
+	plan.Total = money.Zero(plan.Principal.CurrencyCode)
+	for _, planned := range plan.Installments {
+		plan.TotalInterest = plan.TotalInterest.Add(planned.Interest)
+		plan.Total = plan.Total.Add(planned.Amount)
+	}
+
+	return plan
+}
+
+// cancelInstallmentPlan прекращает списания по плану транзакции, сумма которой полностью
+// вернулась покупателю возвратами или спорами.
+// Вызывается под блокировкой транзакции.
+func (s *service) cancelInstallmentPlan(ctx context.Context, transactionUUID string) {
+	plans, err := s.installmentRepository.List(ctx, model.InstallmentPlansFilter{TransactionUUID: transactionUUID})
+	if err != nil {
+		log.Printf("failed to find installment plan of transaction %s: %v", transactionUUID, err)
+		return
+	}
+
+	for _, plan := range plans {
+		if plan.Status == model.InstallmentPlanStatusCompleted || plan.Status == model.InstallmentPlanStatusCancelled {
+			continue
+		}
+		plan.Status = model.InstallmentPlanStatusCancelled
+		plan.UpdatedAt = time.Now()
+		if err := s.installmentRepository.Update(ctx, plan); err != nil {
+			log.Printf("failed to cancel installment plan %s: %v", plan.UUID, err)
+			continue
+		}
+		log.Printf("План рассрочки отменён, plan_uuid: %s", plan.UUID)
+	}
+}
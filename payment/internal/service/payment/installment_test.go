@@ -0,0 +1,251 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// payInInstallments оплачивает заказ кредитной картой в рассрочку на три месяца.
+func payInInstallments(t *testing.T, s *service, amount string) model.Transaction {
+	t.Helper()
+
+	info := payOrderInfo(t, amount)
+	info.PaymentMethod = model.PaymentMethodCreditCard
+	info.InstallmentTermMonths = 3
+
+	transaction, err := s.PayOrder(context.Background(), info)
+	if err != nil {
+		t.Fatalf("PayOrder() error = %v", err)
+	}
+	return transaction
+}
+
+func installmentPlan(t *testing.T, s *service, transactionUUID string) model.InstallmentPlan {
+	t.Helper()
+
+	plans, err := s.ListInstallmentPlans(context.Background(), model.InstallmentPlansFilter{TransactionUUID: transactionUUID})
+	if err != nil || len(plans) != 1 {
+		t.Fatalf("ListInstallmentPlans() = %d plans, error = %v", len(plans), err)
+	}
+	return plans[0]
+}
+
+func checkLedger(t *testing.T, s *service) {
+	t.Helper()
+
+	report, err := s.CheckLedger(context.Background())
+	if err != nil {
+		t.Fatalf("CheckLedger() error = %v", err)
+	}
+	if len(report.Violations) > 0 {
+		t.Fatalf("CheckLedger() violations = %+v", report.Violations)
+	}
+}
+
+func TestInstallmentCollectedAmount(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	transaction := payInInstallments(t, s, "900.00")
+	if got, want := transaction.CollectedAmount, mustMoney(t, "300.00"); got.Cmp(want) != 0 {
+		t.Fatalf("CollectedAmount after capture = %s, want %s", got, want)
+	}
+	checkLedger(t, s)
+
+	receipt, err := s.GetReceipt(ctx, transaction.UUID)
+	if err != nil {
+		t.Fatalf("GetReceipt() error = %v", err)
+	}
+	if receipt.Total.Cmp(mustMoney(t, "300.00")) != 0 || receipt.CreditAmount.Cmp(mustMoney(t, "600.00")) != 0 {
+		t.Fatalf("receipt total = %s, credit = %s, want 300.00 and 600.00", receipt.Total, receipt.CreditAmount)
+	}
+
+	// Спорить можно только о том, что уже списано.
+	disputed := mustMoney(t, "300.01")
+	_, err = s.OpenDispute(ctx, model.DisputeInfo{
+		TransactionUUID: transaction.UUID,
+		Amount:          &disputed,
+		Reason:          model.DisputeReasonProductNotReceived,
+	})
+	if !errors.Is(err, model.ErrInvalidAmount) {
+		t.Fatalf("OpenDispute() over collected error = %v, want %v", err, model.ErrInvalidAmount)
+	}
+
+	s.chargeDueInstallments(ctx, time.Now().AddDate(0, 3, 0))
+
+	transaction, err = s.GetTransaction(ctx, transaction.UUID)
+	if err != nil {
+		t.Fatalf("GetTransaction() error = %v", err)
+	}
+	if got, want := transaction.CollectedAmount, mustMoney(t, "900.00"); got.Cmp(want) != 0 {
+		t.Fatalf("CollectedAmount after all installments = %s, want %s", got, want)
+	}
+	if plan := installmentPlan(t, s, transaction.UUID); plan.Status != model.InstallmentPlanStatusCompleted {
+		t.Fatalf("plan status = %v, want completed", plan.Status)
+	}
+	checkLedger(t, s)
+}
+
+func TestInstallmentRefund(t *testing.T) {
+	tests := []struct {
+		name           string
+		amount         string
+		wantErr        error
+		wantReturned   string
+		wantWrittenOff string
+		wantStatus     model.TransactionStatus
+		wantPlanStatus model.InstallmentPlanStatus
+		// wantUnpaid — суммы невнесённых платежей после возврата.
+		wantUnpaid []string
+	}{
+		{
+			name:           "reduces unpaid installments",
+			amount:         "450.00",
+			wantReturned:   "0",
+			wantWrittenOff: "450.00",
+			wantStatus:     model.TransactionStatusPartiallyRefunded,
+			wantPlanStatus: model.InstallmentPlanStatusActive,
+			wantUnpaid:     []string{"75.00", "75.00"},
+		},
+		{
+			name:           "writes off the debt and returns the rest",
+			amount:         "700.00",
+			wantReturned:   "100.00",
+			wantWrittenOff: "600.00",
+			wantStatus:     model.TransactionStatusPartiallyRefunded,
+			wantPlanStatus: model.InstallmentPlanStatusCancelled,
+		},
+		{
+			name:           "whole order",
+			amount:         "900.00",
+			wantReturned:   "300.00",
+			wantWrittenOff: "600.00",
+			wantStatus:     model.TransactionStatusRefunded,
+			wantPlanStatus: model.InstallmentPlanStatusCancelled,
+		},
+		{
+			name:    "more than the order",
+			amount:  "900.01",
+			wantErr: model.ErrRefundExceedsCaptured,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+			transaction := payInInstallments(t, s, "900.00")
+
+			amount := mustMoney(t, tt.amount)
+			refund, err := s.RefundPayment(ctx, model.RefundInfo{TransactionUUID: transaction.UUID, Amount: &amount})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RefundPayment() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if refund.Amount.Cmp(mustMoney(t, tt.wantReturned)) != 0 || refund.WrittenOffAmount.Cmp(mustMoney(t, tt.wantWrittenOff)) != 0 {
+				t.Fatalf("refund returned %s, written off %s, want %s and %s",
+					refund.Amount, refund.WrittenOffAmount, tt.wantReturned, tt.wantWrittenOff)
+			}
+
+			transaction, err = s.GetTransaction(ctx, transaction.UUID)
+			if err != nil {
+				t.Fatalf("GetTransaction() error = %v", err)
+			}
+			if transaction.Status != tt.wantStatus {
+				t.Fatalf("transaction status = %v, want %v", transaction.Status, tt.wantStatus)
+			}
+			if transaction.RefundedAmount.Cmp(refund.Amount) != 0 {
+				t.Fatalf("RefundedAmount = %s, want %s", transaction.RefundedAmount, refund.Amount)
+			}
+
+			plan := installmentPlan(t, s, transaction.UUID)
+			if plan.Status != tt.wantPlanStatus {
+				t.Fatalf("plan status = %v, want %v", plan.Status, tt.wantPlanStatus)
+			}
+			if tt.wantUnpaid != nil {
+				var unpaid []model.Installment
+				for _, i := range plan.Installments {
+					if i.Status != model.InstallmentStatusPaid {
+						unpaid = append(unpaid, i)
+					}
+				}
+				if len(unpaid) != len(tt.wantUnpaid) {
+					t.Fatalf("unpaid installments = %d, want %d", len(unpaid), len(tt.wantUnpaid))
+				}
+				for k, i := range unpaid {
+					if i.Amount.Cmp(mustMoney(t, tt.wantUnpaid[k])) != 0 {
+						t.Fatalf("installment %d amount = %s, want %s", i.Number, i.Amount, tt.wantUnpaid[k])
+					}
+				}
+			}
+			checkLedger(t, s)
+		})
+	}
+}
+
+func TestInstallmentInterest(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	s.config.InstallmentRates = map[int]int64{3: 2400}
+	s.config.FeeBasisPoints = 250
+
+	transaction := payInInstallments(t, s, "900.00")
+	plan := installmentPlan(t, s, transaction.UUID)
+	if !plan.TotalInterest.IsPositive() {
+		t.Fatalf("plan total interest = %s, want positive", plan.TotalInterest)
+	}
+	s.chargeDueInstallments(ctx, time.Now().AddDate(0, 3, 0))
+	checkLedger(t, s)
+
+	// Покупатель заплатил весь график, магазин получил заказ, счёт процентов — проценты.
+	plan = installmentPlan(t, s, transaction.UUID)
+	charged := mustMoney(t, "0")
+	for _, installment := range plan.Installments {
+		charged = charged.Add(installment.Amount)
+	}
+	balances := map[model.LedgerAccountType]model.AccountBalance{}
+	all, err := s.ListAccountBalances(ctx, model.AccountBalancesFilter{})
+	if err != nil {
+		t.Fatalf("ListAccountBalances() error = %v", err)
+	}
+	for _, balance := range all {
+		balances[balance.Account.Type] = balance
+	}
+	if got := balances[model.LedgerAccountTypeCustomer].Debits; got.Cmp(charged) != 0 {
+		t.Fatalf("customer debits = %s, want %s", got, charged)
+	}
+	if got := balances[model.LedgerAccountTypeInterest].Credits; got.Cmp(plan.TotalInterest) != 0 {
+		t.Fatalf("interest credits = %s, want %s", got, plan.TotalInterest)
+	}
+	if got, want := balances[model.LedgerAccountTypeMerchant].Credits.Add(balances[model.LedgerAccountTypeFees].Credits), mustMoney(t, "900.00"); got.Cmp(want) != 0 {
+		t.Fatalf("merchant and fee credits = %s, want %s", got, want)
+	}
+
+	// О каждом платеже, списанном планировщиком, подписчики узнают отдельным событием.
+	events, err := s.eventRepository.List(ctx, 0, 100)
+	if err != nil {
+		t.Fatalf("List() events error = %v", err)
+	}
+	var installments []money.Money
+	for _, event := range events {
+		if event.Type == model.PaymentEventTypeInstallmentCharged {
+			installments = append(installments, event.Amount)
+		}
+	}
+	if len(installments) != len(plan.Installments)-1 {
+		t.Fatalf("INSTALLMENT_CHARGED events = %d, want %d", len(installments), len(plan.Installments)-1)
+	}
+	for k, amount := range installments {
+		if want := plan.Installments[k+1].Amount; amount.Cmp(want) != 0 {
+			t.Fatalf("event %d amount = %s, want %s", k+1, amount, want)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// CreateInvestor заводит инвестора с нулевыми балансами в валюте info.CurrencyCode.
+func (s *service) CreateInvestor(ctx context.Context, info model.InvestorInfo) (model.Investor, error) {
+	name := strings.TrimSpace(info.Name)
+	if name == "" {
+		return model.Investor{}, fmt.Errorf("%w: name must not be empty", model.ErrInvalidInvestor)
+	}
+	if !currencyCodeRe.MatchString(info.CurrencyCode) {
+		return model.Investor{}, fmt.Errorf("%w: currency_code must be a three-letter ISO 4217 code", model.ErrInvalidInvestor)
+	}
+
+	users := make([]string, 0, len(info.AuthorizedUserUUIDs))
+	for _, user := range info.AuthorizedUserUUIDs {
+		if err := uuid.Validate(user); err != nil {
+			return model.Investor{}, model.ErrInvalidUUID
+		}
+		if !slices.Contains(users, user) {
+			users = append(users, user)
+		}
+	}
+
+	now := time.Now()
+	zero := money.Money{CurrencyCode: info.CurrencyCode}
+
+	investor := model.Investor{
+		UUID:                uuid.NewString(),
+		Name:                name,
+		Available:           zero,
+		Held:                zero,
+		Spent:               zero,
+		AuthorizedUserUUIDs: users,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	if err := s.investorRepository.Create(ctx, investor); err != nil {
+		return model.Investor{}, err
+	}
+
+	return investor, nil
+}
+
+func (s *service) GetInvestor(ctx context.Context, investorUUID string) (model.Investor, error) {
+	if err := uuid.Validate(investorUUID); err != nil {
+		return model.Investor{}, model.ErrInvalidUUID
+	}
+
+	return s.investorRepository.Get(ctx, investorUUID)
+}
+
+func (s *service) ListInvestors(ctx context.Context) ([]model.Investor, error) {
+	return s.investorRepository.List(ctx)
+}
+
+// TopUpInvestor зачисляет amount на свободный остаток инвестора.
+func (s *service) TopUpInvestor(ctx context.Context, investorUUID string, amount money.Money) (model.Investor, error) {
+	if err := uuid.Validate(investorUUID); err != nil {
+		return model.Investor{}, model.ErrInvalidUUID
+	}
+	if err := validateAmount(amount); err != nil {
+		return model.Investor{}, err
+	}
+
+	return s.investorRepository.Update(ctx, investorUUID, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		if amount.CurrencyCode != investor.Available.CurrencyCode {
+			return nil, fmt.Errorf("%w: currency_code must be %s", model.ErrInvalidAmount, investor.Available.CurrencyCode)
+		}
+
+		now := time.Now()
+		investor.Available = investor.Available.Add(amount)
+		investor.UpdatedAt = now
+
+		return []model.InvestorMovement{{
+			UUID:         uuid.NewString(),
+			InvestorUUID: investor.UUID,
+			Kind:         model.InvestorMovementKindTopUp,
+			Amount:       amount,
+			CreatedAt:    now,
+		}}, nil
+	})
+}
+
+// GrantInvestorAccess разрешает пользователю оплачивать заказы средствами инвестора.
+func (s *service) GrantInvestorAccess(ctx context.Context, investorUUID, userUUID string) (model.Investor, error) {
+	return s.updateInvestorAccess(ctx, investorUUID, userUUID, func(users []string) []string {
+		if slices.Contains(users, userUUID) {
+			return users
+		}
+		return append(users, userUUID)
+	})
+}
+
+// RevokeInvestorAccess запрещает пользователю новые оплаты средствами инвестора.
+// Уже удержанные суммы остаются в силе.
+func (s *service) RevokeInvestorAccess(ctx context.Context, investorUUID, userUUID string) (model.Investor, error) {
+	return s.updateInvestorAccess(ctx, investorUUID, userUUID, func(users []string) []string {
+		return slices.DeleteFunc(users, func(user string) bool { return user == userUUID })
+	})
+}
+
+func (s *service) updateInvestorAccess(
+	ctx context.Context,
+	investorUUID, userUUID string,
+	change func(users []string) []string,
+) (model.Investor, error) {
+	if err := uuid.Validate(investorUUID); err != nil {
+		return model.Investor{}, model.ErrInvalidUUID
+	}
+	if err := uuid.Validate(userUUID); err != nil {
+		return model.Investor{}, model.ErrInvalidUUID
+	}
+
+	return s.investorRepository.Update(ctx, investorUUID, func(investor *model.Investor) ([]model.InvestorMovement, error) {
+		investor.AuthorizedUserUUIDs = change(investor.AuthorizedUserUUIDs)
+		investor.UpdatedAt = time.Now()
+		return nil, nil
+	})
+}
+
+// ListInvestorMovements возвращает движения средств инвестора в порядке проведения.
+func (s *service) ListInvestorMovements(ctx context.Context, investorUUID string) ([]model.InvestorMovement, error) {
+	if err := uuid.Validate(investorUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+	if _, err := s.investorRepository.Get(ctx, investorUUID); err != nil {
+		return nil, err
+	}
+
+	return s.investorRepository.ListMovements(ctx, investorUUID)
+}
+
+// chooseInvestor выбирает инвестора для оплаты, если клиент его не указал: первого
+// разрешившего пользователю траты в валюте оплаты, у которого хватает средств, иначе
+// первого разрешившего. Если такого нет, возвращается пустая строка и провайдер отклонит оплату.
+func (s *service) chooseInvestor(ctx context.Context, info model.PayOrderInfo) (string, error) {
+	investors, err := s.investorRepository.List(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	fallback := ""
+	for _, investor := range investors {
+		if !slices.Contains(investor.AuthorizedUserUUIDs, info.UserUUID) {
+			continue
+		}
+		if investor.Available.CurrencyCode == info.Amount.CurrencyCode && investor.Available.Cmp(info.Amount) >= 0 {
+			return investor.UUID, nil
+		}
+		if fallback == "" {
+			fallback = investor.UUID
+		}
+	}
+
+	return fallback, nil
+}
@@ -0,0 +1,336 @@
+package payment
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// captureEntry описывает списание основного долга principal и процентов interest:
+// дебет счёта покупателя на всю списанную сумму, кредит счёта комиссий на комиссию
+// провайдера с неё, кредит счёта процентов и кредит магазина на остаток основного долга.
+// В рассрочку так проводится каждый внесённый платёж, без рассрочки interest нулевой.
+func (s *service) captureEntry(transaction model.Transaction, principal, interest money.Money) model.JournalEntry {
+	charged := principal.Add(interest)
+	fee := charged.MulBasisPoints(s.config.FeeBasisPoints)
+
+	return newEntry(model.JournalEntryKindCapture, transaction.UUID,
+		debit(model.CustomerAccount(transaction.UserUUID), charged),
+		credit(model.MerchantAccount, principal.Sub(fee)),
+		credit(model.FeesAccount, fee),
+		credit(model.InterestAccount, interest),
+	)
+}
+
+// refundEntry описывает возврат: дебет счёта возвратов и кредит счёта покупателя.
+// Комиссия провайдера при возврате не возвращается.
+func refundEntry(transaction model.Transaction, refund model.Refund) model.JournalEntry {
+	entry := newEntry(model.JournalEntryKindRefund, transaction.UUID,
+		debit(model.RefundsAccount, refund.Amount),
+		credit(model.CustomerAccount(transaction.UserUUID), refund.Amount),
+	)
+	entry.RefundUUID = refund.UUID
+	return entry
+}
+
+// chargebackEntry описывает проигранный спор: дебет счёта споров и кредит счёта покупателя.
+// Как и при возврате, комиссия провайдера не возвращается.
+func chargebackEntry(transaction model.Transaction, dispute model.Dispute) model.JournalEntry {
+	entry := newEntry(model.JournalEntryKindChargeback, transaction.UUID,
+		debit(model.ChargebacksAccount, dispute.Amount),
+		credit(model.CustomerAccount(transaction.UserUUID), dispute.Amount),
+	)
+	entry.DisputeUUID = dispute.UUID
+	return entry
+}
+
+// reversalEntry сторнирует запись: те же суммы по тем же счетам с обратными сторонами.
+func reversalEntry(entry model.JournalEntry) model.JournalEntry {
+	postings := make([]model.Posting, 0, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		reversed := posting
+		reversed.Direction = model.PostingDirectionCredit
+		if posting.Direction == model.PostingDirectionCredit {
+			reversed.Direction = model.PostingDirectionDebit
+		}
+		postings = append(postings, reversed)
+	}
+
+	reversal := newEntry(model.JournalEntryKindReversal, entry.TransactionUUID, postings...)
+	reversal.RefundUUID = entry.RefundUUID
+	reversal.DisputeUUID = entry.DisputeUUID
+	reversal.ReversesEntryUUID = entry.UUID
+	return reversal
+}
+
+// newEntry создаёт запись журнала, пропуская проводки с нулевой суммой.
+func newEntry(kind model.JournalEntryKind, transactionUUID string, postings ...model.Posting) model.JournalEntry {
+	return model.JournalEntry{
+		UUID:            uuid.NewString(),
+		Kind:            kind,
+		TransactionUUID: transactionUUID,
+		Postings: slices.DeleteFunc(postings, func(p model.Posting) bool {
+			return p.Amount.IsZero()
+		}),
+		CreatedAt: time.Now(),
+	}
+}
+
+func debit(account model.LedgerAccount, amount money.Money) model.Posting {
+	return model.Posting{Account: account, Direction: model.PostingDirectionDebit, Amount: amount}
+}
+
+func credit(account model.LedgerAccount, amount money.Money) model.Posting {
+	return model.Posting{Account: account, Direction: model.PostingDirectionCredit, Amount: amount}
+}
+
+// post записывает в журнал только сбалансированные записи.
+func (s *service) post(ctx context.Context, entry model.JournalEntry) error {
+	if err := checkEntry(entry); err != nil {
+		return err
+	}
+	return s.ledgerRepository.Append(ctx, entry)
+}
+
+// reverse сторнирует запись, проведённую для операции, которая затем не удалась.
+func (s *service) reverse(ctx context.Context, entry model.JournalEntry) {
+	if err := s.post(ctx, reversalEntry(entry)); err != nil {
+		log.Printf("failed to reverse journal entry %s: %v", entry.UUID, err)
+	}
+}
+
+// checkEntry проверяет, что проводки записи положительны, в одной валюте и дебет равен кредиту.
+func checkEntry(entry model.JournalEntry) error {
+	if len(entry.Postings) < 2 {
+		return fmt.Errorf("%w: entry must have at least two postings", model.ErrUnbalancedEntry)
+	}
+
+	currency := entry.Postings[0].Amount.CurrencyCode
+	debits := money.Money{CurrencyCode: currency}
+	credits := money.Money{CurrencyCode: currency}
+	for _, posting := range entry.Postings {
+		if posting.Amount.CurrencyCode != currency {
+			return fmt.Errorf("%w: postings in %s and %s", model.ErrUnbalancedEntry, currency, posting.Amount.CurrencyCode)
+		}
+		if !posting.Amount.IsPositive() {
+			return fmt.Errorf("%w: posting amount %s is not positive", model.ErrUnbalancedEntry, posting.Amount)
+		}
+
+		switch posting.Direction {
+		case model.PostingDirectionDebit:
+			debits = debits.Add(posting.Amount)
+		case model.PostingDirectionCredit:
+			credits = credits.Add(posting.Amount)
+		default:
+			return fmt.Errorf("%w: posting has no direction", model.ErrUnbalancedEntry)
+		}
+	}
+
+	if debits.Cmp(credits) != 0 {
+		return fmt.Errorf("%w: debits %s, credits %s", model.ErrUnbalancedEntry, debits, credits)
+	}
+
+	return nil
+}
+
+// entryTotal возвращает сумму дебетов записи, для сбалансированной записи она равна сумме кредитов.
+func entryTotal(entry model.JournalEntry) money.Money {
+	var total money.Money
+	for _, posting := range entry.Postings {
+		if posting.Direction == model.PostingDirectionDebit {
+			total = posting.Amount.Add(total)
+		}
+	}
+	return total
+}
+
+// accountTotal возвращает сумму проводок записи по счёту account в любом направлении.
+func accountTotal(entry model.JournalEntry, account model.LedgerAccount) money.Money {
+	var total money.Money
+	for _, posting := range entry.Postings {
+		if posting.Account == account {
+			total = posting.Amount.Add(total)
+		}
+	}
+	return total
+}
+
+// ListAccountBalances возвращает обороты и сальдо счетов, по одной строке на счёт и валюту.
+func (s *service) ListAccountBalances(ctx context.Context, filter model.AccountBalancesFilter) ([]model.AccountBalance, error) {
+	if filter.OwnerUUID != "" {
+		if err := uuid.Validate(filter.OwnerUUID); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+	}
+
+	entries, err := s.ledgerRepository.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	type balanceKey struct {
+		account  model.LedgerAccount
+		currency string
+	}
+	balances := make(map[balanceKey]*model.AccountBalance)
+
+	for _, entry := range entries {
+		for _, posting := range entry.Postings {
+			if filter.AccountType != model.LedgerAccountTypeUnspecified && posting.Account.Type != filter.AccountType {
+				continue
+			}
+			if filter.OwnerUUID != "" && posting.Account.OwnerUUID != filter.OwnerUUID {
+				continue
+			}
+
+			currency := posting.Amount.CurrencyCode
+			key := balanceKey{account: posting.Account, currency: currency}
+			balance, ok := balances[key]
+			if !ok {
+				zero := money.Money{CurrencyCode: currency}
+				balance = &model.AccountBalance{Account: posting.Account, Debits: zero, Credits: zero}
+				balances[key] = balance
+			}
+
+			if posting.Direction == model.PostingDirectionDebit {
+				balance.Debits = balance.Debits.Add(posting.Amount)
+			} else {
+				balance.Credits = balance.Credits.Add(posting.Amount)
+			}
+		}
+	}
+
+	result := make([]model.AccountBalance, 0, len(balances))
+	for _, balance := range balances {
+		balance.Balance = balance.Debits.Sub(balance.Credits)
+		result = append(result, *balance)
+	}
+	slices.SortFunc(result, func(a, b model.AccountBalance) int {
+		return cmp.Or(
+			cmp.Compare(a.Account.Type, b.Account.Type),
+			cmp.Compare(a.Account.OwnerUUID, b.Account.OwnerUUID),
+			cmp.Compare(a.Debits.CurrencyCode, b.Debits.CurrencyCode),
+		)
+	})
+
+	return result, nil
+}
+
+// ListJournalEntries возвращает записи журнала. Пустой transactionUUID означает все записи.
+func (s *service) ListJournalEntries(ctx context.Context, transactionUUID string) ([]model.JournalEntry, error) {
+	if transactionUUID != "" {
+		if err := uuid.Validate(transactionUUID); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+	}
+
+	return s.ledgerRepository.List(ctx, transactionUUID)
+}
+
+// CheckLedger сверяет книгу: каждая запись сбалансирована, оборотная ведомость по каждой
+// валюте сходится к нулю, а собранные, возвращённые и опротестованные по записям суммы
+// совпадают с транзакциями.
+func (s *service) CheckLedger(ctx context.Context) (model.LedgerReport, error) {
+	entries, err := s.ledgerRepository.List(ctx, "")
+	if err != nil {
+		return model.LedgerReport{}, err
+	}
+	transactions, err := s.transactionRepository.List(ctx, model.TransactionsFilter{})
+	if err != nil {
+		return model.LedgerReport{}, err
+	}
+
+	report := model.LedgerReport{EntriesChecked: len(entries)}
+	violate := func(entryUUID, transactionUUID, format string, args ...any) {
+		report.Violations = append(report.Violations, model.LedgerViolation{
+			EntryUUID:       entryUUID,
+			TransactionUUID: transactionUUID,
+			Description:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	byUUID := make(map[string]model.JournalEntry, len(entries))
+	for _, entry := range entries {
+		byUUID[entry.UUID] = entry
+	}
+
+	// trial — сальдо всей книги по валютам, captured, refunded и chargedBack — суммы по транзакциям.
+	trial := make(map[string]money.Money)
+	captured := make(map[string]money.Money)
+	refunded := make(map[string]money.Money)
+	chargedBack := make(map[string]money.Money)
+
+	for _, entry := range entries {
+		if err := checkEntry(entry); err != nil {
+			violate(entry.UUID, entry.TransactionUUID, "%v", err)
+			continue
+		}
+
+		for _, posting := range entry.Postings {
+			currency := posting.Amount.CurrencyCode
+			if posting.Direction == model.PostingDirectionDebit {
+				trial[currency] = posting.Amount.Add(trial[currency])
+			} else {
+				trial[currency] = trial[currency].Sub(posting.Amount)
+			}
+		}
+
+		// Проценты по рассрочке — доход сверх суммы заказа, в собранную сумму они не входят.
+		kind, total := entry.Kind, entryTotal(entry).Sub(accountTotal(entry, model.InterestAccount))
+		if kind == model.JournalEntryKindReversal {
+			reversed, ok := byUUID[entry.ReversesEntryUUID]
+			if !ok {
+				violate(entry.UUID, entry.TransactionUUID, "reverses unknown entry %q", entry.ReversesEntryUUID)
+				continue
+			}
+			kind, total = reversed.Kind, money.Money{CurrencyCode: total.CurrencyCode}.Sub(total)
+		}
+
+		switch kind {
+		case model.JournalEntryKindCapture:
+			captured[entry.TransactionUUID] = total.Add(captured[entry.TransactionUUID])
+		case model.JournalEntryKindRefund:
+			refunded[entry.TransactionUUID] = total.Add(refunded[entry.TransactionUUID])
+		case model.JournalEntryKindChargeback:
+			chargedBack[entry.TransactionUUID] = total.Add(chargedBack[entry.TransactionUUID])
+		}
+	}
+
+	for _, currency := range slices.Sorted(maps.Keys(trial)) {
+		if balance := trial[currency]; !balance.IsZero() {
+			violate("", "", "trial balance in %s is off by %s", currency, balance)
+		}
+	}
+
+	known := make(map[string]struct{}, len(transactions))
+	for _, transaction := range transactions {
+		known[transaction.UUID] = struct{}{}
+
+		zero := money.Money{CurrencyCode: transaction.Amount.CurrencyCode}
+		if got := zero.Add(captured[transaction.UUID]); got.Cmp(transaction.CollectedAmount) != 0 {
+			violate("", transaction.UUID, "collected amount %s does not match journal %s", transaction.CollectedAmount, got)
+		}
+		if got := zero.Add(refunded[transaction.UUID]); got.Cmp(transaction.RefundedAmount) != 0 {
+			violate("", transaction.UUID, "refunded amount %s does not match journal %s", transaction.RefundedAmount, got)
+		}
+		if got := zero.Add(chargedBack[transaction.UUID]); got.Cmp(transaction.ChargedBackAmount) != 0 {
+			violate("", transaction.UUID, "charged back amount %s does not match journal %s", transaction.ChargedBackAmount, got)
+		}
+	}
+	for _, entry := range entries {
+		if _, ok := known[entry.TransactionUUID]; !ok {
+			violate(entry.UUID, entry.TransactionUUID, "entry references unknown transaction")
+		}
+	}
+
+	return report, nil
+}
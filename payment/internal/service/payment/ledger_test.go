@@ -0,0 +1,214 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func TestCheckEntry(t *testing.T) {
+	customer := model.CustomerAccount("0d9e8f7a-6b5c-4d3e-8f1a-2b3c4d5e6f70")
+
+	tests := []struct {
+		name     string
+		postings []model.Posting
+		wantErr  error
+	}{
+		{
+			name: "balanced",
+			postings: []model.Posting{
+				debit(customer, mustMoney(t, "100.00")),
+				credit(model.MerchantAccount, mustMoney(t, "97.50")),
+				credit(model.FeesAccount, mustMoney(t, "2.50")),
+			},
+		},
+		{
+			name: "debits exceed credits",
+			postings: []model.Posting{
+				debit(customer, mustMoney(t, "100.00")),
+				credit(model.MerchantAccount, mustMoney(t, "99.99")),
+			},
+			wantErr: model.ErrUnbalancedEntry,
+		},
+		{
+			name:     "single posting",
+			postings: []model.Posting{debit(customer, mustMoney(t, "100.00"))},
+			wantErr:  model.ErrUnbalancedEntry,
+		},
+		{
+			name: "mixed currencies",
+			postings: []model.Posting{
+				debit(customer, mustMoney(t, "100.00")),
+				credit(model.MerchantAccount, money.Money{CurrencyCode: "USD", Units: 100}),
+			},
+			wantErr: model.ErrUnbalancedEntry,
+		},
+		{
+			name: "negative amount",
+			postings: []model.Posting{
+				debit(customer, mustMoney(t, "-100.00")),
+				credit(model.MerchantAccount, mustMoney(t, "-100.00")),
+			},
+			wantErr: model.ErrUnbalancedEntry,
+		},
+		{
+			name: "posting without direction",
+			postings: []model.Posting{
+				debit(customer, mustMoney(t, "100.00")),
+				{Account: model.MerchantAccount, Amount: mustMoney(t, "100.00")},
+			},
+			wantErr: model.ErrUnbalancedEntry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Запись собирается без newEntry, чтобы проверить и проводки, которые он бы отбросил.
+			entry := model.JournalEntry{Kind: model.JournalEntryKindCapture, Postings: tt.postings}
+			if err := checkEntry(entry); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkEntry() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if err := checkEntry(reversalEntry(entry)); err != nil {
+				t.Fatalf("checkEntry(reversal) error = %v", err)
+			}
+		})
+	}
+}
+
+func TestLedgerBalance(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s *service, transactionUUID string)
+	}{
+		{
+			name: "capture",
+			run:  func(*testing.T, *service, string) {},
+		},
+		{
+			name: "partial refund",
+			run: func(t *testing.T, s *service, transactionUUID string) {
+				amount := mustMoney(t, "100.00")
+				if _, err := s.RefundPayment(context.Background(), model.RefundInfo{TransactionUUID: transactionUUID, Amount: &amount}); err != nil {
+					t.Fatalf("RefundPayment() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "full refund",
+			run: func(t *testing.T, s *service, transactionUUID string) {
+				if _, err := s.RefundPayment(context.Background(), model.RefundInfo{TransactionUUID: transactionUUID}); err != nil {
+					t.Fatalf("RefundPayment() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "lost dispute",
+			run: func(t *testing.T, s *service, transactionUUID string) {
+				loseDispute(t, s, transactionUUID, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+			s.config.FeeBasisPoints = 250
+
+			transaction, err := s.PayOrder(ctx, payOrderInfo(t, "333.33"))
+			if err != nil {
+				t.Fatalf("PayOrder() error = %v", err)
+			}
+			tt.run(t, s, transaction.UUID)
+
+			entries, err := s.ListJournalEntries(ctx, transaction.UUID)
+			if err != nil {
+				t.Fatalf("ListJournalEntries() error = %v", err)
+			}
+			for _, entry := range entries {
+				if err := checkEntry(entry); err != nil {
+					t.Fatalf("entry %s (%v): %v", entry.UUID, entry.Kind, err)
+				}
+			}
+
+			// Оборотная ведомость сходится к нулю: сумма сальдо всех счетов равна нулю.
+			balances, err := s.ListAccountBalances(ctx, model.AccountBalancesFilter{})
+			if err != nil {
+				t.Fatalf("ListAccountBalances() error = %v", err)
+			}
+			total := money.Zero("RUB")
+			for _, balance := range balances {
+				total = total.Add(balance.Balance)
+			}
+			if !total.IsZero() {
+				t.Fatalf("trial balance is off by %s", total)
+			}
+			checkLedger(t, s)
+		})
+	}
+}
+
+func TestCheckLedgerViolations(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(t *testing.T, s *service, transaction model.Transaction)
+	}{
+		{
+			name: "refunded amount without entry",
+			tamper: func(t *testing.T, s *service, transaction model.Transaction) {
+				transaction.RefundedAmount = mustMoney(t, "10.00")
+				if err := s.transactionRepository.Update(context.Background(), transaction); err != nil {
+					t.Fatalf("Update() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "unbalanced entry",
+			tamper: func(t *testing.T, s *service, transaction model.Transaction) {
+				entry := newEntry(model.JournalEntryKindRefund, transaction.UUID,
+					debit(model.RefundsAccount, mustMoney(t, "10.00")),
+					credit(model.CustomerAccount(transaction.UserUUID), mustMoney(t, "9.00")),
+				)
+				if err := s.ledgerRepository.Append(context.Background(), entry); err != nil {
+					t.Fatalf("Append() error = %v", err)
+				}
+			},
+		},
+		{
+			name: "collected amount without entry",
+			tamper: func(t *testing.T, s *service, transaction model.Transaction) {
+				transaction.CollectedAmount = transaction.CollectedAmount.Add(mustMoney(t, "1.00"))
+				if err := s.transactionRepository.Update(context.Background(), transaction); err != nil {
+					t.Fatalf("Update() error = %v", err)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+
+			transaction, err := s.PayOrder(ctx, payOrderInfo(t, "450.00"))
+			if err != nil {
+				t.Fatalf("PayOrder() error = %v", err)
+			}
+			tt.tamper(t, s, transaction)
+
+			report, err := s.CheckLedger(ctx)
+			if err != nil {
+				t.Fatalf("CheckLedger() error = %v", err)
+			}
+			if len(report.Violations) == 0 {
+				t.Fatal("CheckLedger() found no violations")
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func (s *service) ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error) {
+	return s.transactionRepository.List(ctx, filter)
+}
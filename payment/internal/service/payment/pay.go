@@ -0,0 +1,72 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// PayOrder проводит оплату в один шаг: авторизует сумму и сразу её списывает.
+func (s *service) PayOrder(ctx context.Context, info model.PayOrderInfo) (model.Transaction, error) {
+	if err := s.validatePayOrderInfo(info); err != nil {
+		return model.Transaction{}, err
+	}
+
+	return s.idempotent(ctx, operationPay, info, func(ctx context.Context) (model.Transaction, error) {
+		// Оплата СБП не проходит сразу: покупатель платит по QR-коду, а транзакция ждёт подтверждения.
+		if info.PaymentMethod == model.PaymentMethodSBP {
+			return s.createSBPIntent(ctx, info)
+		}
+
+		transaction, err := s.authorize(ctx, info)
+		if err != nil {
+			return model.Transaction{}, err
+		}
+
+		captured, err := s.capture(ctx, transaction.UUID, nil)
+		if err != nil {
+			// Оплата в один шаг не оставляет висящих удержаний: если списать не удалось, снимаем его.
+			if errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrProviderUnavailable) {
+				if _, voidErr := s.VoidAuthorization(ctx, transaction.UUID); voidErr != nil {
+					log.Printf("failed to void authorization %s after capture failure: %v", transaction.UUID, voidErr)
+				}
+			}
+			return model.Transaction{}, err
+		}
+
+		return captured, nil
+	})
+}
+
+func (s *service) validatePayOrderInfo(info model.PayOrderInfo) error {
+	if !isSupportedPaymentMethod(info.PaymentMethod) {
+		return model.ErrPaymentMethodNotSupported
+	}
+	if info.PaymentToken != "" && info.PaymentMethod != model.PaymentMethodCard {
+		return model.ErrPaymentTokenNotAllowed
+	}
+	if err := validateAmount(info.Amount); err != nil {
+		return err
+	}
+	if err := s.validateSettlementCurrency(info.Amount.CurrencyCode); err != nil {
+		return err
+	}
+	if err := validateLineItems(info.LineItems, info.Amount); err != nil {
+		return err
+	}
+	return s.validateInstallmentTerm(info)
+}
+
+func isSupportedPaymentMethod(method model.PaymentMethod) bool {
+	switch method {
+	case model.PaymentMethodCard,
+		model.PaymentMethodSBP,
+		model.PaymentMethodCreditCard,
+		model.PaymentMethodInvestorMoney:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,43 @@
+package payment
+
+import (
+	"context"
+	"log"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// provider возвращает адаптер, зарегистрированный для способа оплаты.
+func (s *service) provider(method model.PaymentMethod) (provider.Provider, error) {
+	p, ok := s.providers[method]
+	if !ok {
+		return nil, model.ErrPaymentMethodNotSupported
+	}
+	return p, nil
+}
+
+// providerRequest описывает для провайдера операцию над транзакцией на сумму amount.
+func providerRequest(operation model.ProviderOperation, transaction model.Transaction, amount money.Money) model.ProviderRequest {
+	return model.ProviderRequest{
+		Operation:        operation,
+		TransactionUUID:  transaction.UUID,
+		OrderUUID:        transaction.OrderUUID,
+		UserUUID:         transaction.UserUUID,
+		PaymentMethod:    transaction.PaymentMethod,
+		Amount:           amount,
+		AuthorizedAmount: transaction.AuthorizedAmount,
+		InvestorUUID:     transaction.InvestorUUID,
+	}
+}
+
+// releaseHold снимает у провайдера удержание, которое не удалось сохранить в транзакции:
+// без записи о нём удержание не снимут ни отмена, ни истечение авторизации.
+func (s *service) releaseHold(ctx context.Context, p provider.Provider, transaction model.Transaction) {
+	if err := p.Void(ctx, providerRequest(model.ProviderOperationVoid, transaction, transaction.AuthorizedAmount)); err != nil {
+		log.Printf("failed to void unsaved authorization of transaction %s: %v", transaction.UUID, err)
+		return
+	}
+	log.Printf("Удержание снято: авторизацию не удалось сохранить, transaction_uuid: %s", transaction.UUID)
+}
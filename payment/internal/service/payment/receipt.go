@@ -0,0 +1,168 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/receipt"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func (s *service) GetReceipt(ctx context.Context, transactionUUID string) (model.Receipt, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.Receipt{}, model.ErrInvalidUUID
+	}
+
+	issued, err := s.receiptRepository.GetByTransaction(ctx, transactionUUID)
+	if !errors.Is(err, model.ErrReceiptNotFound) {
+		return issued, err
+	}
+
+	// Чека нет, если сумму списали до появления чеков или выписать его при списании
+	// не удалось. Для списанной транзакции он выписывается сейчас.
+	transaction, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err != nil {
+		return model.Receipt{}, err
+	}
+	if !isCaptured(transaction.Status) {
+		return model.Receipt{}, model.ErrReceiptNotFound
+	}
+
+	return s.createReceipt(ctx, transaction)
+}
+
+// issueReceipt выписывает чек после списания. Оплата к этому моменту проведена,
+// поэтому ошибка только пишется в лог: чек будет выписан при первом запросе.
+func (s *service) issueReceipt(ctx context.Context, transaction model.Transaction) {
+	if _, err := s.createReceipt(ctx, transaction); err != nil {
+		log.Printf("failed to issue receipt for transaction %s: %v", transaction.UUID, err)
+	}
+}
+
+func (s *service) createReceipt(ctx context.Context, transaction model.Transaction) (model.Receipt, error) {
+	items := receiptItems(transaction, s.config.ReceiptVATBasisPoints)
+	taxTotal := money.Zero(transaction.Amount.CurrencyCode)
+	for _, item := range items {
+		taxTotal = taxTotal.Add(item.Tax)
+	}
+
+	issued := model.Receipt{
+		UUID:                  uuid.NewString(),
+		TransactionUUID:       transaction.UUID,
+		OrderUUID:             transaction.OrderUUID,
+		UserUUID:              transaction.UserUUID,
+		PaymentMethod:         transaction.PaymentMethod,
+		MaskedCardNumber:      transaction.MaskedCardNumber,
+		InstallmentTermMonths: transaction.InstallmentTermMonths,
+		Items:                 items,
+		Total:                 transaction.CollectedAmount,
+		CreditAmount:          transaction.Amount.Sub(transaction.CollectedAmount),
+		TaxTotal:              taxTotal,
+		PaidAt:                transaction.CapturedAt,
+		CreatedAt:             time.Now(),
+	}
+
+	var err error
+	issued.HTML, issued.Text, err = receipt.Render(issued, s.config.ReceiptSeller)
+	if err != nil {
+		return model.Receipt{}, err
+	}
+
+	if err := s.receiptRepository.Create(ctx, issued); err != nil {
+		// Чек уже выписал параллельный запрос.
+		if errors.Is(err, model.ErrReceiptAlreadyExists) {
+			return s.receiptRepository.GetByTransaction(ctx, transaction.UUID)
+		}
+		return model.Receipt{}, err
+	}
+
+	log.Printf("Выписан чек, receipt_uuid: %s, transaction_uuid: %s", issued.UUID, transaction.UUID)
+	return issued, nil
+}
+
+// receiptItems возвращает строки чека. Строки заказа печатаются, если их сумма равна
+// списанной; при частичном списании или без строк чек содержит одну строку на всю сумму.
+func receiptItems(transaction model.Transaction, vatBasisPoints int64) []model.ReceiptItem {
+	lines := transaction.LineItems
+	if total, err := lineItemsTotal(lines, transaction.Amount.CurrencyCode); err != nil || len(lines) == 0 || total.Cmp(transaction.Amount) != 0 {
+		lines = []model.LineItem{{
+			Name:      "Оплата заказа " + transaction.OrderUUID,
+			Quantity:  1,
+			UnitPrice: transaction.Amount,
+		}}
+	}
+
+	items := make([]model.ReceiptItem, 0, len(lines))
+	for _, line := range lines {
+		amount := lineItemAmount(line)
+		items = append(items, model.ReceiptItem{
+			SKU:                line.SKU,
+			Name:               line.Name,
+			Quantity:           line.Quantity,
+			UnitPrice:          line.UnitPrice,
+			Amount:             amount,
+			TaxRateBasisPoints: vatBasisPoints,
+			Tax:                includedTax(amount, vatBasisPoints),
+		})
+	}
+	return items
+}
+
+// includedTax выделяет налог, включённый в сумму: amount·rate/(100% + rate),
+// округлённый до копеек.
+func includedTax(amount money.Money, bps int64) money.Money {
+	const basisPointsPerUnit = 10_000
+
+	tax := new(big.Rat).Mul(amount.Rat(), big.NewRat(bps, basisPointsPerUnit+bps))
+	return money.FromRat(amount.CurrencyCode, tax).Round()
+}
+
+func lineItemAmount(item model.LineItem) money.Money {
+	total := item.UnitPrice.TotalNanos()
+	total.Mul(total, big.NewInt(int64(item.Quantity)))
+	return money.FromNanos(item.UnitPrice.CurrencyCode, total)
+}
+
+func lineItemsTotal(items []model.LineItem, currency string) (money.Money, error) {
+	amounts := make([]money.Money, 0, len(items))
+	for _, item := range items {
+		amounts = append(amounts, lineItemAmount(item))
+	}
+	return money.Sum(currency, amounts...)
+}
+
+// validateLineItems проверяет строки заказа: их сумма должна совпадать с суммой оплаты.
+// Строки необязательны, без них чек содержит одну строку на всю сумму.
+func validateLineItems(items []model.LineItem, amount money.Money) error {
+	for i, item := range items {
+		switch {
+		case item.Name == "":
+			return fmt.Errorf("%w: line %d has no name", model.ErrInvalidLineItems, i+1)
+		case item.Quantity <= 0:
+			return fmt.Errorf("%w: line %d quantity must be positive", model.ErrInvalidLineItems, i+1)
+		case item.UnitPrice.CurrencyCode != amount.CurrencyCode:
+			return fmt.Errorf("%w: line %d must be priced in %s", model.ErrInvalidLineItems, i+1, amount.CurrencyCode)
+		case item.UnitPrice.IsNegative():
+			return fmt.Errorf("%w: line %d unit price must not be negative", model.ErrInvalidLineItems, i+1)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	total, err := lineItemsTotal(items, amount.CurrencyCode)
+	if err != nil {
+		return fmt.Errorf("%w: %v", model.ErrInvalidLineItems, err)
+	}
+	if total.Cmp(amount) != 0 {
+		return fmt.Errorf("%w: lines total %s, amount is %s", model.ErrInvalidLineItems, total.Decimal(), amount.Decimal())
+	}
+	return nil
+}
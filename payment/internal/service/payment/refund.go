@@ -0,0 +1,184 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+func (s *service) RefundPayment(ctx context.Context, info model.RefundInfo) (model.Refund, error) {
+	if err := uuid.Validate(info.TransactionUUID); err != nil {
+		return model.Refund{}, model.ErrInvalidUUID
+	}
+
+	// Возвраты одной транзакции проводятся последовательно, иначе два параллельных
+	// возврата могут вместе превысить списанную сумму.
+	unlock := s.transactionLocks.lock(info.TransactionUUID)
+	defer unlock()
+
+	transaction, err := s.transactionRepository.Get(ctx, info.TransactionUUID)
+	if err != nil {
+		return model.Refund{}, err
+	}
+
+	if transaction.Status != model.TransactionStatusPaid &&
+		transaction.Status != model.TransactionStatusPartiallyRefunded {
+		return model.Refund{}, model.ErrTransactionNotRefundable
+	}
+	// Спорную сумму вернёт эмитент, если спор будет проигран, поэтому до решения возвраты запрещены.
+	open, err := s.hasOpenDispute(ctx, transaction.UUID)
+	if err != nil {
+		return model.Refund{}, err
+	}
+	if open {
+		return model.Refund{}, model.ErrDisputeAlreadyOpen
+	}
+
+	// Возврат по рассрочке сначала уменьшает невнесённый основной долг: эти деньги
+	// с покупателя ещё не списаны. Перечисляется только то, что превышает долг.
+	var plan model.InstallmentPlan
+	outstanding := money.Zero(transaction.Amount.CurrencyCode)
+	if transaction.InstallmentTermMonths > 0 {
+		plan, err = s.unpaidInstallmentPlan(ctx, transaction.UUID)
+		if err != nil {
+			return model.Refund{}, err
+		}
+		if plan.UUID != "" {
+			outstanding = outstandingPrincipal(plan)
+		}
+	}
+	remaining := disputableAmount(transaction).Add(outstanding)
+
+	amount := remaining
+	if info.Amount != nil {
+		amount = *info.Amount
+		if err := validateAmount(amount); err != nil {
+			return model.Refund{}, err
+		}
+		if amount.CurrencyCode != transaction.Amount.CurrencyCode {
+			return model.Refund{}, fmt.Errorf("%w: currency_code must be %s", model.ErrInvalidAmount, transaction.Amount.CurrencyCode)
+		}
+		if amount.Cmp(remaining) > 0 {
+			return model.Refund{}, model.ErrRefundExceedsCaptured
+		}
+	}
+	if !amount.IsPositive() {
+		return model.Refund{}, model.ErrTransactionNotRefundable
+	}
+
+	writtenOff := amount
+	if writtenOff.Cmp(outstanding) > 0 {
+		writtenOff = outstanding
+	}
+	now := time.Now()
+	refund := model.Refund{
+		UUID:             uuid.NewString(),
+		TransactionUUID:  transaction.UUID,
+		Amount:           amount.Sub(writtenOff),
+		WrittenOffAmount: writtenOff,
+		Reason:           info.Reason,
+		Status:           model.RefundStatusSucceeded,
+		CreatedAt:        now,
+	}
+
+	if refund.Amount.IsPositive() {
+		p, err := s.provider(transaction.PaymentMethod)
+		if err != nil {
+			return model.Refund{}, err
+		}
+		if err := p.Refund(ctx, providerRequest(model.ProviderOperationRefund, transaction, refund.Amount)); err != nil {
+			// Отказ провайдера сохраняется как неуспешный возврат, транзакция не меняется.
+			var decline *model.DeclineError
+			if errors.As(err, &decline) {
+				refund.Status = model.RefundStatusFailed
+				if createErr := s.refundRepository.Create(ctx, refund); createErr != nil {
+					return model.Refund{}, createErr
+				}
+				log.Printf("Провайдер отклонил возврат, refund_uuid: %s, decline_code: %s", refund.UUID, decline.Code)
+			}
+			return model.Refund{}, err
+		}
+	}
+
+	updated := transaction
+	updated.RefundedAmount = refund.Amount.Add(transaction.RefundedAmount)
+	updated.Status = model.TransactionStatusPartiallyRefunded
+	if disputableAmount(updated).IsZero() && writtenOff.Cmp(outstanding) == 0 {
+		updated.Status = model.TransactionStatusRefunded
+	}
+
+	if err := s.transactionRepository.Update(ctx, updated); err != nil {
+		return model.Refund{}, err
+	}
+	// rollback возвращает транзакцию, книгу и план рассрочки к состоянию до возврата.
+	var entry model.JournalEntry
+	reduced := false
+	rollback := func(reason string) {
+		if rollbackErr := s.transactionRepository.Update(ctx, transaction); rollbackErr != nil {
+			log.Printf("failed to roll back transaction %s after %s failure: %v", transaction.UUID, reason, rollbackErr)
+		}
+		if entry.UUID != "" {
+			s.reverse(ctx, entry)
+		}
+		if reduced {
+			if rollbackErr := s.installmentRepository.Update(ctx, plan); rollbackErr != nil {
+				log.Printf("failed to roll back installment plan %s after %s failure: %v", plan.UUID, reason, rollbackErr)
+			}
+		}
+	}
+	if refund.Amount.IsPositive() {
+		pending := refundEntry(transaction, refund)
+		if err := s.post(ctx, pending); err != nil {
+			rollback("ledger")
+			return model.Refund{}, err
+		}
+		entry = pending
+	}
+	if writtenOff.IsPositive() {
+		if err := s.installmentRepository.Update(ctx, reduceInstallmentPlan(plan, writtenOff, now)); err != nil {
+			rollback("installment plan")
+			return model.Refund{}, err
+		}
+		reduced = true
+		log.Printf("Долг по рассрочке уменьшен, plan_uuid: %s, written_off: %s", plan.UUID, writtenOff)
+	}
+	if err := s.refundRepository.Create(ctx, refund); err != nil {
+		// Без записи о возврате транзакция, книга и план не должны меняться.
+		rollback("refund")
+		return model.Refund{}, err
+	}
+
+	log.Printf("Возврат проведён, refund_uuid: %s, transaction_uuid: %s", refund.UUID, transaction.UUID)
+	event := paymentEvent(model.PaymentEventTypeRefunded, updated, refund.Amount)
+	event.RefundUUID = refund.UUID
+	s.publish(ctx, event)
+
+	if updated.Status == model.TransactionStatusRefunded && transaction.InstallmentTermMonths > 0 {
+		s.cancelInstallmentPlan(ctx, transaction.UUID)
+	}
+
+	return refund, nil
+}
+
+func (s *service) GetRefund(ctx context.Context, refundUUID string) (model.Refund, error) {
+	if err := uuid.Validate(refundUUID); err != nil {
+		return model.Refund{}, model.ErrInvalidUUID
+	}
+
+	return s.refundRepository.Get(ctx, refundUUID)
+}
+
+func (s *service) ListRefunds(ctx context.Context, transactionUUID string) ([]model.Refund, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+
+	return s.refundRepository.ListByTransaction(ctx, transactionUUID)
+}
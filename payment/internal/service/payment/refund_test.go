@@ -0,0 +1,127 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// refundStep — возврат или проигранный спор на amount; пустой amount означает весь остаток.
+type refundStep struct {
+	dispute bool
+	amount  string
+	wantErr error
+}
+
+func TestRefundCap(t *testing.T) {
+	tests := []struct {
+		name string
+		// captured — списанная часть авторизации на 450.00, пустая строка означает всю сумму.
+		captured    string
+		steps       []refundStep
+		wantRefunds string
+		wantStatus  model.TransactionStatus
+	}{
+		{
+			name:        "whole remainder",
+			steps:       []refundStep{{}, {amount: "0.01", wantErr: model.ErrTransactionNotRefundable}},
+			wantRefunds: "450.00",
+			wantStatus:  model.TransactionStatusRefunded,
+		},
+		{
+			name:        "partial refunds up to captured",
+			steps:       []refundStep{{amount: "100.00"}, {amount: "350.00"}},
+			wantRefunds: "450.00",
+			wantStatus:  model.TransactionStatusRefunded,
+		},
+		{
+			name:        "partial refund over remainder",
+			steps:       []refundStep{{amount: "300.00"}, {amount: "150.01", wantErr: model.ErrRefundExceedsCaptured}},
+			wantRefunds: "300.00",
+			wantStatus:  model.TransactionStatusPartiallyRefunded,
+		},
+		{
+			name:        "more than captured",
+			steps:       []refundStep{{amount: "450.01", wantErr: model.ErrRefundExceedsCaptured}},
+			wantRefunds: "0",
+			wantStatus:  model.TransactionStatusPaid,
+		},
+		{
+			name:        "partial capture",
+			captured:    "300.00",
+			steps:       []refundStep{{amount: "300.01", wantErr: model.ErrRefundExceedsCaptured}, {}},
+			wantRefunds: "300.00",
+			wantStatus:  model.TransactionStatusRefunded,
+		},
+		{
+			name: "lost dispute",
+			steps: []refundStep{
+				{dispute: true, amount: "200.00"},
+				{amount: "250.01", wantErr: model.ErrRefundExceedsCaptured},
+				{amount: "250.00"},
+			},
+			wantRefunds: "250.00",
+			wantStatus:  model.TransactionStatusRefunded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t)
+
+			transaction, err := s.AuthorizePayment(ctx, payOrderInfo(t, "450.00"))
+			if err != nil {
+				t.Fatalf("AuthorizePayment() error = %v", err)
+			}
+			var captured *money.Money
+			if tt.captured != "" {
+				amount := mustMoney(t, tt.captured)
+				captured = &amount
+			}
+			if _, err := s.CapturePayment(ctx, transaction.UUID, captured); err != nil {
+				t.Fatalf("CapturePayment() error = %v", err)
+			}
+
+			for i, step := range tt.steps {
+				var amount *money.Money
+				if step.amount != "" {
+					parsed := mustMoney(t, step.amount)
+					amount = &parsed
+				}
+
+				if step.dispute {
+					loseDispute(t, s, transaction.UUID, amount)
+					continue
+				}
+				_, err := s.RefundPayment(ctx, model.RefundInfo{
+					TransactionUUID: transaction.UUID,
+					Amount:          amount,
+					Reason:          model.RefundReasonRequestedByCustomer,
+				})
+				if !errors.Is(err, step.wantErr) {
+					t.Fatalf("step %d: RefundPayment(%q) error = %v, want %v", i+1, step.amount, err, step.wantErr)
+				}
+			}
+
+			transaction, err = s.GetTransaction(ctx, transaction.UUID)
+			if err != nil {
+				t.Fatalf("GetTransaction() error = %v", err)
+			}
+			if got, want := transaction.RefundedAmount, mustMoney(t, tt.wantRefunds); got.Cmp(want) != 0 {
+				t.Fatalf("RefundedAmount = %s, want %s", got, want)
+			}
+			if transaction.Status != tt.wantStatus {
+				t.Fatalf("status = %v, want %v", transaction.Status, tt.wantStatus)
+			}
+			// Возвраты и споры вместе никогда не превышают собранную сумму.
+			if returned := transaction.RefundedAmount.Add(transaction.ChargedBackAmount); returned.Cmp(transaction.CollectedAmount) > 0 {
+				t.Fatalf("returned %s exceeds collected %s", returned, transaction.CollectedAmount)
+			}
+			checkLedger(t, s)
+		})
+	}
+}
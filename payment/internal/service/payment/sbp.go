@@ -0,0 +1,214 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/sbp"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// CreateSBPPaymentIntent создаёт платёж СБП, который ждёт оплаты по QR-коду.
+func (s *service) CreateSBPPaymentIntent(
+	ctx context.Context,
+	info model.PayOrderInfo,
+	format model.QRImageFormat,
+) (model.SBPPaymentIntent, error) {
+	info.PaymentMethod = model.PaymentMethodSBP
+	if err := s.validatePayOrderInfo(info); err != nil {
+		return model.SBPPaymentIntent{}, err
+	}
+
+	transaction, err := s.idempotent(ctx, operationSBPIntent, info, func(ctx context.Context) (model.Transaction, error) {
+		return s.createSBPIntent(ctx, info)
+	})
+	if err != nil {
+		return model.SBPPaymentIntent{}, err
+	}
+
+	return s.sbpIntent(transaction, format)
+}
+
+// GetSBPPaymentIntent возвращает платёж СБП с QR-кодом в формате format.
+func (s *service) GetSBPPaymentIntent(
+	ctx context.Context,
+	transactionUUID string,
+	format model.QRImageFormat,
+) (model.SBPPaymentIntent, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.SBPPaymentIntent{}, model.ErrInvalidUUID
+	}
+
+	transaction, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err != nil {
+		return model.SBPPaymentIntent{}, err
+	}
+	if transaction.PaymentMethod != model.PaymentMethodSBP {
+		return model.SBPPaymentIntent{}, model.ErrInvalidTransactionState
+	}
+
+	return s.sbpIntent(transaction, format)
+}
+
+// ConfirmSBPPayment проводит оплату ожидающего платежа СБП, как если бы покупатель
+// отсканировал QR-код и подтвердил перевод в приложении банка.
+func (s *service) ConfirmSBPPayment(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.Transaction{}, model.ErrInvalidUUID
+	}
+
+	transaction, err := s.authorizeSBPIntent(ctx, transactionUUID)
+	if err != nil || transaction.Status != model.TransactionStatusAuthorized {
+		return transaction, err
+	}
+
+	captured, err := s.capture(ctx, transaction.UUID, nil)
+	if err != nil {
+		if errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrProviderUnavailable) {
+			if _, voidErr := s.VoidAuthorization(ctx, transaction.UUID); voidErr != nil {
+				log.Printf("failed to void authorization %s after capture failure: %v", transaction.UUID, voidErr)
+			}
+		}
+		return model.Transaction{}, err
+	}
+
+	return captured, nil
+}
+
+// createSBPIntent создаёт транзакцию в статусе PENDING. Провайдер вызывается только
+// при подтверждении оплаты, до этого у покупателя ничего не удерживается.
+func (s *service) createSBPIntent(ctx context.Context, info model.PayOrderInfo) (model.Transaction, error) {
+	if err := s.screen(ctx, info); err != nil {
+		return model.Transaction{}, err
+	}
+
+	now := time.Now()
+
+	transaction := model.Transaction{
+		UUID:          uuid.NewString(),
+		OrderUUID:     info.OrderUUID,
+		UserUUID:      info.UserUUID,
+		PaymentMethod: model.PaymentMethodSBP,
+		Status:        model.TransactionStatusPending,
+		Amount:        info.Amount,
+		RefundedAmount: money.Money{
+			CurrencyCode: info.Amount.CurrencyCode,
+		},
+		ChargedBackAmount: money.Money{
+			CurrencyCode: info.Amount.CurrencyCode,
+		},
+		CollectedAmount: money.Money{
+			CurrencyCode: info.Amount.CurrencyCode,
+		},
+		AuthorizationExpiresAt: now.Add(s.config.SBPIntentTTL),
+		LineItems:              info.LineItems,
+		CreatedAt:              now,
+	}
+	if err := s.settle(&transaction, now); err != nil {
+		return model.Transaction{}, err
+	}
+
+	// Ссылку строим до сохранения, чтобы не оставлять транзакций с недопустимой для СБП суммой.
+	if _, err := sbp.Payload(s.config.SBPBankID, transaction.UUID, transaction.Amount); err != nil {
+		return model.Transaction{}, err
+	}
+
+	if err := s.transactionRepository.Create(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+
+	log.Printf("Создан платёж СБП, ожидающий оплаты по QR-коду, transaction_uuid: %s", transaction.UUID)
+
+	return transaction, nil
+}
+
+// authorizeSBPIntent авторизует сумму ожидающего платежа у провайдера СБП.
+// Уже оплаченный платёж возвращается без изменений.
+func (s *service) authorizeSBPIntent(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	unlock := s.transactionLocks.lock(transactionUUID)
+	defer unlock()
+
+	transaction, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	switch {
+	case transaction.PaymentMethod != model.PaymentMethodSBP:
+		return model.Transaction{}, model.ErrInvalidTransactionState
+	case isCaptured(transaction.Status):
+		// Повторное подтверждение оплаченного платежа ничего не меняет.
+		return transaction, nil
+	case transaction.Status == model.TransactionStatusExpired:
+		return model.Transaction{}, model.ErrPaymentIntentExpired
+	case transaction.Status != model.TransactionStatusPending:
+		return model.Transaction{}, model.ErrInvalidTransactionState
+	}
+
+	now := time.Now()
+	if !now.Before(transaction.AuthorizationExpiresAt) {
+		s.expire(ctx, transaction)
+		return model.Transaction{}, model.ErrPaymentIntentExpired
+	}
+
+	p, err := s.provider(transaction.PaymentMethod)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	transaction.AuthorizedAmount = transaction.Amount
+	if err := p.Authorize(ctx, providerRequest(model.ProviderOperationAuthorize, transaction, transaction.Amount)); err != nil {
+		var decline *model.DeclineError
+		if !errors.As(err, &decline) {
+			// Сбой провайдера оставляет платёж ожидающим, подтверждение можно повторить.
+			return model.Transaction{}, err
+		}
+
+		transaction.Status = model.TransactionStatusDeclined
+		transaction.AuthorizationExpiresAt = time.Time{}
+		transaction.DeclineCode = decline.Code
+		if updateErr := s.transactionRepository.Update(ctx, transaction); updateErr != nil {
+			return model.Transaction{}, updateErr
+		}
+
+		log.Printf("Провайдер отклонил платёж СБП, transaction_uuid: %s, decline_code: %s", transaction.UUID, decline.Code)
+		s.publish(ctx, failureEvent(transaction, decline.Code))
+
+		return model.Transaction{}, err
+	}
+
+	transaction.Status = model.TransactionStatusAuthorized
+	transaction.AuthorizationExpiresAt = now.Add(s.config.AuthorizationTTL)
+	if err := s.transactionRepository.Update(ctx, transaction); err != nil {
+		s.releaseHold(ctx, p, transaction)
+		return model.Transaction{}, err
+	}
+	s.publish(ctx, paymentEvent(model.PaymentEventTypeAuthorized, transaction, transaction.AuthorizedAmount))
+
+	return transaction, nil
+}
+
+// sbpIntent строит ссылку НСПК и QR-код для транзакции СБП.
+func (s *service) sbpIntent(transaction model.Transaction, format model.QRImageFormat) (model.SBPPaymentIntent, error) {
+	payload, err := sbp.Payload(s.config.SBPBankID, transaction.UUID, transaction.Amount)
+	if err != nil {
+		return model.SBPPaymentIntent{}, err
+	}
+
+	image, contentType, err := sbp.QRCode(payload, format)
+	if err != nil {
+		return model.SBPPaymentIntent{}, err
+	}
+
+	return model.SBPPaymentIntent{
+		Transaction:        transaction,
+		Payload:            payload,
+		QRImage:            image,
+		QRImageContentType: contentType,
+	}, nil
+}
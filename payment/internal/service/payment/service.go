@@ -0,0 +1,139 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/fraud"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider"
+	"github.com/Denisz0785/spaceyard/payment/internal/repository"
+	def "github.com/Denisz0785/spaceyard/payment/internal/service"
+	"github.com/Denisz0785/spaceyard/payment/internal/vault"
+	"github.com/Denisz0785/spaceyard/payment/internal/webhook"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+)
+
+var _ def.PaymentService = (*service)(nil)
+
+// Config задаёт сроки, которые настраиваются при запуске сервиса.
+type Config struct {
+	// IdempotencyRetention — сколько хранится ключ идемпотентности после оплаты.
+	IdempotencyRetention time.Duration
+	// AuthorizationTTL — сколько действует авторизация, пока сумма не списана.
+	AuthorizationTTL time.Duration
+	// FeeBasisPoints — комиссия провайдера со списанной суммы в базисных пунктах (1 б.п. = 0,01%).
+	FeeBasisPoints int64
+	// SBPBankID — идентификатор банка-получателя платежей СБП в ссылке НСПК.
+	SBPBankID string
+	// SBPIntentTTL — сколько действует QR-код платежа СБП, пока покупатель не оплатил.
+	SBPIntentTTL time.Duration
+	// InstallmentRates — годовые ставки рассрочки в базисных пунктах по доступным срокам в месяцах.
+	InstallmentRates map[int]int64
+	// InstallmentPeriod — интервал между платежами рассрочки, ноль означает календарный месяц.
+	InstallmentPeriod time.Duration
+	// InstallmentGracePeriod — сколько после срока повторяется списание платежа,
+	// прежде чем он считается пропущенным.
+	InstallmentGracePeriod time.Duration
+	// InstallmentRetryInterval — пауза между попытками списать платёж после отказа.
+	InstallmentRetryInterval time.Duration
+	// InstallmentMaxMissed — после стольких пропусков план признаётся дефолтным, ноль — никогда.
+	InstallmentMaxMissed int
+	// WebhookMaxAttempts — после стольких неудачных попыток доставка вебхука считается
+	// недоставленной, ноль — попытки не ограничены.
+	WebhookMaxAttempts int
+	// WebhookBackoffBase — пауза после первой неудачной попытки, дальше она удваивается.
+	WebhookBackoffBase time.Duration
+	// WebhookBackoffMax — наибольшая пауза между попытками доставки вебхука.
+	WebhookBackoffMax time.Duration
+	// SettlementCurrency — валюта расчётов с провайдерами, в неё пересчитываются суммы транзакций.
+	SettlementCurrency string
+	// ReceiptSeller — название продавца в шапке чека.
+	ReceiptSeller string
+	// ReceiptVATBasisPoints — ставка НДС, включённого в цены позиций чека, в базисных пунктах.
+	ReceiptVATBasisPoints int64
+	// DisputeEvidenceWindow — сколько после открытия спора магазин может отправить доказательства.
+	DisputeEvidenceWindow time.Duration
+}
+
+type service struct {
+	transactionRepository repository.TransactionRepository
+	idempotencyRepository repository.IdempotencyRepository
+	refundRepository      repository.RefundRepository
+	ledgerRepository      repository.LedgerRepository
+	investorRepository    repository.InvestorRepository
+	fraudRepository       repository.FraudDecisionRepository
+	installmentRepository repository.InstallmentPlanRepository
+	eventRepository       repository.PaymentEventRepository
+	webhookRepository     repository.WebhookRepository
+	cardRepository        repository.CardRepository
+	receiptRepository     repository.ReceiptRepository
+	disputeRepository     repository.DisputeRepository
+	// screener проверяет попытки оплаты правилами антифрода до обращения к провайдеру.
+	screener *fraud.Screener
+	// keyring шифрует номера сохранённых карт.
+	keyring *vault.Keyring
+	// providers — адаптеры платёжных провайдеров по способам оплаты.
+	providers map[model.PaymentMethod]provider.Provider
+	// webhookSender отправляет события на адреса подписчиков вебхуков.
+	webhookSender *webhook.Sender
+	// rates пересчитывает суммы транзакций в валюту расчётов.
+	rates *currency.Rates
+
+	config   Config
+	keyLocks keyLocks
+	// transactionLocks упорядочивает изменения одной транзакции.
+	transactionLocks keyLocks
+	// userLocks упорядочивает проверки антифрода одного пользователя.
+	userLocks keyLocks
+	// cardLocks упорядочивает удаление и перешифрование одной карты.
+	cardLocks keyLocks
+	// eventNotifier будит подписчиков потока событий после публикации.
+	eventNotifier *eventNotifier
+}
+
+func NewService(
+	transactionRepository repository.TransactionRepository,
+	idempotencyRepository repository.IdempotencyRepository,
+	refundRepository repository.RefundRepository,
+	ledgerRepository repository.LedgerRepository,
+	investorRepository repository.InvestorRepository,
+	fraudRepository repository.FraudDecisionRepository,
+	installmentRepository repository.InstallmentPlanRepository,
+	eventRepository repository.PaymentEventRepository,
+	webhookRepository repository.WebhookRepository,
+	cardRepository repository.CardRepository,
+	receiptRepository repository.ReceiptRepository,
+	disputeRepository repository.DisputeRepository,
+	screener *fraud.Screener,
+	keyring *vault.Keyring,
+	providers map[model.PaymentMethod]provider.Provider,
+	webhookSender *webhook.Sender,
+	rates *currency.Rates,
+	config Config,
+) *service {
+	return &service{
+		transactionRepository: transactionRepository,
+		idempotencyRepository: idempotencyRepository,
+		refundRepository:      refundRepository,
+		ledgerRepository:      ledgerRepository,
+		investorRepository:    investorRepository,
+		fraudRepository:       fraudRepository,
+		installmentRepository: installmentRepository,
+		eventRepository:       eventRepository,
+		webhookRepository:     webhookRepository,
+		cardRepository:        cardRepository,
+		receiptRepository:     receiptRepository,
+		disputeRepository:     disputeRepository,
+		screener:              screener,
+		keyring:               keyring,
+		providers:             providers,
+		webhookSender:         webhookSender,
+		rates:                 rates,
+		config:                config,
+		keyLocks:              keyLocks{locks: make(map[string]*keyLock)},
+		transactionLocks:      keyLocks{locks: make(map[string]*keyLock)},
+		userLocks:             keyLocks{locks: make(map[string]*keyLock)},
+		cardLocks:             keyLocks{locks: make(map[string]*keyLock)},
+		eventNotifier:         newEventNotifier(),
+	}
+}
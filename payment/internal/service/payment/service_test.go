@@ -0,0 +1,127 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/fraud"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider"
+	investorProvider "github.com/Denisz0785/spaceyard/payment/internal/provider/investor"
+	"github.com/Denisz0785/spaceyard/payment/internal/provider/simulator"
+	cardRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/card"
+	disputeRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/dispute"
+	eventRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/event"
+	fraudRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/fraud"
+	idempotencyRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/idempotency"
+	installmentRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/installment"
+	investorRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/investor"
+	ledgerRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/ledger"
+	receiptRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/receipt"
+	refundRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/refund"
+	transactionRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/transaction"
+	webhookRepository "github.com/Denisz0785/spaceyard/payment/internal/repository/webhook"
+	"github.com/Denisz0785/spaceyard/payment/internal/vault"
+	"github.com/Denisz0785/spaceyard/payment/internal/webhook"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// newTestService собирает сервис на репозиториях в памяти и симуляторе провайдера,
+// который одобряет все операции.
+func newTestService(t *testing.T) *service {
+	t.Helper()
+
+	rates := currency.NewRates("RUB")
+	screener, err := fraud.NewScreener("", rates)
+	if err != nil {
+		t.Fatalf("failed to create fraud screener: %v", err)
+	}
+	keyring, err := vault.NewKeyring("")
+	if err != nil {
+		t.Fatalf("failed to create keyring: %v", err)
+	}
+
+	investorRepo := investorRepository.NewRepository()
+	providers := map[model.PaymentMethod]provider.Provider{
+		model.PaymentMethodInvestorMoney: investorProvider.New(investorRepo),
+	}
+	for _, method := range []model.PaymentMethod{
+		model.PaymentMethodCard,
+		model.PaymentMethodSBP,
+		model.PaymentMethodCreditCard,
+	} {
+		providers[method] = simulator.New(method, simulator.Config{})
+	}
+
+	return NewService(
+		transactionRepository.NewRepository(),
+		idempotencyRepository.NewRepository(),
+		refundRepository.NewRepository(),
+		ledgerRepository.NewRepository(),
+		investorRepo,
+		fraudRepository.NewRepository(),
+		installmentRepository.NewRepository(),
+		eventRepository.NewRepository(),
+		webhookRepository.NewRepository(),
+		cardRepository.NewRepository(),
+		receiptRepository.NewRepository(),
+		disputeRepository.NewRepository(),
+		screener,
+		keyring,
+		providers,
+		webhook.NewSender(time.Second, false),
+		rates,
+		Config{
+			IdempotencyRetention:  time.Hour,
+			AuthorizationTTL:      time.Hour,
+			SBPBankID:             "100000000001",
+			SBPIntentTTL:          time.Hour,
+			InstallmentRates:      map[int]int64{3: 0},
+			SettlementCurrency:    "RUB",
+			DisputeEvidenceWindow: time.Hour,
+		},
+	)
+}
+
+// mustMoney разбирает десятичную сумму в рублях.
+func mustMoney(t *testing.T, value string) money.Money {
+	t.Helper()
+
+	amount, err := money.Parse("RUB", value)
+	if err != nil {
+		t.Fatalf("failed to parse amount %q: %v", value, err)
+	}
+	return amount
+}
+
+// payOrderInfo возвращает запрос на оплату нового заказа картой.
+func payOrderInfo(t *testing.T, amount string) model.PayOrderInfo {
+	t.Helper()
+
+	return model.PayOrderInfo{
+		OrderUUID:     "6f1c1d0e-8a8b-4c53-9d4e-0b1a2c3d4e5f",
+		UserUUID:      "0d9e8f7a-6b5c-4d3e-8f1a-2b3c4d5e6f70",
+		PaymentMethod: model.PaymentMethodCard,
+		Amount:        mustMoney(t, amount),
+	}
+}
+
+// loseDispute открывает спор на amount и сразу признаёт его проигранным.
+func loseDispute(t *testing.T, s *service, transactionUUID string, amount *money.Money) {
+	t.Helper()
+
+	ctx := context.Background()
+	dispute, err := s.OpenDispute(ctx, model.DisputeInfo{
+		TransactionUUID: transactionUUID,
+		Amount:          amount,
+		Reason:          model.DisputeReasonProductNotReceived,
+	})
+	if err != nil {
+		t.Fatalf("OpenDispute() error = %v", err)
+	}
+	if _, err := s.ResolveDispute(ctx, dispute.UUID, model.DisputeOutcomeLost); err != nil {
+		t.Fatalf("ResolveDispute() error = %v", err)
+	}
+}
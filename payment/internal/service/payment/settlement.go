@@ -0,0 +1,52 @@
+package payment
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+// validateSettlementCurrency проверяет, что для валюты платежа сейчас действует курс к валюте расчётов.
+func (s *service) validateSettlementCurrency(code string) error {
+	if _, _, err := s.rates.Rate(code, s.config.SettlementCurrency, time.Now()); err != nil {
+		return fmt.Errorf("%w: no exchange rate from %s to settlement currency %s: %w",
+			model.ErrInvalidAmount, code, s.config.SettlementCurrency, err)
+	}
+	return nil
+}
+
+// settle фиксирует курс валюты транзакции к валюте расчётов на момент at и пересчитывает
+// по нему сумму. Для валюты без курса возвращается ErrInvalidAmount.
+func (s *service) settle(transaction *model.Transaction, at time.Time) error {
+	conversion, err := s.rates.Convert(transaction.Amount.Rat(), transaction.Amount.CurrencyCode, s.config.SettlementCurrency, at)
+	if err != nil {
+		return fmt.Errorf("%w: no exchange rate from %s to settlement currency %s: %w",
+			model.ErrInvalidAmount, transaction.Amount.CurrencyCode, s.config.SettlementCurrency, err)
+	}
+
+	transaction.ExchangeRate = currency.FormatDecimal(conversion.Rate)
+	transaction.SettlementAmount = money.FromRat(s.config.SettlementCurrency, conversion.Amount)
+	return nil
+}
+
+// resettle пересчитывает сумму в валюте расчётов после изменения Amount по курсу,
+// зафиксированному при создании транзакции. Транзакции, созданные до появления валюты
+// расчётов, курса не имеют и не пересчитываются.
+func (s *service) resettle(transaction *model.Transaction) error {
+	if transaction.ExchangeRate == "" {
+		return nil
+	}
+
+	rate, err := currency.ParseDecimal(transaction.ExchangeRate)
+	if err != nil {
+		return fmt.Errorf("invalid exchange rate of transaction %s: %w", transaction.UUID, err)
+	}
+
+	settlementCurrency := transaction.SettlementAmount.CurrencyCode
+	amount := currency.ConvertAt(transaction.Amount.Rat(), rate, settlementCurrency)
+	transaction.SettlementAmount = money.FromRat(settlementCurrency, amount)
+	return nil
+}
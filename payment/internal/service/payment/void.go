@@ -0,0 +1,52 @@
+package payment
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// VoidAuthorization снимает удержание авторизованной суммы без списания.
+func (s *service) VoidAuthorization(ctx context.Context, transactionUUID string) (model.Transaction, error) {
+	if err := uuid.Validate(transactionUUID); err != nil {
+		return model.Transaction{}, model.ErrInvalidUUID
+	}
+
+	unlock := s.transactionLocks.lock(transactionUUID)
+	defer unlock()
+
+	transaction, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	switch transaction.Status {
+	case model.TransactionStatusVoided, model.TransactionStatusExpired:
+		// Удержание уже снято, повторная отмена ничего не меняет.
+		return transaction, nil
+	case model.TransactionStatusAuthorized:
+	default:
+		return model.Transaction{}, model.ErrInvalidTransactionState
+	}
+
+	p, err := s.provider(transaction.PaymentMethod)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+	if err := p.Void(ctx, providerRequest(model.ProviderOperationVoid, transaction, transaction.AuthorizedAmount)); err != nil {
+		return model.Transaction{}, err
+	}
+
+	transaction.Status = model.TransactionStatusVoided
+	if err := s.transactionRepository.Update(ctx, transaction); err != nil {
+		return model.Transaction{}, err
+	}
+
+	log.Printf("Авторизация отменена, transaction_uuid: %s", transaction.UUID)
+	s.publish(ctx, paymentEvent(model.PaymentEventTypeVoided, transaction, transaction.AuthorizedAmount))
+
+	return transaction, nil
+}
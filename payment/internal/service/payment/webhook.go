@@ -0,0 +1,388 @@
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/payment/internal/webhook"
+)
+
+// webhookSecretPrefix — префикс секрета, по которому его легко узнать в конфигурации подписчика.
+const webhookSecretPrefix = "whsec_"
+
+// CreateWebhookSubscription регистрирует адрес подписчика и возвращает подписку с секретом подписи.
+func (s *service) CreateWebhookSubscription(
+	ctx context.Context,
+	info model.WebhookSubscriptionInfo,
+) (model.WebhookSubscription, error) {
+	if err := s.validateWebhookSubscription(ctx, info); err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	secret, err := webhookSecret()
+	if err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	subscription := model.WebhookSubscription{
+		UUID:       uuid.NewString(),
+		URL:        info.URL,
+		EventTypes: slices.Clone(info.EventTypes),
+		Secret:     secret,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.webhookRepository.CreateSubscription(ctx, subscription); err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	log.Printf("Создана подписка на вебхуки, subscription_uuid: %s, url: %s", subscription.UUID, subscription.URL)
+
+	return subscription, nil
+}
+
+// ListWebhookSubscriptions возвращает подписки без секретов.
+func (s *service) ListWebhookSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	subscriptions, err := s.webhookRepository.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteWebhookSubscription удаляет подписку. Её недоставленные события переходят
+// в недоставленные окончательно и остаются в списке доставок.
+func (s *service) DeleteWebhookSubscription(ctx context.Context, subscriptionUUID string) error {
+	if err := uuid.Validate(subscriptionUUID); err != nil {
+		return model.ErrInvalidUUID
+	}
+
+	if err := s.webhookRepository.DeleteSubscription(ctx, subscriptionUUID); err != nil {
+		return err
+	}
+
+	pending, err := s.webhookRepository.ListDeliveries(ctx, model.WebhookDeliveriesFilter{
+		SubscriptionUUID: subscriptionUUID,
+		Statuses:         []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusPending},
+	})
+	if err != nil {
+		return err
+	}
+	for _, delivery := range pending {
+		s.killWebhookDelivery(ctx, delivery, "subscription is deleted", time.Now())
+	}
+
+	log.Printf("Удалена подписка на вебхуки, subscription_uuid: %s", subscriptionUUID)
+
+	return nil
+}
+
+// ListWebhookDeliveries возвращает доставки событий в порядке их номеров.
+func (s *service) ListWebhookDeliveries(
+	ctx context.Context,
+	filter model.WebhookDeliveriesFilter,
+) ([]model.WebhookDelivery, error) {
+	if filter.SubscriptionUUID != "" {
+		if err := uuid.Validate(filter.SubscriptionUUID); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+	}
+
+	return s.webhookRepository.ListDeliveries(ctx, filter)
+}
+
+// ReplayWebhookDeliveries снова ставит в очередь недоставленные события подписки:
+// перечисленные в deliveryUUIDs или все, если список пуст.
+func (s *service) ReplayWebhookDeliveries(
+	ctx context.Context,
+	subscriptionUUID string,
+	deliveryUUIDs []string,
+) ([]model.WebhookDelivery, error) {
+	if err := uuid.Validate(subscriptionUUID); err != nil {
+		return nil, model.ErrInvalidUUID
+	}
+	for _, deliveryUUID := range deliveryUUIDs {
+		if err := uuid.Validate(deliveryUUID); err != nil {
+			return nil, model.ErrInvalidUUID
+		}
+	}
+
+	// Доставки удалённой подписки повторять некуда.
+	if _, err := s.webhookRepository.GetSubscription(ctx, subscriptionUUID); err != nil {
+		return nil, err
+	}
+
+	dead, err := s.webhookRepository.ListDeliveries(ctx, model.WebhookDeliveriesFilter{
+		SubscriptionUUID: subscriptionUUID,
+		Statuses:         []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusDead},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deliveryUUIDs) > 0 {
+		selected := make([]model.WebhookDelivery, 0, len(deliveryUUIDs))
+		for _, deliveryUUID := range deliveryUUIDs {
+			i := slices.IndexFunc(dead, func(delivery model.WebhookDelivery) bool {
+				return delivery.UUID == deliveryUUID
+			})
+			if i < 0 {
+				return nil, model.ErrWebhookDeliveryNotFound
+			}
+			selected = append(selected, dead[i])
+		}
+		dead = selected
+	}
+
+	now := time.Now()
+	replayed := make([]model.WebhookDelivery, 0, len(dead))
+	for _, delivery := range dead {
+		delivery.Status = model.WebhookDeliveryStatusPending
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = now
+		delivery.UpdatedAt = now
+		if err := s.webhookRepository.UpdateDelivery(ctx, delivery); err != nil {
+			return nil, err
+		}
+		replayed = append(replayed, delivery)
+	}
+
+	log.Printf("Повторно поставлено в очередь доставок: %d, subscription_uuid: %s", len(replayed), subscriptionUUID)
+
+	return replayed, nil
+}
+
+// RunWebhookDelivery периодически отправляет подписчикам события из очереди до отмены ctx.
+// Перед первой отправкой закрывает очередь подписок, адрес которых больше не допускается.
+func (s *service) RunWebhookDelivery(ctx context.Context, interval time.Duration) {
+	s.checkWebhookSubscriptions(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.deliverDueWebhooks(ctx, now)
+		}
+	}
+}
+
+// enqueueWebhooks ставит событие в очередь доставки каждому подписчику на его тип.
+// Ошибка только логируется: событие уже записано в журнал.
+func (s *service) enqueueWebhooks(ctx context.Context, event model.PaymentEvent) {
+	subscriptions, err := s.webhookRepository.ListSubscriptions(ctx)
+	if err != nil {
+		log.Printf("failed to list webhook subscriptions for event %d: %v", event.Sequence, err)
+		return
+	}
+
+	deliveries := make([]model.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if len(subscription.EventTypes) > 0 && !slices.Contains(subscription.EventTypes, event.Type) {
+			continue
+		}
+
+		deliveryUUID := uuid.NewString()
+		payload, err := webhook.Payload(deliveryUUID, event)
+		if err != nil {
+			log.Printf("failed to build webhook payload of event %d for subscription %s: %v",
+				event.Sequence, subscription.UUID, err)
+			continue
+		}
+
+		deliveries = append(deliveries, model.WebhookDelivery{
+			UUID:             deliveryUUID,
+			SubscriptionUUID: subscription.UUID,
+			EventSequence:    event.Sequence,
+			EventType:        event.Type,
+			Payload:          payload,
+			Status:           model.WebhookDeliveryStatusPending,
+			NextAttemptAt:    event.CreatedAt,
+			CreatedAt:        event.CreatedAt,
+			UpdatedAt:        event.CreatedAt,
+		})
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	if err := s.webhookRepository.CreateDeliveries(ctx, deliveries); err != nil {
+		log.Printf("failed to enqueue webhook deliveries of event %d: %v", event.Sequence, err)
+	}
+}
+
+// deliverDueWebhooks отправляет доставки, очередная попытка которых наступила к now.
+func (s *service) deliverDueWebhooks(ctx context.Context, now time.Time) {
+	deliveries, err := s.webhookRepository.ListDeliveries(ctx, model.WebhookDeliveriesFilter{
+		Statuses: []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusPending},
+		DueBy:    now,
+	})
+	if err != nil {
+		log.Printf("failed to list webhook deliveries: %v", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		s.deliverWebhook(ctx, delivery)
+	}
+}
+
+// deliverWebhook делает одну попытку доставки. После неудачи следующая попытка
+// откладывается экспоненциально, после WebhookMaxAttempts доставка считается недоставленной.
+func (s *service) deliverWebhook(ctx context.Context, delivery model.WebhookDelivery) {
+	subscription, err := s.webhookRepository.GetSubscription(ctx, delivery.SubscriptionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrWebhookSubscriptionNotFound) {
+			s.killWebhookDelivery(ctx, delivery, "subscription is deleted", time.Now())
+			return
+		}
+		log.Printf("failed to get webhook subscription %s: %v", delivery.SubscriptionUUID, err)
+		return
+	}
+
+	sendErr := s.webhookSender.Send(ctx, subscription.URL, subscription.Secret, delivery.UUID, delivery.Payload)
+	now := time.Now()
+
+	if sendErr == nil {
+		delivery.Status = model.WebhookDeliveryStatusDelivered
+		delivery.DeliveredAt = now
+		delivery.LastError = ""
+		delivery.UpdatedAt = now
+		if err := s.webhookRepository.UpdateDelivery(ctx, delivery); err != nil {
+			log.Printf("failed to update webhook delivery %s: %v", delivery.UUID, err)
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// Попытка прервана остановкой сервиса, при следующем запуске она повторится.
+		return
+	}
+
+	delivery.Attempts++
+	// Адрес, отклонённый правилами отправителя, не станет допустимым от повторов.
+	if isForbiddenWebhookURL(sendErr) ||
+		s.config.WebhookMaxAttempts > 0 && delivery.Attempts >= s.config.WebhookMaxAttempts {
+		s.killWebhookDelivery(ctx, delivery, sendErr.Error(), now)
+		return
+	}
+
+	delivery.LastError = sendErr.Error()
+	delivery.NextAttemptAt = now.Add(s.webhookBackoff(delivery.Attempts))
+	delivery.UpdatedAt = now
+	if err := s.webhookRepository.UpdateDelivery(ctx, delivery); err != nil {
+		log.Printf("failed to update webhook delivery %s: %v", delivery.UUID, err)
+	}
+}
+
+// checkWebhookSubscriptions находит подписки, адрес которых не проходит проверку
+// отправителя, — например, созданные по http до запрета — и переводит их ожидающие
+// доставки в недоставленные. Новые события таким подписчикам отклоняются с первой попытки.
+func (s *service) checkWebhookSubscriptions(ctx context.Context) {
+	subscriptions, err := s.webhookRepository.ListSubscriptions(ctx)
+	if err != nil {
+		log.Printf("failed to list webhook subscriptions: %v", err)
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		urlErr := s.webhookSender.ValidateURL(ctx, subscription.URL)
+		if !isForbiddenWebhookURL(urlErr) {
+			continue
+		}
+
+		log.Printf("Подписка на вебхуки не получит событий, пока её не пересоздадут с допустимым адресом "+
+			"(для локальной разработки — PAYMENT_WEBHOOK_ALLOW_INSECURE), subscription_uuid: %s, url: %s, reason: %v",
+			subscription.UUID, subscription.URL, urlErr)
+
+		pending, err := s.webhookRepository.ListDeliveries(ctx, model.WebhookDeliveriesFilter{
+			SubscriptionUUID: subscription.UUID,
+			Statuses:         []model.WebhookDeliveryStatus{model.WebhookDeliveryStatusPending},
+		})
+		if err != nil {
+			log.Printf("failed to list webhook deliveries of subscription %s: %v", subscription.UUID, err)
+			continue
+		}
+		now := time.Now()
+		for _, delivery := range pending {
+			s.killWebhookDelivery(ctx, delivery, urlErr.Error(), now)
+		}
+	}
+}
+
+// isForbiddenWebhookURL сообщает, что адрес подписчика отклонён правилами отправителя,
+// а не временной ошибкой сети.
+func isForbiddenWebhookURL(err error) bool {
+	return errors.Is(err, webhook.ErrInsecureURL) || errors.Is(err, webhook.ErrForbiddenAddress)
+}
+
+// killWebhookDelivery переводит доставку в недоставленные с причиной reason.
+func (s *service) killWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery, reason string, now time.Time) {
+	delivery.Status = model.WebhookDeliveryStatusDead
+	delivery.LastError = reason
+	delivery.NextAttemptAt = time.Time{}
+	delivery.UpdatedAt = now
+	if err := s.webhookRepository.UpdateDelivery(ctx, delivery); err != nil {
+		log.Printf("failed to update webhook delivery %s: %v", delivery.UUID, err)
+		return
+	}
+
+	log.Printf("Событие %d не доставлено подписчику, delivery_uuid: %s, subscription_uuid: %s, reason: %s",
+		delivery.EventSequence, delivery.UUID, delivery.SubscriptionUUID, reason)
+}
+
+// webhookBackoff возвращает паузу перед попыткой после attempts неудачных:
+// WebhookBackoffBase·2^(attempts-1), но не больше WebhookBackoffMax.
+func (s *service) webhookBackoff(attempts int) time.Duration {
+	backoff := s.config.WebhookBackoffBase
+	for i := 1; i < attempts; i++ {
+		if backoff >= s.config.WebhookBackoffMax/2 {
+			return s.config.WebhookBackoffMax
+		}
+		backoff *= 2
+	}
+
+	return min(backoff, s.config.WebhookBackoffMax)
+}
+
+func (s *service) validateWebhookSubscription(ctx context.Context, info model.WebhookSubscriptionInfo) error {
+	if err := s.webhookSender.ValidateURL(ctx, info.URL); err != nil {
+		return fmt.Errorf("%w: %w", model.ErrInvalidWebhookSubscription, err)
+	}
+
+	for _, eventType := range info.EventTypes {
+		if eventType == model.PaymentEventTypeUnspecified {
+			return model.ErrInvalidWebhookSubscription
+		}
+	}
+
+	return nil
+}
+
+// webhookSecret создаёт случайный ключ подписи из 32 байт.
+func webhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
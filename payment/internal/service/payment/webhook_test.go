@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+func TestWebhookBackoff(t *testing.T) {
+	s := newTestService(t)
+	s.config.WebhookBackoffBase = 10 * time.Second
+	s.config.WebhookBackoffMax = time.Hour
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 5, want: 160 * time.Second},
+		{attempts: 9, want: 2560 * time.Second},
+		{attempts: 10, want: time.Hour},
+		// Удвоение не переполняет длительность и при очень большом числе попыток.
+		{attempts: 1000, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := s.webhookBackoff(tt.attempts); got != tt.want {
+			t.Errorf("webhookBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestInsecureWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+
+	// Подписка по http, созданная до запрета, лежит в хранилище в обход проверки адреса.
+	subscription := model.WebhookSubscription{
+		UUID:      "5b0c1d2e-3f4a-4b5c-8d6e-7f8091a2b3c4",
+		URL:       "http://203.0.113.10/hook",
+		Secret:    "whsec_test",
+		CreatedAt: time.Now(),
+	}
+	if err := s.webhookRepository.CreateSubscription(ctx, subscription); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+
+	deliveries := func(status model.WebhookDeliveryStatus) []model.WebhookDelivery {
+		t.Helper()
+
+		deliveries, err := s.ListWebhookDeliveries(ctx, model.WebhookDeliveriesFilter{
+			SubscriptionUUID: subscription.UUID,
+			Statuses:         []model.WebhookDeliveryStatus{status},
+		})
+		if err != nil {
+			t.Fatalf("ListWebhookDeliveries() error = %v", err)
+		}
+		return deliveries
+	}
+
+	if _, err := s.PayOrder(ctx, payOrderInfo(t, "100.00")); err != nil {
+		t.Fatalf("PayOrder() error = %v", err)
+	}
+	if len(deliveries(model.WebhookDeliveryStatusPending)) == 0 {
+		t.Fatal("no pending deliveries after PayOrder()")
+	}
+
+	// При запуске доставки ожидающие события такой подписки сразу становятся недоставленными.
+	s.checkWebhookSubscriptions(ctx)
+	if pending := deliveries(model.WebhookDeliveryStatusPending); len(pending) > 0 {
+		t.Fatalf("pending deliveries after startup check = %d, want 0", len(pending))
+	}
+
+	// Новые события не повторяются: первая же попытка отклоняется окончательно.
+	if _, err := s.PayOrder(ctx, payOrderInfo(t, "200.00")); err != nil {
+		t.Fatalf("PayOrder() error = %v", err)
+	}
+	s.deliverDueWebhooks(ctx, time.Now())
+	if pending := deliveries(model.WebhookDeliveryStatusPending); len(pending) > 0 {
+		t.Fatalf("pending deliveries after the first attempt = %d, want 0", len(pending))
+	}
+	for _, delivery := range deliveries(model.WebhookDeliveryStatusDead) {
+		if delivery.LastError == "" {
+			t.Fatalf("dead delivery %s has no reason", delivery.UUID)
+		}
+	}
+}
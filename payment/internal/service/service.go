@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	"github.com/Denisz0785/spaceyard/shared/pkg/money"
+)
+
+type PaymentService interface {
+	// PayOrder проводит оплату заказа и возвращает сохранённую транзакцию.
+	PayOrder(ctx context.Context, info model.PayOrderInfo) (model.Transaction, error)
+	// AuthorizePayment удерживает сумму до списания, отмены или истечения авторизации.
+	AuthorizePayment(ctx context.Context, info model.PayOrderInfo) (model.Transaction, error)
+	// CapturePayment списывает авторизованную сумму, nil amount означает всю сумму.
+	CapturePayment(ctx context.Context, transactionUUID string, amount *money.Money) (model.Transaction, error)
+	VoidAuthorization(ctx context.Context, transactionUUID string) (model.Transaction, error)
+	// CreateSBPPaymentIntent создаёт платёж СБП, ожидающий оплаты по QR-коду.
+	CreateSBPPaymentIntent(ctx context.Context, info model.PayOrderInfo, format model.QRImageFormat) (model.SBPPaymentIntent, error)
+	GetSBPPaymentIntent(ctx context.Context, transactionUUID string, format model.QRImageFormat) (model.SBPPaymentIntent, error)
+	// ConfirmSBPPayment проводит оплату платежа СБП, как если бы покупатель отсканировал QR-код.
+	ConfirmSBPPayment(ctx context.Context, transactionUUID string) (model.Transaction, error)
+	GetTransaction(ctx context.Context, uuid string) (model.Transaction, error)
+	ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error)
+	// RefundPayment возвращает часть или весь остаток списанной по транзакции суммы.
+	RefundPayment(ctx context.Context, info model.RefundInfo) (model.Refund, error)
+	GetRefund(ctx context.Context, uuid string) (model.Refund, error)
+	ListRefunds(ctx context.Context, transactionUUID string) ([]model.Refund, error)
+	// ListAccountBalances возвращает обороты и сальдо счетов книги по валютам.
+	ListAccountBalances(ctx context.Context, filter model.AccountBalancesFilter) ([]model.AccountBalance, error)
+	ListJournalEntries(ctx context.Context, transactionUUID string) ([]model.JournalEntry, error)
+	// CheckLedger сверяет книгу и возвращает найденные расхождения.
+	CheckLedger(ctx context.Context) (model.LedgerReport, error)
+	CreateInvestor(ctx context.Context, info model.InvestorInfo) (model.Investor, error)
+	GetInvestor(ctx context.Context, uuid string) (model.Investor, error)
+	ListInvestors(ctx context.Context) ([]model.Investor, error)
+	// TopUpInvestor зачисляет сумму на свободный остаток инвестора.
+	TopUpInvestor(ctx context.Context, investorUUID string, amount money.Money) (model.Investor, error)
+	GrantInvestorAccess(ctx context.Context, investorUUID, userUUID string) (model.Investor, error)
+	RevokeInvestorAccess(ctx context.Context, investorUUID, userUUID string) (model.Investor, error)
+	// ListInvestorMovements возвращает пополнения, удержания, списания и возвраты инвестора.
+	ListInvestorMovements(ctx context.Context, investorUUID string) ([]model.InvestorMovement, error)
+	// ListFraudDecisions возвращает решения антифрода с причинами блокировок.
+	ListFraudDecisions(ctx context.Context, filter model.FraudDecisionsFilter) ([]model.FraudDecision, error)
+	// QuoteInstallmentPlan рассчитывает график рассрочки без оформления плана.
+	QuoteInstallmentPlan(ctx context.Context, amount money.Money, termMonths int) (model.InstallmentQuote, error)
+	GetInstallmentPlan(ctx context.Context, uuid string) (model.InstallmentPlan, error)
+	ListInstallmentPlans(ctx context.Context, filter model.InstallmentPlansFilter) ([]model.InstallmentPlan, error)
+	// SubscribePaymentEvents отправляет в send события с номерами больше afterSequence и новые
+	// события по мере появления, пока не отменён ctx или send не вернул ошибку.
+	SubscribePaymentEvents(ctx context.Context, afterSequence int64, send func(event model.PaymentEvent) error) error
+	// CreateWebhookSubscription регистрирует адрес подписчика и возвращает подписку с секретом подписи.
+	CreateWebhookSubscription(ctx context.Context, info model.WebhookSubscriptionInfo) (model.WebhookSubscription, error)
+	// ListWebhookSubscriptions возвращает подписки без секретов.
+	ListWebhookSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, subscriptionUUID string) error
+	ListWebhookDeliveries(ctx context.Context, filter model.WebhookDeliveriesFilter) ([]model.WebhookDelivery, error)
+	// ReplayWebhookDeliveries снова ставит в очередь недоставленные события подписки.
+	ReplayWebhookDeliveries(ctx context.Context, subscriptionUUID string, deliveryUUIDs []string) ([]model.WebhookDelivery, error)
+	// SaveCard шифрует и сохраняет карту и возвращает её с токеном для оплаты.
+	SaveCard(ctx context.Context, info model.CardInfo) (model.PaymentCard, error)
+	// ListCards возвращает сохранённые карты пользователя с замаскированными номерами.
+	ListCards(ctx context.Context, userUUID string) ([]model.PaymentCard, error)
+	DeleteCard(ctx context.Context, userUUID, token string) error
+	// ReencryptCards перешифровывает сохранённые карты активным ключом связки.
+	ReencryptCards(ctx context.Context) (model.CardReencryption, error)
+	// GetReceipt возвращает чек списанной транзакции, выписывая его, если он ещё не выписан.
+	GetReceipt(ctx context.Context, transactionUUID string) (model.Receipt, error)
+	// OpenDispute регистрирует спор эмитента по списанной картой транзакции.
+	OpenDispute(ctx context.Context, info model.DisputeInfo) (model.Dispute, error)
+	GetDispute(ctx context.Context, uuid string) (model.Dispute, error)
+	ListDisputes(ctx context.Context, filter model.DisputesFilter) ([]model.Dispute, error)
+	// SubmitDisputeEvidence оспаривает спор доказательствами до истечения срока ответа.
+	SubmitDisputeEvidence(ctx context.Context, uuid string, evidence model.DisputeEvidence) (model.Dispute, error)
+	// AcceptDispute соглашается со спором, возвращая спорную сумму покупателю.
+	AcceptDispute(ctx context.Context, uuid string) (model.Dispute, error)
+	// ResolveDispute фиксирует решение эмитента: проигрыш возвращает спорную сумму покупателю.
+	ResolveDispute(ctx context.Context, uuid string, outcome model.DisputeOutcome) (model.Dispute, error)
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// keySize — длина ключа AES-256 в байтах.
+const keySize = 32
+
+// Config — содержимое файла связки ключей. Новые данные шифруются активным ключом,
+// остальные ключи нужны только для расшифровки ранее зашифрованных данных.
+//
+//	{"active_key_id": "2026-10", "keys": [{"id": "2026-01", "key": "<base64>"}, {"id": "2026-10", "key": "<base64>"}]}
+type Config struct {
+	ActiveKeyID string      `json:"active_key_id"`
+	Keys        []KeyConfig `json:"keys"`
+}
+
+// KeyConfig — ключ AES-256 в base64 и его идентификатор, который сохраняется
+// рядом с зашифрованными им данными.
+type KeyConfig struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+// LoadConfig читает связку ключей из JSON-файла и проверяет ключи.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задаёт оператор сервиса
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to parse keyring: %w", err)
+	}
+	if _, err := config.keys(); err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+// keys декодирует ключи и проверяет, что идентификаторы уникальны, а активный ключ есть в связке.
+func (c Config) keys() (map[string][]byte, error) {
+	if len(c.Keys) == 0 {
+		return nil, errors.New("keyring has no keys")
+	}
+
+	keys := make(map[string][]byte, len(c.Keys))
+	for i, k := range c.Keys {
+		if k.ID == "" {
+			return nil, fmt.Errorf("key %d has no id", i)
+		}
+		if _, ok := keys[k.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", k.ID)
+		}
+		key, err := base64.StdEncoding.DecodeString(k.Key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64", k.ID)
+		}
+		if len(key) != keySize {
+			return nil, fmt.Errorf("key %q must be %d bytes long, got %d", k.ID, keySize, len(key))
+		}
+		keys[k.ID] = key
+	}
+
+	if _, ok := keys[c.ActiveKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q is not in the keyring", c.ActiveKeyID)
+	}
+
+	return keys, nil
+}
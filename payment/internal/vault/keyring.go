@@ -0,0 +1,190 @@
+// Package vault шифрует данные сохранённых карт AES-256-GCM ключами из файла связки ключей.
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// ErrUnknownKey — данные зашифрованы ключом, которого больше нет в связке.
+var ErrUnknownKey = errors.New("encryption key is not in the keyring")
+
+// Keyring шифрует и расшифровывает данные ключами из файла. Файл перечитывается
+// при изменении, поэтому ключ можно сменить без перезапуска: новый ключ добавляется
+// в файл и становится активным, а старый удаляется после перешифрования данных.
+type Keyring struct {
+	path string
+	keys atomic.Pointer[keySet]
+
+	// modTime и size — отметка прочитанной версии файла, меняются только в Run.
+	modTime time.Time
+	size    int64
+}
+
+// keySet — ключи одной версии файла, готовые к шифрованию.
+type keySet struct {
+	activeKeyID string
+	aeads       map[string]cipher.AEAD
+}
+
+// NewKeyring создаёт связку ключей из файла path. Пустой path означает связку без ключей:
+// шифрование и расшифровка возвращают model.ErrCardVaultUnavailable.
+func NewKeyring(path string) (*Keyring, error) {
+	k := &Keyring{path: path}
+	k.keys.Store(&keySet{})
+
+	if path == "" {
+		return k, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	warnPermissions(path, info)
+
+	set, err := loadKeySet(path)
+	if err != nil {
+		return nil, err
+	}
+	k.keys.Store(set)
+	k.modTime, k.size = info.ModTime(), info.Size()
+
+	return k, nil
+}
+
+// Run периодически проверяет файл ключей и перечитывает его при изменении до отмены ctx.
+// Если новая версия некорректна, продолжают действовать прежние ключи.
+func (k *Keyring) Run(ctx context.Context, interval time.Duration) {
+	if k.path == "" {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			k.reload()
+		}
+	}
+}
+
+func (k *Keyring) reload() {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		log.Printf("failed to stat card keyring %s: %v", k.path, err)
+		return
+	}
+	if info.ModTime().Equal(k.modTime) && info.Size() == k.size {
+		return
+	}
+	k.modTime, k.size = info.ModTime(), info.Size()
+	warnPermissions(k.path, info)
+
+	set, err := loadKeySet(k.path)
+	if err != nil {
+		log.Printf("failed to reload card keyring %s, keeping previous keys: %v", k.path, err)
+		return
+	}
+	k.keys.Store(set)
+
+	log.Printf("Связка ключей карт перечитана из %s, активный ключ: %s", k.path, set.activeKeyID)
+}
+
+// ActiveKeyID возвращает ключ, которым шифруются новые данные, или пустую строку,
+// если ключи не настроены.
+func (k *Keyring) ActiveKeyID() string {
+	return k.keys.Load().activeKeyID
+}
+
+// Seal шифрует plaintext активным ключом. aad привязывает шифротекст к записи:
+// расшифровать его можно только с теми же дополнительными данными.
+func (k *Keyring) Seal(plaintext, aad []byte) (model.SealedData, error) {
+	set := k.keys.Load()
+	aead, ok := set.aeads[set.activeKeyID]
+	if !ok {
+		return model.SealedData{}, model.ErrCardVaultUnavailable
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return model.SealedData{}, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return model.SealedData{
+		KeyID:      set.activeKeyID,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, aad),
+	}, nil
+}
+
+// Open расшифровывает данные ключом, которым они были зашифрованы.
+func (k *Keyring) Open(sealed model.SealedData, aad []byte) ([]byte, error) {
+	set := k.keys.Load()
+	if len(set.aeads) == 0 {
+		return nil, model.ErrCardVaultUnavailable
+	}
+	aead, ok := set.aeads[sealed.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, sealed.KeyID)
+	}
+	if len(sealed.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce of key %q", sealed.KeyID)
+	}
+
+	plaintext, err := aead.Open(nil, sealed.Nonce, sealed.Ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data of key %q: %w", sealed.KeyID, err)
+	}
+	return plaintext, nil
+}
+
+func loadKeySet(path string) (*keySet, error) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := config.keys()
+	if err != nil {
+		return nil, err
+	}
+
+	set := &keySet{
+		activeKeyID: config.ActiveKeyID,
+		aeads:       make(map[string]cipher.AEAD, len(keys)),
+	}
+	for id, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		set.aeads[id] = aead
+	}
+
+	return set, nil
+}
+
+// warnPermissions предупреждает, если файл ключей доступен не только владельцу.
+func warnPermissions(path string, info os.FileInfo) {
+	if info.Mode().Perm()&0o077 != 0 {
+		log.Printf("warning: card keyring %s is accessible by other users (mode %v), restrict it to 0600", path, info.Mode().Perm())
+	}
+}
@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+)
+
+// writeKeyring записывает связку ключей с активным ключом activeKeyID. Ключ с идентификатором id
+// состоит из повторённого первого байта id, чтобы разные идентификаторы давали разные ключи.
+func writeKeyring(t *testing.T, path, activeKeyID string, ids ...string) {
+	t.Helper()
+
+	config := Config{ActiveKeyID: activeKeyID}
+	for _, id := range ids {
+		key := bytes.Repeat([]byte{id[0]}, keySize)
+		config.Keys = append(config.Keys, KeyConfig{ID: id, Key: base64.StdEncoding.EncodeToString(key)})
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal keyring: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write keyring: %v", err)
+	}
+}
+
+func TestKeyringRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keyring.json")
+	writeKeyring(t, path, "a", "a")
+
+	k, err := NewKeyring(path)
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+
+	number, aad := []byte("4111111111111111"), []byte("card-token")
+	sealed, err := k.Seal(number, aad)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if sealed.KeyID != "a" || bytes.Contains(sealed.Ciphertext, number) {
+		t.Fatalf("Seal() = key %q, ciphertext %x", sealed.KeyID, sealed.Ciphertext)
+	}
+	// Шифротекст привязан к записи.
+	if _, err := k.Open(sealed, []byte("other-token")); err == nil {
+		t.Fatal("Open() with other aad succeeded")
+	}
+
+	// Новый ключ становится активным, данные старого по-прежнему расшифровываются.
+	writeKeyring(t, path, "b", "a", "b")
+	k.modTime = time.Time{}
+	k.reload()
+	if got := k.ActiveKeyID(); got != "b" {
+		t.Fatalf("ActiveKeyID() = %q, want %q", got, "b")
+	}
+	plaintext, err := k.Open(sealed, aad)
+	if err != nil || !bytes.Equal(plaintext, number) {
+		t.Fatalf("Open() = %q, error = %v", plaintext, err)
+	}
+	resealed, err := k.Seal(plaintext, aad)
+	if err != nil || resealed.KeyID != "b" {
+		t.Fatalf("Seal() = key %q, error = %v", resealed.KeyID, err)
+	}
+
+	// Некорректная версия файла не заменяет действующие ключи.
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("failed to write keyring: %v", err)
+	}
+	k.reload()
+	if got := k.ActiveKeyID(); got != "b" {
+		t.Fatalf("ActiveKeyID() after broken file = %q, want %q", got, "b")
+	}
+
+	// После удаления старого ключа его данные не расшифровать.
+	writeKeyring(t, path, "b", "b")
+	k.modTime = time.Time{}
+	k.reload()
+	if _, err := k.Open(sealed, aad); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("Open() error = %v, want %v", err, ErrUnknownKey)
+	}
+	if _, err := k.Open(resealed, aad); err != nil {
+		t.Fatalf("Open() resealed error = %v", err)
+	}
+}
+
+func TestKeyringWithoutKeys(t *testing.T) {
+	k, err := NewKeyring("")
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+	if _, err := k.Seal([]byte("4111111111111111"), nil); !errors.Is(err, model.ErrCardVaultUnavailable) {
+		t.Fatalf("Seal() error = %v, want %v", err, model.ErrCardVaultUnavailable)
+	}
+	if _, err := k.Open(model.SealedData{KeyID: "a"}, nil); !errors.Is(err, model.ErrCardVaultUnavailable) {
+		t.Fatalf("Open() error = %v, want %v", err, model.ErrCardVaultUnavailable)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize))
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "valid", data: `{"active_key_id": "a", "keys": [{"id": "a", "key": "` + key + `"}]}`},
+		{name: "no keys", data: `{"active_key_id": "a", "keys": []}`, wantErr: true},
+		{name: "unknown active key", data: `{"active_key_id": "b", "keys": [{"id": "a", "key": "` + key + `"}]}`, wantErr: true},
+		{name: "duplicate id", data: `{"active_key_id": "a", "keys": [{"id": "a", "key": "` + key + `"}, {"id": "a", "key": "` + key + `"}]}`, wantErr: true},
+		{name: "no id", data: `{"active_key_id": "", "keys": [{"key": "` + key + `"}]}`, wantErr: true},
+		{name: "short key", data: `{"active_key_id": "a", "keys": [{"id": "a", "key": "AAAA"}]}`, wantErr: true},
+		{name: "not base64", data: `{"active_key_id": "a", "keys": [{"id": "a", "key": "***"}]}`, wantErr: true},
+		{name: "not json", data: `active_key_id = "a"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keyring.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("failed to write keyring: %v", err)
+			}
+			if _, err := LoadConfig(path); (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+var (
+	ErrInsecureURL      = errors.New("webhook url must be an absolute https url")
+	ErrForbiddenAddress = errors.New("webhook host resolves to a non-public address")
+)
+
+// ValidateURL проверяет адрес подписчика: только https и только публичные хосты.
+// Имя хоста разрешается, и если хотя бы один из его адресов ведёт в loopback,
+// частную или link-local сеть, адрес отклоняется. Это не заменяет проверку при
+// соединении: DNS-запись может измениться после регистрации.
+//
+// Отправитель, созданный с allowInsecure, принимает и http, и любые адреса.
+func (s *Sender) ValidateURL(ctx context.Context, rawURL string) error {
+	u, err := s.parseURL(rawURL)
+	if err != nil {
+		return err
+	}
+	if s.allowInsecure {
+		return nil
+	}
+
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return checkAddr(addr)
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook host %q: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if err := checkAddr(addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseURL разбирает абсолютный адрес с хостом и без учётных данных.
+// Схема http допускается только при allowInsecure.
+func (s *Sender) parseURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" || u.User != nil {
+		return nil, ErrInsecureURL
+	}
+	if u.Scheme != "https" && (!s.allowInsecure || u.Scheme != "http") {
+		return nil, ErrInsecureURL
+	}
+
+	return u, nil
+}
+
+// dialControl вызывается для каждого соединения после разрешения имени и не даёт
+// подключиться к непубличному адресу, даже если DNS-запись подменили после регистрации.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	return checkAddr(addrPort.Addr())
+}
+
+// checkAddr отклоняет loopback, частные, link-local и прочие неглобальные адреса.
+func checkAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLinkLocalUnicast() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+
+	return nil
+}
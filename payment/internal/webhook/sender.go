@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Denisz0785/spaceyard/payment/internal/converter"
+	"github.com/Denisz0785/spaceyard/payment/internal/model"
+	paymentv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1"
+)
+
+// DeliveryHeader — заголовок с идентификатором доставки. Повторы одной доставки
+// приходят с тем же значением, по нему подписчик отбрасывает дубликаты.
+const DeliveryHeader = "X-Spaceyard-Delivery"
+
+// maxResponseBody — сколько байт ответа подписчика читается перед закрытием соединения.
+const maxResponseBody = 64 << 10
+
+// Payload возвращает тело запроса — сообщение WebhookPayload в JSON с именами полей из proto.
+func Payload(deliveryUUID string, event model.PaymentEvent) ([]byte, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(&paymentv1.WebhookPayload{
+		DeliveryUuid: deliveryUUID,
+		Event:        converter.PaymentEventToProto(event),
+	})
+}
+
+// Sender отправляет подписанные события на адреса подписчиков.
+type Sender struct {
+	client *http.Client
+	// allowInsecure разрешает http и непубличные адреса — для локальной разработки.
+	allowInsecure bool
+}
+
+// NewSender создаёт отправителя, ждущего ответа подписчика не дольше timeout.
+// Соединения к непубличным адресам запрещены, прокси из окружения не используется,
+// а перенаправления не выполняются: ответ 3xx считается неудачной доставкой.
+// С allowInsecure отправитель доставляет и по http, и на loopback и частные адреса.
+func NewSender(timeout time.Duration, allowInsecure bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowInsecure {
+		dialer.Control = dialControl
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Sender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		allowInsecure: allowInsecure,
+	}
+}
+
+// Send отправляет body POST-запросом на rawURL. Ответ вне диапазона 2xx считается ошибкой.
+func (s *Sender) Send(ctx context.Context, rawURL, secret, deliveryUUID string, body []byte) error {
+	// Подписки, созданные до запрета http, не получают событий по открытому каналу.
+	if _, err := s.parseURL(rawURL); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, deliveryUUID)
+	req.Header.Set(SignatureHeader, Sign(secret, time.Now(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	// Дочитываем ответ, чтобы соединение вернулось в пул.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader — заголовок с подписью тела запроса вида "t=<unix-время>,v1=<hex HMAC-SHA256>".
+// Подписывается строка "<unix-время>.<тело>", поэтому перехваченный запрос нельзя
+// повторить с другим временем.
+const SignatureHeader = "X-Spaceyard-Signature"
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredSignature = errors.New("webhook signature is too old")
+)
+
+// Sign возвращает значение SignatureHeader для тела body, отправленного в момент at.
+func Sign(secret string, at time.Time, body []byte) string {
+	timestamp := at.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, signature(secret, timestamp, body))
+}
+
+// Verify проверяет подпись header тела body. Подпись старше tolerance отклоняется.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var timestamp int64
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			var err error
+			if timestamp, err = strconv.ParseInt(value, 10, 64); err != nil {
+				return ErrInvalidSignature
+			}
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	expected := signature(secret, timestamp, body)
+	valid := false
+	for _, s := range signatures {
+		if hmac.Equal([]byte(s), []byte(expected)) {
+			valid = true
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+	if now.Sub(time.Unix(timestamp, 0)).Abs() > tolerance {
+		return ErrExpiredSignature
+	}
+
+	return nil
+}
+
+func signature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(strconv.AppendInt(nil, timestamp, 10))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	const secret = "whsec_test"
+	body := []byte(`{"delivery_uuid":"d"}`)
+	signedAt := time.Unix(1_700_000_000, 0)
+	header := Sign(secret, signedAt, body)
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid", secret: secret, header: header, body: body, now: signedAt.Add(time.Minute)},
+		{
+			name:   "one of several signatures matches",
+			secret: secret,
+			header: header + ",v1=00",
+			body:   body,
+			now:    signedAt,
+		},
+		{name: "wrong secret", secret: "whsec_other", header: header, body: body, now: signedAt, wantErr: ErrInvalidSignature},
+		{name: "tampered body", secret: secret, header: header, body: []byte(`{}`), now: signedAt, wantErr: ErrInvalidSignature},
+		{name: "too old", secret: secret, header: header, body: body, now: signedAt.Add(6 * time.Minute), wantErr: ErrExpiredSignature},
+		{name: "from the future", secret: secret, header: header, body: body, now: signedAt.Add(-6 * time.Minute), wantErr: ErrExpiredSignature},
+		{name: "no timestamp", secret: secret, header: "v1=00", body: body, now: signedAt, wantErr: ErrInvalidSignature},
+		{name: "no signature", secret: secret, header: "t=1700000000", body: body, now: signedAt, wantErr: ErrInvalidSignature},
+		{name: "malformed timestamp", secret: secret, header: "t=abc,v1=00", body: body, now: signedAt, wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.now, 5*time.Minute)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignReplayWithOtherTimestamp(t *testing.T) {
+	const secret = "whsec_test"
+	body := []byte(`{}`)
+	signedAt := time.Unix(1_700_000_000, 0)
+
+	// Подпись, перенесённая на другое время, не подходит: время входит в подписываемую строку.
+	_, sig, _ := strings.Cut(Sign(secret, signedAt, body), ",")
+	forged := "t=1700000060," + sig
+	if err := Verify(secret, forged, body, signedAt.Add(time.Minute), 5*time.Minute); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify(forged) error = %v, want %v", err, ErrInvalidSignature)
+	}
+}
@@ -0,0 +1,50 @@
+// Package currency пересчитывает суммы между валютами по локальной таблице курсов
+// и округляет их до минимальных единиц валюты.
+package currency
+
+import (
+	"math/big"
+	"regexp"
+)
+
+// RateDecimals — знаков после запятой в курсах. Кросс-курсы округляются до этой точности,
+// поэтому записанный курс воспроизводит пересчёт сумм без расхождений.
+const RateDecimals = 9
+
+// codePattern — трёхбуквенный код валюты ISO 4217.
+var codePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// minorUnits — валюты, у которых число знаков после запятой отличается от двух.
+var minorUnits = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// ValidCode сообщает, что code похож на код валюты ISO 4217.
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// MinorUnits возвращает число знаков после запятой в суммах валюты code.
+func MinorUnits(code string) int {
+	if digits, ok := minorUnits[code]; ok {
+		return digits
+	}
+	return 2
+}
+
+// Round округляет amount до минимальных единиц валюты code половиной от нуля:
+// 0.005 USD становится 0.01, а -0.005 USD — -0.01.
+func Round(amount *big.Rat, code string) *big.Rat {
+	return roundTo(amount, MinorUnits(code))
+}
@@ -0,0 +1,56 @@
+package currency
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// decimalPattern — десятичная запись без экспоненты, например "-1234.50".
+var decimalPattern = regexp.MustCompile(`^[+-]?\d+(\.\d+)?$`)
+
+// ParseDecimal разбирает десятичную строку без потери точности.
+func ParseDecimal(value string) (*big.Rat, error) {
+	if !decimalPattern.MatchString(value) {
+		return nil, fmt.Errorf("%q is not a decimal number", value)
+	}
+
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal number", value)
+	}
+
+	return r, nil
+}
+
+// FormatDecimal записывает конечную десятичную дробь без лишних нулей, например "1234.5".
+// Бесконечные дроби записываются с 9 знаками после запятой, округлёнными половиной от нуля.
+func FormatDecimal(r *big.Rat) string {
+	digits, exact := r.FloatPrec()
+	if !exact || digits > RateDecimals {
+		digits = RateDecimals
+		r = roundTo(r, digits)
+	}
+
+	s := r.FloatString(digits)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// roundTo округляет r до digits знаков после запятой половиной от нуля.
+func roundTo(r *big.Rat, digits int) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(scale))
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+
+	// |rem| / denom >= 1/2 ⇔ 2|rem| >= denom
+	if rem.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(scaled.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(scaled.Sign())))
+	}
+
+	return new(big.Rat).SetFrac(quo, scale)
+}
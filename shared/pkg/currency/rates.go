@@ -0,0 +1,196 @@
+package currency
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"time"
+)
+
+// effectiveDateLayout — формат дат начала действия курсов в файле таблицы.
+const effectiveDateLayout = "2006-01-02"
+
+var (
+	ErrUnknownCurrency = errors.New("currency has no exchange rate")
+	ErrNoRate          = errors.New("exchange rate is not effective yet")
+)
+
+// Rates — таблица курсов валют к базовой валюте с датами начала действия.
+type Rates struct {
+	base string
+	// history — курсы валют по возрастанию даты начала действия.
+	history map[string][]rate
+}
+
+type rate struct {
+	// value — сколько единиц базовой валюты стоит одна единица валюты.
+	value         *big.Rat
+	effectiveFrom time.Time
+}
+
+// Conversion — результат пересчёта суммы.
+type Conversion struct {
+	// Amount — сумма в целевой валюте, округлённая до её минимальных единиц.
+	Amount *big.Rat
+	// Rate — сколько единиц целевой валюты стоит одна единица исходной.
+	Rate *big.Rat
+	// EffectiveFrom — начало действия самого позднего из использованных курсов,
+	// нулевое при пересчёте в ту же валюту.
+	EffectiveFrom time.Time
+}
+
+// fileConfig — JSON-файл таблицы курсов, например:
+//
+//	{
+//	  "base_currency": "RUB",
+//	  "rates": [
+//	    {"currency_code": "USD", "rate": "92.5", "effective_from": "2026-01-01"},
+//	    {"currency_code": "USD", "rate": "90.1", "effective_from": "2026-07-01"}
+//	  ]
+//	}
+//
+// Курс действует с полуночи UTC даты effective_from до начала действия следующего курса той же валюты.
+type fileConfig struct {
+	BaseCurrency string `json:"base_currency"`
+	Rates        []struct {
+		CurrencyCode  string `json:"currency_code"`
+		Rate          string `json:"rate"`
+		EffectiveFrom string `json:"effective_from"`
+	} `json:"rates"`
+}
+
+// NewRates создаёт пустую таблицу: пересчёт возможен только из валюты base в неё же.
+func NewRates(base string) *Rates {
+	return &Rates{base: base, history: make(map[string][]rate)}
+}
+
+// LoadRates читает таблицу курсов из JSON-файла path.
+func LoadRates(path string) (*Rates, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задаётся конфигурацией сервиса
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var config fileConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	if !ValidCode(config.BaseCurrency) {
+		return nil, fmt.Errorf("%s: invalid base currency %q", path, config.BaseCurrency)
+	}
+
+	rates := NewRates(config.BaseCurrency)
+	for i, entry := range config.Rates {
+		if !ValidCode(entry.CurrencyCode) || entry.CurrencyCode == config.BaseCurrency {
+			return nil, fmt.Errorf("%s: rate %d: invalid currency %q", path, i, entry.CurrencyCode)
+		}
+
+		value, err := ParseDecimal(entry.Rate)
+		if err != nil || value.Sign() <= 0 {
+			return nil, fmt.Errorf("%s: rate %d: rate %q must be a positive decimal", path, i, entry.Rate)
+		}
+		if digits, _ := value.FloatPrec(); digits > RateDecimals {
+			return nil, fmt.Errorf("%s: rate %d: rate %q has more than %d fractional digits", path, i, entry.Rate, RateDecimals)
+		}
+
+		effectiveFrom, err := time.Parse(effectiveDateLayout, entry.EffectiveFrom)
+		if err != nil {
+			return nil, fmt.Errorf("%s: rate %d: effective_from %q must be a YYYY-MM-DD date", path, i, entry.EffectiveFrom)
+		}
+
+		history := rates.history[entry.CurrencyCode]
+		if slices.ContainsFunc(history, func(r rate) bool { return r.effectiveFrom.Equal(effectiveFrom) }) {
+			return nil, fmt.Errorf("%s: rate %d: %s already has a rate effective from %s", path, i, entry.CurrencyCode, entry.EffectiveFrom)
+		}
+		rates.history[entry.CurrencyCode] = append(history, rate{value: value, effectiveFrom: effectiveFrom})
+	}
+
+	for _, history := range rates.history {
+		slices.SortFunc(history, func(a, b rate) int { return a.effectiveFrom.Compare(b.effectiveFrom) })
+	}
+
+	return rates, nil
+}
+
+// Base возвращает базовую валюту таблицы.
+func (r *Rates) Base() string {
+	return r.base
+}
+
+// Rate возвращает курс, действующий в момент at: сколько единиц валюты to стоит одна
+// единица валюты from. Кросс-курс через базовую валюту округляется до RateDecimals знаков.
+func (r *Rates) Rate(from, to string, at time.Time) (*big.Rat, time.Time, error) {
+	if from == to {
+		return big.NewRat(1, 1), time.Time{}, nil
+	}
+
+	fromRate, fromEffective, err := r.baseRate(from, at)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	toRate, toEffective, err := r.baseRate(to, at)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	value := roundTo(new(big.Rat).Quo(fromRate, toRate), RateDecimals)
+	if value.Sign() == 0 {
+		return nil, time.Time{}, fmt.Errorf("%w: %s to %s rate is below %d fractional digits", ErrUnknownCurrency, from, to, RateDecimals)
+	}
+
+	effectiveFrom := fromEffective
+	if toEffective.After(effectiveFrom) {
+		effectiveFrom = toEffective
+	}
+
+	return value, effectiveFrom, nil
+}
+
+// Convert пересчитывает amount из валюты from в валюту to по курсу, действующему
+// в момент at, и округляет результат до минимальных единиц валюты to.
+func (r *Rates) Convert(amount *big.Rat, from, to string, at time.Time) (Conversion, error) {
+	value, effectiveFrom, err := r.Rate(from, to, at)
+	if err != nil {
+		return Conversion{}, err
+	}
+
+	return Conversion{
+		Amount:        ConvertAt(amount, value, to),
+		Rate:          value,
+		EffectiveFrom: effectiveFrom,
+	}, nil
+}
+
+// ConvertAt пересчитывает amount по известному курсу rate в валюту to с тем же округлением,
+// что и Convert. Так сумму можно пересчитать повторно по курсу, записанному ранее.
+func ConvertAt(amount, rate *big.Rat, to string) *big.Rat {
+	return Round(new(big.Rat).Mul(amount, rate), to)
+}
+
+// baseRate возвращает курс валюты code к базовой, действующий в момент at.
+func (r *Rates) baseRate(code string, at time.Time) (*big.Rat, time.Time, error) {
+	if code == r.base {
+		return big.NewRat(1, 1), time.Time{}, nil
+	}
+
+	history, ok := r.history[code]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrUnknownCurrency, code)
+	}
+
+	// Последний курс, начавший действовать не позже at.
+	i, _ := slices.BinarySearchFunc(history, at, func(r rate, at time.Time) int {
+		if r.effectiveFrom.After(at) {
+			return 1
+		}
+		return -1
+	})
+	if i == 0 {
+		return nil, time.Time{}, fmt.Errorf("%w: %s has no rate before %s", ErrNoRate, code, history[0].effectiveFrom.Format(effectiveDateLayout))
+	}
+
+	return history[i-1].value, history[i-1].effectiveFrom, nil
+}
@@ -0,0 +1,118 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Validator проверяет входящие запросы по аннотациям buf.validate из proto-файлов сервиса.
+type Validator struct {
+	validator protovalidate.Validator
+	// domain и reason попадают в google.rpc.ErrorInfo, чтобы клиенты отличали ошибки сервиса.
+	domain string
+	reason string
+}
+
+// NewValidator создаёт валидатор. domain и reason — домен сервиса и его причина
+// ERROR_REASON_INVALID_ARGUMENT, например "payment.spaceyard".
+func NewValidator(domain, reason string) (*Validator, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Validator{
+		validator: validator,
+		domain:    domain,
+		reason:    reason,
+	}, nil
+}
+
+// Unary возвращает interceptor, который отклоняет невалидные запросы с InvalidArgument
+// до вызова обработчика.
+func (v *Validator) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := v.validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream возвращает interceptor, который проверяет каждое сообщение клиентского потока.
+func (v *Validator) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, validator: v})
+	}
+}
+
+// validate возвращает статус InvalidArgument с google.rpc.BadRequest для всех нарушений.
+func (v *Validator) validate(req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := v.validator.Validate(msg)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		// Ошибка компиляции или выполнения правил — проблема сервиса, а не запроса.
+		log.Printf("failed to validate %T: %v", req, err)
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErr.Violations))
+	for _, violation := range validationErr.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       protovalidate.FieldPathString(violation.Proto.GetField()),
+			Description: violation.Proto.GetMessage(),
+			Reason:      violation.Proto.GetRuleId(),
+		})
+	}
+
+	first := violations[0]
+	message := "invalid request: " + first.GetDescription()
+	if first.GetField() != "" {
+		message = "invalid " + first.GetField() + ": " + first.GetDescription()
+	}
+
+	st, detailsErr := status.New(codes.InvalidArgument, message).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   v.reason,
+			Domain:   v.domain,
+			Metadata: map[string]string{"field": first.GetField()},
+		},
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+	if detailsErr != nil {
+		log.Printf("failed to attach error details: %v", detailsErr)
+		return status.Error(codes.InvalidArgument, message)
+	}
+
+	return st.Err()
+}
+
+// validatingStream проверяет сообщения, которые обработчик читает из потока.
+type validatingStream struct {
+	grpc.ServerStream
+	validator *Validator
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.validator.validate(m)
+}
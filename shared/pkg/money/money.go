@@ -0,0 +1,219 @@
+// Package money описывает точные денежные суммы, общие для всех сервисов: целые единицы
+// и нано-единицы валюты, как в protobuf-сообщении money.v1.Money. Суммы складываются
+// без погрешностей float64 и округляются половиной от нуля.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Denisz0785/spaceyard/shared/pkg/currency"
+)
+
+// nanosPerUnit — количество нано-единиц в одной целой единице.
+const nanosPerUnit = 1_000_000_000
+
+// ErrCurrencyMismatch — суммы в разных валютах нельзя сложить или сравнить.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// amountPattern — десятичная запись суммы, не больше 9 знаков после запятой, например "-1234.50".
+var amountPattern = regexp.MustCompile(`^-?\d+(\.\d{1,9})?$`)
+
+// Money — точная сумма в валюте: целые единицы и нано-единицы (10^-9) одного знака.
+type Money struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+// Zero возвращает нулевую сумму в валюте currency.
+func Zero(currency string) Money {
+	return Money{CurrencyCode: currency}
+}
+
+// IsPositive сообщает, что сумма строго больше нуля.
+func (m Money) IsPositive() bool {
+	return m.Units > 0 || (m.Units == 0 && m.Nanos > 0)
+}
+
+// IsNegative сообщает, что сумма строго меньше нуля.
+func (m Money) IsNegative() bool {
+	return m.Units < 0 || (m.Units == 0 && m.Nanos < 0)
+}
+
+// IsZero сообщает, что сумма равна нулю независимо от валюты.
+func (m Money) IsZero() bool {
+	return m.Units == 0 && m.Nanos == 0
+}
+
+// Add возвращает сумму m и o в валюте m. Валюты должен сверять вызывающий.
+func (m Money) Add(o Money) Money {
+	return normalize(m.CurrencyCode, m.Units+o.Units, int64(m.Nanos)+int64(o.Nanos))
+}
+
+// Sub возвращает разность m и o в валюте m. Валюты должен сверять вызывающий.
+func (m Money) Sub(o Money) Money {
+	return normalize(m.CurrencyCode, m.Units-o.Units, int64(m.Nanos)-int64(o.Nanos))
+}
+
+// Cmp сравнивает суммы: -1, если m < o, 0, если равны, и 1, если m > o.
+func (m Money) Cmp(o Money) int {
+	d := m.Sub(o)
+	switch {
+	case d.IsPositive():
+		return 1
+	case d.IsZero():
+		return 0
+	default:
+		return -1
+	}
+}
+
+// Sum складывает суммы в валюте currency. Сумма в другой валюте даёт ErrCurrencyMismatch.
+func Sum(currency string, amounts ...Money) (Money, error) {
+	total := Zero(currency)
+	for _, amount := range amounts {
+		if amount.CurrencyCode != currency {
+			return Money{}, fmt.Errorf("%w: %s amount in a %s sum", ErrCurrencyMismatch, amount.CurrencyCode, currency)
+		}
+		total = total.Add(amount)
+	}
+	return total, nil
+}
+
+// MulBasisPoints возвращает долю суммы в базисных пунктах (1 б.п. = 0,01%),
+// округлённую до нано-единиц половиной от нуля.
+func (m Money) MulBasisPoints(bps int64) Money {
+	const basisPointsPerUnit = 10_000
+
+	total := m.TotalNanos()
+	total.Mul(total, big.NewInt(bps))
+
+	return FromNanos(m.CurrencyCode, quoRound(total, big.NewInt(basisPointsPerUnit)))
+}
+
+// Round округляет сумму до минимальных единиц её валюты (копеек для RUB) половиной от нуля.
+func (m Money) Round() Money {
+	return FromRat(m.CurrencyCode, currency.Round(m.Rat(), m.CurrencyCode))
+}
+
+// TotalNanos возвращает сумму, выраженную в нано-единицах.
+func (m Money) TotalNanos() *big.Int {
+	total := new(big.Int).Mul(big.NewInt(m.Units), big.NewInt(nanosPerUnit))
+	return total.Add(total, big.NewInt(int64(m.Nanos)))
+}
+
+// Rat возвращает сумму в виде точной дроби.
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(m.TotalNanos(), big.NewInt(nanosPerUnit))
+}
+
+// Float64 возвращает ближайшее к сумме число float64. Нужна только там, где контракт
+// требует числа с плавающей точкой; считать в float64 нельзя.
+func (m Money) Float64() float64 {
+	f, _ := m.Rat().Float64()
+	return f
+}
+
+// FromNanos возвращает сумму в валюте currency из количества нано-единиц.
+// Целая часть должна помещаться в int64.
+func FromNanos(currency string, nanos *big.Int) Money {
+	units, rem := new(big.Int).QuoRem(nanos, big.NewInt(nanosPerUnit), new(big.Int))
+	return normalize(currency, units.Int64(), rem.Int64())
+}
+
+// FromRat возвращает сумму в валюте currency из дроби, округлённой до нано-единиц
+// половиной от нуля. Целая часть должна помещаться в int64.
+func FromRat(currency string, amount *big.Rat) Money {
+	nanos := new(big.Int).Mul(amount.Num(), big.NewInt(nanosPerUnit))
+	return FromNanos(currency, quoRound(nanos, amount.Denom()))
+}
+
+// Parse разбирает десятичную строку, например "1000.50" или "-0.3", с точностью
+// до 10^-9 без потери точности.
+func Parse(currency, value string) (Money, error) {
+	if !amountPattern.MatchString(value) {
+		return Money{}, fmt.Errorf("%q is not a decimal with at most 9 fractional digits", value)
+	}
+
+	negative := strings.HasPrefix(value, "-")
+	whole, fraction, _ := strings.Cut(strings.TrimPrefix(value, "-"), ".")
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%q is out of range", value)
+	}
+	var nanos int64
+	if fraction != "" {
+		// Не больше 9 цифр, поэтому значение помещается в int32.
+		nanos, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+	}
+
+	if negative {
+		units, nanos = -units, -nanos
+	}
+	return normalize(currency, units, nanos), nil
+}
+
+// Decimal записывает сумму десятичной строкой без валюты. Дробная часть содержит
+// не меньше знаков, чем минимальные единицы валюты, и не теряет значащих цифр:
+// "1234.50" RUB, "18982" JPY, "0.012345" RUB.
+func (m Money) Decimal() string {
+	sign := ""
+	units, nanos := m.Units, int64(m.Nanos)
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	if digits := currency.MinorUnits(m.CurrencyCode); len(fraction) < digits {
+		fraction += strings.Repeat("0", digits-len(fraction))
+	}
+
+	s := sign + strconv.FormatInt(units, 10)
+	if fraction == "" {
+		return s
+	}
+	return s + "." + fraction
+}
+
+// String возвращает сумму с валютой, например "-12.50 RUB".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.CurrencyCode
+}
+
+// normalize переносит избыток нано-единиц в целые и выравнивает знаки units и nanos.
+func normalize(currency string, units, nanos int64) Money {
+	units += nanos / nanosPerUnit
+	nanos %= nanosPerUnit
+
+	switch {
+	case units > 0 && nanos < 0:
+		units--
+		nanos += nanosPerUnit
+	case units < 0 && nanos > 0:
+		units++
+		nanos -= nanosPerUnit
+	}
+
+	return Money{
+		CurrencyCode: currency,
+		Units:        units,
+		Nanos:        int32(nanos), // #nosec G115 -- |nanos| < 10^9 после нормализации
+	}
+}
+
+// quoRound делит num на положительный den с округлением половиной от нуля.
+func quoRound(num, den *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	// |rem| / den >= 1/2 ⇔ 2|rem| >= den
+	if rem.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(den) >= 0 {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+	return quo
+}
@@ -0,0 +1,210 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func mustParse(t *testing.T, currency, value string) Money {
+	t.Helper()
+
+	m, err := Parse(currency, value)
+	if err != nil {
+		t.Fatalf("Parse(%q, %q) error = %v", currency, value, err)
+	}
+	return m
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Money
+		wantErr bool
+	}{
+		{value: "1000.50", want: Money{CurrencyCode: "RUB", Units: 1000, Nanos: 500_000_000}},
+		{value: "-0.3", want: Money{CurrencyCode: "RUB", Nanos: -300_000_000}},
+		{value: "-12.5", want: Money{CurrencyCode: "RUB", Units: -12, Nanos: -500_000_000}},
+		{value: "0.000000001", want: Money{CurrencyCode: "RUB", Nanos: 1}},
+		{value: "0", want: Money{CurrencyCode: "RUB"}},
+		{value: "1.0000000001", wantErr: true},
+		{value: "1.", wantErr: true},
+		{value: "+1", wantErr: true},
+		{value: "1e3", wantErr: true},
+		{value: "", wantErr: true},
+		{value: "99999999999999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse("RUB", tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Parse(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddSub(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		wantAdd string
+		wantSub string
+	}{
+		{name: "nanos carry into units", a: "0.7", b: "0.6", wantAdd: "1.3", wantSub: "0.1"},
+		{name: "result changes sign", a: "0.3", b: "1", wantAdd: "1.3", wantSub: "-0.7"},
+		{name: "negative operands", a: "-0.5", b: "-0.6", wantAdd: "-1.1", wantSub: "0.1"},
+		{name: "mixed signs", a: "1.2", b: "-1.5", wantAdd: "-0.3", wantSub: "2.7"},
+		{name: "smallest unit", a: "1", b: "0.000000001", wantAdd: "1.000000001", wantSub: "0.999999999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParse(t, "RUB", tt.a), mustParse(t, "RUB", tt.b)
+
+			// Сравнение структур проверяет и нормализацию: units и nanos одного знака.
+			if got, want := a.Add(b), mustParse(t, "RUB", tt.wantAdd); got != want {
+				t.Errorf("%s + %s = %+v, want %+v", tt.a, tt.b, got, want)
+			}
+			if got, want := a.Sub(b), mustParse(t, "RUB", tt.wantSub); got != want {
+				t.Errorf("%s - %s = %+v, want %+v", tt.a, tt.b, got, want)
+			}
+		})
+	}
+}
+
+func TestCmp(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.00", b: "1", want: 0},
+		{a: "0.01", b: "0", want: 1},
+		{a: "-5", b: "-4.99", want: -1},
+		{a: "0.000000001", b: "-0.000000001", want: 1},
+	}
+
+	for _, tt := range tests {
+		if got := mustParse(t, "RUB", tt.a).Cmp(mustParse(t, "RUB", tt.b)); got != tt.want {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name    string
+		amounts []Money
+		want    Money
+		wantErr error
+	}{
+		{name: "empty", want: Zero("RUB")},
+		{
+			name:    "same currency",
+			amounts: []Money{mustParse(t, "RUB", "1"), mustParse(t, "RUB", "2.5"), mustParse(t, "RUB", "-0.25")},
+			want:    mustParse(t, "RUB", "3.25"),
+		},
+		{
+			name:    "currency mismatch",
+			amounts: []Money{mustParse(t, "RUB", "1"), mustParse(t, "USD", "1")},
+			wantErr: ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sum("RUB", tt.amounts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Sum() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Sum() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMulBasisPoints(t *testing.T) {
+	tests := []struct {
+		amount string
+		bps    int64
+		want   string
+	}{
+		{amount: "100", bps: 250, want: "2.5"},
+		{amount: "123.45", bps: 2000, want: "24.69"},
+		{amount: "0.000000001", bps: 5000, want: "0.000000001"},
+		{amount: "-0.000000001", bps: 5000, want: "-0.000000001"},
+		{amount: "0.000000001", bps: 4999, want: "0"},
+		{amount: "100", bps: 0, want: "0"},
+	}
+
+	for _, tt := range tests {
+		got := mustParse(t, "RUB", tt.amount).MulBasisPoints(tt.bps)
+		if want := mustParse(t, "RUB", tt.want); got != want {
+			t.Errorf("%s × %d bps = %+v, want %+v", tt.amount, tt.bps, got, want)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		currency string
+		amount   string
+		want     string
+	}{
+		{currency: "RUB", amount: "1.005", want: "1.01"},
+		{currency: "RUB", amount: "-1.005", want: "-1.01"},
+		{currency: "RUB", amount: "1.004999999", want: "1"},
+		{currency: "JPY", amount: "18982.5", want: "18983"},
+	}
+
+	for _, tt := range tests {
+		got := mustParse(t, tt.currency, tt.amount).Round()
+		if want := mustParse(t, tt.currency, tt.want); got != want {
+			t.Errorf("Round(%s %s) = %+v, want %+v", tt.amount, tt.currency, got, want)
+		}
+	}
+}
+
+func TestFromRat(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		want     string
+	}{
+		{num: 1, den: 3, want: "0.333333333"},
+		{num: 2, den: 3, want: "0.666666667"},
+		{num: -2, den: 3, want: "-0.666666667"},
+		{num: 1, den: 2_000_000_000, want: "0.000000001"},
+	}
+
+	for _, tt := range tests {
+		got := FromRat("RUB", big.NewRat(tt.num, tt.den))
+		if want := mustParse(t, "RUB", tt.want); got != want {
+			t.Errorf("FromRat(%d/%d) = %+v, want %+v", tt.num, tt.den, got, want)
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		amount Money
+		want   string
+	}{
+		{amount: Money{CurrencyCode: "RUB", Units: 1234, Nanos: 500_000_000}, want: "1234.50"},
+		{amount: Money{CurrencyCode: "RUB", Nanos: 12_345_000}, want: "0.012345"},
+		{amount: Money{CurrencyCode: "RUB", Units: -12, Nanos: -500_000_000}, want: "-12.50"},
+		{amount: Money{CurrencyCode: "RUB", Nanos: -1}, want: "-0.000000001"},
+		{amount: Money{CurrencyCode: "JPY", Units: 18982}, want: "18982"},
+		{amount: Zero("RUB"), want: "0.00"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.Decimal(); got != tt.want {
+			t.Errorf("%+v.Decimal() = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
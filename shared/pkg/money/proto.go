@@ -0,0 +1,23 @@
+package money
+
+import (
+	moneyv1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/money/v1"
+)
+
+// FromProto преобразует protobuf-сумму. Незаданная сумма становится нулевой без валюты.
+func FromProto(m *moneyv1.Money) Money {
+	return Money{
+		CurrencyCode: m.GetCurrencyCode(),
+		Units:        m.GetUnits(),
+		Nanos:        m.GetNanos(),
+	}
+}
+
+// ToProto преобразует сумму в protobuf-сообщение.
+func ToProto(m Money) *moneyv1.Money {
+	return &moneyv1.Money{
+		CurrencyCode: m.CurrencyCode,
+		Units:        m.Units,
+		Nanos:        m.Nanos,
+	}
+}
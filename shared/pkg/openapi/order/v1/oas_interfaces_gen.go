@@ -9,6 +9,10 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type GetOrderReceiptRes interface {
+	getOrderReceiptRes()
+}
+
 type GetOrderRes interface {
 	getOrderRes()
 }
@@ -6,8 +6,9 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation OperationName = "CancelOrder"
-	CreateOrderOperation OperationName = "CreateOrder"
-	GetOrderOperation    OperationName = "GetOrder"
-	PayOrderOperation    OperationName = "PayOrder"
+	CancelOrderOperation     OperationName = "CancelOrder"
+	CreateOrderOperation     OperationName = "CreateOrder"
+	GetOrderOperation        OperationName = "GetOrder"
+	GetOrderReceiptOperation OperationName = "GetOrderReceipt"
+	PayOrderOperation        OperationName = "PayOrder"
 )
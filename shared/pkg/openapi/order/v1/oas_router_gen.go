@@ -154,6 +154,28 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+					case 'r': // Prefix: "receipt"
+
+						if l := len("receipt"); len(elem) >= l && elem[0:l] == "receipt" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetOrderReceiptRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 					}
 
 				}
@@ -355,6 +377,30 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+					case 'r': // Prefix: "receipt"
+
+						if l := len("receipt"); len(elem) >= l && elem[0:l] == "receipt" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetOrderReceiptOperation
+								r.summary = "Получить чек об оплате заказа"
+								r.operationID = "getOrderReceipt"
+								r.pathPattern = "/orders/{order_uuid}/receipt"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
 					}
 
 				}
@@ -3,6 +3,8 @@
 package order_v1
 
 import (
+	"time"
+
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
 )
@@ -22,6 +24,11 @@ type CancelOrderNotFound struct{}
 
 func (*CancelOrderNotFound) cancelOrderRes() {}
 
+// CancelOrderServiceUnavailable is response for CancelOrder operation.
+type CancelOrderServiceUnavailable struct{}
+
+func (*CancelOrderServiceUnavailable) cancelOrderRes() {}
+
 // CreateOrderBadRequest is response for CreateOrder operation.
 type CreateOrderBadRequest struct{}
 
@@ -60,8 +67,14 @@ func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 
 // Ref: #/components/schemas/CreateOrderResponse
 type CreateOrderResponse struct {
-	OrderUUID  uuid.UUID `json:"order_uuid"`
-	TotalPrice float64   `json:"total_price"`
+	OrderUUID uuid.UUID `json:"order_uuid"`
+	// Приближённая сумма, точная сумма десятичной строкой
+	// отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TotalPrice float64 `json:"total_price"`
+	// Валюта суммы заказа ISO 4217.
+	Currency string `json:"currency"`
 }
 
 // GetOrderUUID returns the value of OrderUUID.
@@ -74,6 +87,11 @@ func (s *CreateOrderResponse) GetTotalPrice() float64 {
 	return s.TotalPrice
 }
 
+// GetCurrency returns the value of Currency.
+func (s *CreateOrderResponse) GetCurrency() string {
+	return s.Currency
+}
+
 // SetOrderUUID sets the value of OrderUUID.
 func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
 	s.OrderUUID = val
@@ -84,13 +102,296 @@ func (s *CreateOrderResponse) SetTotalPrice(val float64) {
 	s.TotalPrice = val
 }
 
+// SetCurrency sets the value of Currency.
+func (s *CreateOrderResponse) SetCurrency(val string) {
+	s.Currency = val
+}
+
 func (*CreateOrderResponse) createOrderRes() {}
 
+// CreateOrderServiceUnavailable is response for CreateOrder operation.
+type CreateOrderServiceUnavailable struct{}
+
+func (*CreateOrderServiceUnavailable) createOrderRes() {}
+
+// Сумма заказа в запрошенной валюте отображения.
+// Ref: #/components/schemas/DisplayPrice
+type DisplayPrice struct {
+	// Сумма, округлённая до минимальных единиц валюты,
+	// приближённо; точная отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TotalPrice float64 `json:"total_price"`
+	Currency   string  `json:"currency"`
+	// Сколько единиц валюты отображения стоит единица
+	// валюты заказа.
+	ExchangeRate string `json:"exchange_rate"`
+	// Дата начала действия курса.
+	RateEffectiveFrom OptDate `json:"rate_effective_from"`
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *DisplayPrice) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// GetCurrency returns the value of Currency.
+func (s *DisplayPrice) GetCurrency() string {
+	return s.Currency
+}
+
+// GetExchangeRate returns the value of ExchangeRate.
+func (s *DisplayPrice) GetExchangeRate() string {
+	return s.ExchangeRate
+}
+
+// GetRateEffectiveFrom returns the value of RateEffectiveFrom.
+func (s *DisplayPrice) GetRateEffectiveFrom() OptDate {
+	return s.RateEffectiveFrom
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *DisplayPrice) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *DisplayPrice) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetExchangeRate sets the value of ExchangeRate.
+func (s *DisplayPrice) SetExchangeRate(val string) {
+	s.ExchangeRate = val
+}
+
+// SetRateEffectiveFrom sets the value of RateEffectiveFrom.
+func (s *DisplayPrice) SetRateEffectiveFrom(val OptDate) {
+	s.RateEffectiveFrom = val
+}
+
+// Вид правила антифрода, заблокировавшего оплату.
+// Ref: #/components/schemas/FraudReason
+type FraudReason string
+
+const (
+	FraudReasonUNKNOWN        FraudReason = "UNKNOWN"
+	FraudReasonBLOCKLIST      FraudReason = "BLOCKLIST"
+	FraudReasonAMOUNTLIMIT    FraudReason = "AMOUNT_LIMIT"
+	FraudReasonVELOCITYLIMIT  FraudReason = "VELOCITY_LIMIT"
+	FraudReasonFAILEDATTEMPTS FraudReason = "FAILED_ATTEMPTS"
+)
+
+// AllValues returns all FraudReason values.
+func (FraudReason) AllValues() []FraudReason {
+	return []FraudReason{
+		FraudReasonUNKNOWN,
+		FraudReasonBLOCKLIST,
+		FraudReasonAMOUNTLIMIT,
+		FraudReasonVELOCITYLIMIT,
+		FraudReasonFAILEDATTEMPTS,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FraudReason) MarshalText() ([]byte, error) {
+	switch s {
+	case FraudReasonUNKNOWN:
+		return []byte(s), nil
+	case FraudReasonBLOCKLIST:
+		return []byte(s), nil
+	case FraudReasonAMOUNTLIMIT:
+		return []byte(s), nil
+	case FraudReasonVELOCITYLIMIT:
+		return []byte(s), nil
+	case FraudReasonFAILEDATTEMPTS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FraudReason) UnmarshalText(data []byte) error {
+	switch FraudReason(data) {
+	case FraudReasonUNKNOWN:
+		*s = FraudReasonUNKNOWN
+		return nil
+	case FraudReasonBLOCKLIST:
+		*s = FraudReasonBLOCKLIST
+		return nil
+	case FraudReasonAMOUNTLIMIT:
+		*s = FraudReasonAMOUNTLIMIT
+		return nil
+	case FraudReasonVELOCITYLIMIT:
+		*s = FraudReasonVELOCITYLIMIT
+		return nil
+	case FraudReasonFAILEDATTEMPTS:
+		*s = FraudReasonFAILEDATTEMPTS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// GetOrderBadRequest is response for GetOrder operation.
+type GetOrderBadRequest struct{}
+
+func (*GetOrderBadRequest) getOrderRes() {}
+
 // GetOrderNotFound is response for GetOrder operation.
 type GetOrderNotFound struct{}
 
 func (*GetOrderNotFound) getOrderRes() {}
 
+// GetOrderReceiptNotFound is response for GetOrderReceipt operation.
+type GetOrderReceiptNotFound struct{}
+
+func (*GetOrderReceiptNotFound) getOrderReceiptRes() {}
+
+// GetOrderReceiptServiceUnavailable is response for GetOrderReceipt operation.
+type GetOrderReceiptServiceUnavailable struct{}
+
+func (*GetOrderReceiptServiceUnavailable) getOrderReceiptRes() {}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDisplayPrice returns new OptDisplayPrice with value set to v.
+func NewOptDisplayPrice(v DisplayPrice) OptDisplayPrice {
+	return OptDisplayPrice{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDisplayPrice is optional DisplayPrice.
+type OptDisplayPrice struct {
+	Value DisplayPrice
+	Set   bool
+}
+
+// IsSet returns true if OptDisplayPrice was set.
+func (o OptDisplayPrice) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDisplayPrice) Reset() {
+	var v DisplayPrice
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDisplayPrice) SetTo(v DisplayPrice) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDisplayPrice) Get() (v DisplayPrice, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDisplayPrice) Or(d DisplayPrice) DisplayPrice {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptNilUUID returns new OptNilUUID with value set to v.
 func NewOptNilUUID(v uuid.UUID) OptNilUUID {
 	return OptNilUUID{
@@ -200,12 +501,117 @@ func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
 	return d
 }
 
+// NewOptSbpPayment returns new OptSbpPayment with value set to v.
+func NewOptSbpPayment(v SbpPayment) OptSbpPayment {
+	return OptSbpPayment{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSbpPayment is optional SbpPayment.
+type OptSbpPayment struct {
+	Value SbpPayment
+	Set   bool
+}
+
+// IsSet returns true if OptSbpPayment was set.
+func (o OptSbpPayment) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSbpPayment) Reset() {
+	var v SbpPayment
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSbpPayment) SetTo(v SbpPayment) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSbpPayment) Get() (v SbpPayment, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSbpPayment) Or(d SbpPayment) SbpPayment {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/Order
 type Order struct {
-	OrderUUID       uuid.UUID        `json:"order_uuid"`
-	UserUUID        uuid.UUID        `json:"user_uuid"`
-	PartUuids       []uuid.UUID      `json:"part_uuids"`
-	TotalPrice      float64          `json:"total_price"`
+	OrderUUID uuid.UUID   `json:"order_uuid"`
+	UserUUID  uuid.UUID   `json:"user_uuid"`
+	PartUuids []uuid.UUID `json:"part_uuids"`
+	// Приближённая сумма, точная сумма десятичной строкой
+	// отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TotalPrice float64 `json:"total_price"`
+	// Валюта суммы заказа ISO 4217, в ней заказ оплачивается.
+	Currency     string          `json:"currency"`
+	DisplayPrice OptDisplayPrice `json:"display_price"`
+	// Суммарная масса деталей заказа в килограммах, по ней
+	// оценивается стоимость запуска.
+	TotalMassKg OptFloat64 `json:"total_mass_kg"`
+	// Суммарный габаритный объём деталей заказа в
+	// кубических метрах.
+	TotalVolumeM3   OptFloat64       `json:"total_volume_m3"`
 	TransactionUUID OptNilUUID       `json:"transaction_uuid"`
 	PaymentMethod   OptPaymentMethod `json:"payment_method"`
 	Status          OrderStatus      `json:"status"`
@@ -231,6 +637,26 @@ func (s *Order) GetTotalPrice() float64 {
 	return s.TotalPrice
 }
 
+// GetCurrency returns the value of Currency.
+func (s *Order) GetCurrency() string {
+	return s.Currency
+}
+
+// GetDisplayPrice returns the value of DisplayPrice.
+func (s *Order) GetDisplayPrice() OptDisplayPrice {
+	return s.DisplayPrice
+}
+
+// GetTotalMassKg returns the value of TotalMassKg.
+func (s *Order) GetTotalMassKg() OptFloat64 {
+	return s.TotalMassKg
+}
+
+// GetTotalVolumeM3 returns the value of TotalVolumeM3.
+func (s *Order) GetTotalVolumeM3() OptFloat64 {
+	return s.TotalVolumeM3
+}
+
 // GetTransactionUUID returns the value of TransactionUUID.
 func (s *Order) GetTransactionUUID() OptNilUUID {
 	return s.TransactionUUID
@@ -266,6 +692,26 @@ func (s *Order) SetTotalPrice(val float64) {
 	s.TotalPrice = val
 }
 
+// SetCurrency sets the value of Currency.
+func (s *Order) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetDisplayPrice sets the value of DisplayPrice.
+func (s *Order) SetDisplayPrice(val OptDisplayPrice) {
+	s.DisplayPrice = val
+}
+
+// SetTotalMassKg sets the value of TotalMassKg.
+func (s *Order) SetTotalMassKg(val OptFloat64) {
+	s.TotalMassKg = val
+}
+
+// SetTotalVolumeM3 sets the value of TotalVolumeM3.
+func (s *Order) SetTotalVolumeM3(val OptFloat64) {
+	s.TotalVolumeM3 = val
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *Order) SetTransactionUUID(val OptNilUUID) {
 	s.TransactionUUID = val
@@ -283,6 +729,10 @@ func (s *Order) SetStatus(val OrderStatus) {
 
 func (*Order) getOrderRes() {}
 
+// Статус заказа. DISPUTED — банк покупателя опротестовал
+// оплату, заказ ждёт решения по спору. CHARGED_BACK — спор
+// проигран, оплата полностью возвращена покупателю
+// банком.
 // Ref: #/components/schemas/OrderStatus
 type OrderStatus string
 
@@ -290,6 +740,8 @@ const (
 	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
 	OrderStatusPAID           OrderStatus = "PAID"
 	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusDISPUTED       OrderStatus = "DISPUTED"
+	OrderStatusCHARGEDBACK    OrderStatus = "CHARGED_BACK"
 )
 
 // AllValues returns all OrderStatus values.
@@ -298,6 +750,8 @@ func (OrderStatus) AllValues() []OrderStatus {
 		OrderStatusPENDINGPAYMENT,
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
+		OrderStatusDISPUTED,
+		OrderStatusCHARGEDBACK,
 	}
 }
 
@@ -310,6 +764,10 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case OrderStatusCANCELLED:
 		return []byte(s), nil
+	case OrderStatusDISPUTED:
+		return []byte(s), nil
+	case OrderStatusCHARGEDBACK:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -327,16 +785,37 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusCANCELLED:
 		*s = OrderStatusCANCELLED
 		return nil
+	case OrderStatusDISPUTED:
+		*s = OrderStatusDISPUTED
+		return nil
+	case OrderStatusCHARGEDBACK:
+		*s = OrderStatusCHARGEDBACK
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// PayOrderBadRequest is response for PayOrder operation.
+type PayOrderBadRequest struct{}
+
+func (*PayOrderBadRequest) payOrderRes() {}
+
+// PayOrderConflict is response for PayOrder operation.
+type PayOrderConflict struct{}
+
+func (*PayOrderConflict) payOrderRes() {}
+
 // PayOrderNotFound is response for PayOrder operation.
 type PayOrderNotFound struct{}
 
 func (*PayOrderNotFound) payOrderRes() {}
 
+// PayOrderPaymentRequired is response for PayOrder operation.
+type PayOrderPaymentRequired struct{}
+
+func (*PayOrderPaymentRequired) payOrderRes() {}
+
 // Ref: #/components/schemas/PayOrderRequest
 type PayOrderRequest struct {
 	PaymentMethod PaymentMethod `json:"payment_method"`
@@ -354,7 +833,8 @@ func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
 
 // Ref: #/components/schemas/PayOrderResponse
 type PayOrderResponse struct {
-	TransactionUUID uuid.UUID `json:"transaction_uuid"`
+	TransactionUUID uuid.UUID     `json:"transaction_uuid"`
+	SbpPayment      OptSbpPayment `json:"sbp_payment"`
 }
 
 // GetTransactionUUID returns the value of TransactionUUID.
@@ -362,13 +842,57 @@ func (s *PayOrderResponse) GetTransactionUUID() uuid.UUID {
 	return s.TransactionUUID
 }
 
+// GetSbpPayment returns the value of SbpPayment.
+func (s *PayOrderResponse) GetSbpPayment() OptSbpPayment {
+	return s.SbpPayment
+}
+
 // SetTransactionUUID sets the value of TransactionUUID.
 func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
 	s.TransactionUUID = val
 }
 
+// SetSbpPayment sets the value of SbpPayment.
+func (s *PayOrderResponse) SetSbpPayment(val OptSbpPayment) {
+	s.SbpPayment = val
+}
+
 func (*PayOrderResponse) payOrderRes() {}
 
+// PayOrderServiceUnavailable is response for PayOrder operation.
+type PayOrderServiceUnavailable struct{}
+
+func (*PayOrderServiceUnavailable) payOrderRes() {}
+
+// Ref: #/components/schemas/PaymentBlocked
+type PaymentBlocked struct {
+	Reason FraudReason `json:"reason"`
+	// Имя сработавшего правила антифрода.
+	Rule OptString `json:"rule"`
+}
+
+// GetReason returns the value of Reason.
+func (s *PaymentBlocked) GetReason() FraudReason {
+	return s.Reason
+}
+
+// GetRule returns the value of Rule.
+func (s *PaymentBlocked) GetRule() OptString {
+	return s.Rule
+}
+
+// SetReason sets the value of Reason.
+func (s *PaymentBlocked) SetReason(val FraudReason) {
+	s.Reason = val
+}
+
+// SetRule sets the value of Rule.
+func (s *PaymentBlocked) SetRule(val OptString) {
+	s.Rule = val
+}
+
+func (*PaymentBlocked) payOrderRes() {}
+
 // Ref: #/components/schemas/PaymentMethod
 type PaymentMethod string
 
@@ -431,3 +955,319 @@ func (s *PaymentMethod) UnmarshalText(data []byte) error {
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
+
+// Чек об оплате заказа с позициями, налогами и способом
+// оплаты.
+// Ref: #/components/schemas/Receipt
+type Receipt struct {
+	ReceiptUUID     uuid.UUID     `json:"receipt_uuid"`
+	OrderUUID       uuid.UUID     `json:"order_uuid"`
+	TransactionUUID uuid.UUID     `json:"transaction_uuid"`
+	PaymentMethod   PaymentMethod `json:"payment_method"`
+	// Маска номера карты, которой оплачен заказ.
+	MaskedCardNumber OptString     `json:"masked_card_number"`
+	Items            []ReceiptItem `json:"items"`
+	// Списанная при оплате сумма, приближённо; точная
+	// отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TotalPrice float64 `json:"total_price"`
+	// Остаток, который спишут платежи рассрочки,
+	// приближённо; точный отдаётся в /api/v2. Не передаётся для
+	// оплаты целиком.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	CreditAmount OptFloat64 `json:"credit_amount"`
+	// НДС, включённый в стоимость позиций, приближённо;
+	// точная отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	TaxTotal float64 `json:"tax_total"`
+	// Валюта сумм чека ISO 4217.
+	Currency string    `json:"currency"`
+	PaidAt   time.Time `json:"paid_at"`
+	// Чек самостоятельным HTML-документом.
+	HTML string `json:"html"`
+	// Чек простым текстом.
+	Text string `json:"text"`
+}
+
+// GetReceiptUUID returns the value of ReceiptUUID.
+func (s *Receipt) GetReceiptUUID() uuid.UUID {
+	return s.ReceiptUUID
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Receipt) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *Receipt) GetTransactionUUID() uuid.UUID {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *Receipt) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetMaskedCardNumber returns the value of MaskedCardNumber.
+func (s *Receipt) GetMaskedCardNumber() OptString {
+	return s.MaskedCardNumber
+}
+
+// GetItems returns the value of Items.
+func (s *Receipt) GetItems() []ReceiptItem {
+	return s.Items
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *Receipt) GetTotalPrice() float64 {
+	return s.TotalPrice
+}
+
+// GetCreditAmount returns the value of CreditAmount.
+func (s *Receipt) GetCreditAmount() OptFloat64 {
+	return s.CreditAmount
+}
+
+// GetTaxTotal returns the value of TaxTotal.
+func (s *Receipt) GetTaxTotal() float64 {
+	return s.TaxTotal
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Receipt) GetCurrency() string {
+	return s.Currency
+}
+
+// GetPaidAt returns the value of PaidAt.
+func (s *Receipt) GetPaidAt() time.Time {
+	return s.PaidAt
+}
+
+// GetHTML returns the value of HTML.
+func (s *Receipt) GetHTML() string {
+	return s.HTML
+}
+
+// GetText returns the value of Text.
+func (s *Receipt) GetText() string {
+	return s.Text
+}
+
+// SetReceiptUUID sets the value of ReceiptUUID.
+func (s *Receipt) SetReceiptUUID(val uuid.UUID) {
+	s.ReceiptUUID = val
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Receipt) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *Receipt) SetTransactionUUID(val uuid.UUID) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *Receipt) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetMaskedCardNumber sets the value of MaskedCardNumber.
+func (s *Receipt) SetMaskedCardNumber(val OptString) {
+	s.MaskedCardNumber = val
+}
+
+// SetItems sets the value of Items.
+func (s *Receipt) SetItems(val []ReceiptItem) {
+	s.Items = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *Receipt) SetTotalPrice(val float64) {
+	s.TotalPrice = val
+}
+
+// SetCreditAmount sets the value of CreditAmount.
+func (s *Receipt) SetCreditAmount(val OptFloat64) {
+	s.CreditAmount = val
+}
+
+// SetTaxTotal sets the value of TaxTotal.
+func (s *Receipt) SetTaxTotal(val float64) {
+	s.TaxTotal = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Receipt) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetPaidAt sets the value of PaidAt.
+func (s *Receipt) SetPaidAt(val time.Time) {
+	s.PaidAt = val
+}
+
+// SetHTML sets the value of HTML.
+func (s *Receipt) SetHTML(val string) {
+	s.HTML = val
+}
+
+// SetText sets the value of Text.
+func (s *Receipt) SetText(val string) {
+	s.Text = val
+}
+
+func (*Receipt) getOrderReceiptRes() {}
+
+// Ref: #/components/schemas/ReceiptItem
+type ReceiptItem struct {
+	// Идентификатор позиции, для деталей — UUID детали.
+	Sku      OptString `json:"sku"`
+	Name     string    `json:"name"`
+	Quantity int32     `json:"quantity"`
+	// Цена единицы с НДС, приближённо; точная отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	UnitPrice float64 `json:"unit_price"`
+	// Стоимость всех единиц строки, приближённо; точная
+	// отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	Amount float64 `json:"amount"`
+	// Ставка НДС в базисных пунктах, налог включён в цену.
+	TaxRateBasisPoints int64 `json:"tax_rate_basis_points"`
+	// НДС, включённый в стоимость строки, приближённо;
+	// точная отдаётся в /api/v2.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	Tax float64 `json:"tax"`
+}
+
+// GetSku returns the value of Sku.
+func (s *ReceiptItem) GetSku() OptString {
+	return s.Sku
+}
+
+// GetName returns the value of Name.
+func (s *ReceiptItem) GetName() string {
+	return s.Name
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *ReceiptItem) GetQuantity() int32 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *ReceiptItem) GetUnitPrice() float64 {
+	return s.UnitPrice
+}
+
+// GetAmount returns the value of Amount.
+func (s *ReceiptItem) GetAmount() float64 {
+	return s.Amount
+}
+
+// GetTaxRateBasisPoints returns the value of TaxRateBasisPoints.
+func (s *ReceiptItem) GetTaxRateBasisPoints() int64 {
+	return s.TaxRateBasisPoints
+}
+
+// GetTax returns the value of Tax.
+func (s *ReceiptItem) GetTax() float64 {
+	return s.Tax
+}
+
+// SetSku sets the value of Sku.
+func (s *ReceiptItem) SetSku(val OptString) {
+	s.Sku = val
+}
+
+// SetName sets the value of Name.
+func (s *ReceiptItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *ReceiptItem) SetQuantity(val int32) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *ReceiptItem) SetUnitPrice(val float64) {
+	s.UnitPrice = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *ReceiptItem) SetAmount(val float64) {
+	s.Amount = val
+}
+
+// SetTaxRateBasisPoints sets the value of TaxRateBasisPoints.
+func (s *ReceiptItem) SetTaxRateBasisPoints(val int64) {
+	s.TaxRateBasisPoints = val
+}
+
+// SetTax sets the value of Tax.
+func (s *ReceiptItem) SetTax(val float64) {
+	s.Tax = val
+}
+
+// Платёж СБП, ожидающий перевода. Заказ остаётся в
+// статусе PENDING_PAYMENT, пока покупатель не отсканирует
+// QR-код и не оплатит его в приложении банка.
+// Ref: #/components/schemas/SbpPayment
+type SbpPayment struct {
+	// Ссылка НСПК, закодированная в QR-коде.
+	Payload string `json:"payload"`
+	// Изображение QR-кода в base64.
+	QrImage            []byte `json:"qr_image"`
+	QrImageContentType string `json:"qr_image_content_type"`
+	// Когда QR-код перестанет действовать.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetPayload returns the value of Payload.
+func (s *SbpPayment) GetPayload() string {
+	return s.Payload
+}
+
+// GetQrImage returns the value of QrImage.
+func (s *SbpPayment) GetQrImage() []byte {
+	return s.QrImage
+}
+
+// GetQrImageContentType returns the value of QrImageContentType.
+func (s *SbpPayment) GetQrImageContentType() string {
+	return s.QrImageContentType
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *SbpPayment) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetPayload sets the value of Payload.
+func (s *SbpPayment) SetPayload(val string) {
+	s.Payload = val
+}
+
+// SetQrImage sets the value of QrImage.
+func (s *SbpPayment) SetQrImage(val []byte) {
+	s.QrImage = val
+}
+
+// SetQrImageContentType sets the value of QrImageContentType.
+func (s *SbpPayment) SetQrImageContentType(val string) {
+	s.QrImageContentType = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *SbpPayment) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
@@ -26,6 +26,12 @@ type Handler interface {
 	//
 	// GET /orders/{order_uuid}
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderReceipt implements getOrderReceipt operation.
+	//
+	// Получить чек об оплате заказа.
+	//
+	// GET /orders/{order_uuid}/receipt
+	GetOrderReceipt(ctx context.Context, params GetOrderReceiptParams) (GetOrderReceiptRes, error)
 	// PayOrder implements payOrder operation.
 	//
 	// Оплатить заказ.
@@ -40,6 +40,15 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrderReceipt implements getOrderReceipt operation.
+//
+// Получить чек об оплате заказа.
+//
+// GET /orders/{order_uuid}/receipt
+func (UnimplementedHandler) GetOrderReceipt(ctx context.Context, params GetOrderReceiptParams) (r GetOrderReceiptRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
 // Оплатить заказ.
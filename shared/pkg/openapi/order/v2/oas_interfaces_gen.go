@@ -0,0 +1,22 @@
+// Code generated by ogen, DO NOT EDIT.
+package order_v2
+
+type CancelOrderRes interface {
+	cancelOrderRes()
+}
+
+type CreateOrderRes interface {
+	createOrderRes()
+}
+
+type GetOrderReceiptRes interface {
+	getOrderReceiptRes()
+}
+
+type GetOrderRes interface {
+	getOrderRes()
+}
+
+type PayOrderRes interface {
+	payOrderRes()
+}
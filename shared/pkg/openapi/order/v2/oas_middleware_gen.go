@@ -0,0 +1,10 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package order_v2
+
+import (
+	"github.com/ogen-go/ogen/middleware"
+)
+
+// Middleware is middleware type.
+type Middleware = middleware.Middleware
@@ -0,0 +1,14 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package order_v2
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	CancelOrderOperation     OperationName = "CancelOrder"
+	CreateOrderOperation     OperationName = "CreateOrder"
+	GetOrderOperation        OperationName = "GetOrder"
+	GetOrderReceiptOperation OperationName = "GetOrderReceipt"
+	PayOrderOperation        OperationName = "PayOrder"
+)
@@ -0,0 +1,1285 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package order_v2
+
+import (
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/google/uuid"
+)
+
+// CancelOrderConflict is response for CancelOrder operation.
+type CancelOrderConflict struct{}
+
+func (*CancelOrderConflict) cancelOrderRes() {}
+
+// CancelOrderNoContent is response for CancelOrder operation.
+type CancelOrderNoContent struct{}
+
+func (*CancelOrderNoContent) cancelOrderRes() {}
+
+// CancelOrderNotFound is response for CancelOrder operation.
+type CancelOrderNotFound struct{}
+
+func (*CancelOrderNotFound) cancelOrderRes() {}
+
+// CancelOrderServiceUnavailable is response for CancelOrder operation.
+type CancelOrderServiceUnavailable struct{}
+
+func (*CancelOrderServiceUnavailable) cancelOrderRes() {}
+
+// CreateOrderBadRequest is response for CreateOrder operation.
+type CreateOrderBadRequest struct{}
+
+func (*CreateOrderBadRequest) createOrderRes() {}
+
+// CreateOrderNotFound is response for CreateOrder operation.
+type CreateOrderNotFound struct{}
+
+func (*CreateOrderNotFound) createOrderRes() {}
+
+// Ref: #/components/schemas/CreateOrderRequest
+type CreateOrderRequest struct {
+	UserUUID  uuid.UUID   `json:"user_uuid"`
+	PartUuids []uuid.UUID `json:"part_uuids"`
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetPartUuids returns the value of PartUuids.
+func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
+	s.UserUUID = val
+}
+
+// SetPartUuids sets the value of PartUuids.
+func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
+// Ref: #/components/schemas/CreateOrderResponse
+type CreateOrderResponse struct {
+	OrderUUID  uuid.UUID `json:"order_uuid"`
+	TotalPrice Decimal   `json:"total_price"`
+	// Валюта суммы заказа ISO 4217.
+	Currency string `json:"currency"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *CreateOrderResponse) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *CreateOrderResponse) GetTotalPrice() Decimal {
+	return s.TotalPrice
+}
+
+// GetCurrency returns the value of Currency.
+func (s *CreateOrderResponse) GetCurrency() string {
+	return s.Currency
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *CreateOrderResponse) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *CreateOrderResponse) SetTotalPrice(val Decimal) {
+	s.TotalPrice = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *CreateOrderResponse) SetCurrency(val string) {
+	s.Currency = val
+}
+
+func (*CreateOrderResponse) createOrderRes() {}
+
+// CreateOrderServiceUnavailable is response for CreateOrder operation.
+type CreateOrderServiceUnavailable struct{}
+
+func (*CreateOrderServiceUnavailable) createOrderRes() {}
+
+type Decimal string
+
+// Сумма заказа в запрошенной валюте отображения.
+// Ref: #/components/schemas/DisplayPrice
+type DisplayPrice struct {
+	TotalPrice Decimal `json:"total_price"`
+	Currency   string  `json:"currency"`
+	// Сколько единиц валюты отображения стоит единица
+	// валюты заказа.
+	ExchangeRate string `json:"exchange_rate"`
+	// Дата начала действия курса.
+	RateEffectiveFrom OptDate `json:"rate_effective_from"`
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *DisplayPrice) GetTotalPrice() Decimal {
+	return s.TotalPrice
+}
+
+// GetCurrency returns the value of Currency.
+func (s *DisplayPrice) GetCurrency() string {
+	return s.Currency
+}
+
+// GetExchangeRate returns the value of ExchangeRate.
+func (s *DisplayPrice) GetExchangeRate() string {
+	return s.ExchangeRate
+}
+
+// GetRateEffectiveFrom returns the value of RateEffectiveFrom.
+func (s *DisplayPrice) GetRateEffectiveFrom() OptDate {
+	return s.RateEffectiveFrom
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *DisplayPrice) SetTotalPrice(val Decimal) {
+	s.TotalPrice = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *DisplayPrice) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetExchangeRate sets the value of ExchangeRate.
+func (s *DisplayPrice) SetExchangeRate(val string) {
+	s.ExchangeRate = val
+}
+
+// SetRateEffectiveFrom sets the value of RateEffectiveFrom.
+func (s *DisplayPrice) SetRateEffectiveFrom(val OptDate) {
+	s.RateEffectiveFrom = val
+}
+
+// Вид правила антифрода, заблокировавшего оплату.
+// Ref: #/components/schemas/FraudReason
+type FraudReason string
+
+const (
+	FraudReasonUNKNOWN        FraudReason = "UNKNOWN"
+	FraudReasonBLOCKLIST      FraudReason = "BLOCKLIST"
+	FraudReasonAMOUNTLIMIT    FraudReason = "AMOUNT_LIMIT"
+	FraudReasonVELOCITYLIMIT  FraudReason = "VELOCITY_LIMIT"
+	FraudReasonFAILEDATTEMPTS FraudReason = "FAILED_ATTEMPTS"
+)
+
+// AllValues returns all FraudReason values.
+func (FraudReason) AllValues() []FraudReason {
+	return []FraudReason{
+		FraudReasonUNKNOWN,
+		FraudReasonBLOCKLIST,
+		FraudReasonAMOUNTLIMIT,
+		FraudReasonVELOCITYLIMIT,
+		FraudReasonFAILEDATTEMPTS,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FraudReason) MarshalText() ([]byte, error) {
+	switch s {
+	case FraudReasonUNKNOWN:
+		return []byte(s), nil
+	case FraudReasonBLOCKLIST:
+		return []byte(s), nil
+	case FraudReasonAMOUNTLIMIT:
+		return []byte(s), nil
+	case FraudReasonVELOCITYLIMIT:
+		return []byte(s), nil
+	case FraudReasonFAILEDATTEMPTS:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FraudReason) UnmarshalText(data []byte) error {
+	switch FraudReason(data) {
+	case FraudReasonUNKNOWN:
+		*s = FraudReasonUNKNOWN
+		return nil
+	case FraudReasonBLOCKLIST:
+		*s = FraudReasonBLOCKLIST
+		return nil
+	case FraudReasonAMOUNTLIMIT:
+		*s = FraudReasonAMOUNTLIMIT
+		return nil
+	case FraudReasonVELOCITYLIMIT:
+		*s = FraudReasonVELOCITYLIMIT
+		return nil
+	case FraudReasonFAILEDATTEMPTS:
+		*s = FraudReasonFAILEDATTEMPTS
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// GetOrderBadRequest is response for GetOrder operation.
+type GetOrderBadRequest struct{}
+
+func (*GetOrderBadRequest) getOrderRes() {}
+
+// GetOrderNotFound is response for GetOrder operation.
+type GetOrderNotFound struct{}
+
+func (*GetOrderNotFound) getOrderRes() {}
+
+// GetOrderReceiptNotFound is response for GetOrderReceipt operation.
+type GetOrderReceiptNotFound struct{}
+
+func (*GetOrderReceiptNotFound) getOrderReceiptRes() {}
+
+// GetOrderReceiptServiceUnavailable is response for GetOrderReceipt operation.
+type GetOrderReceiptServiceUnavailable struct{}
+
+func (*GetOrderReceiptServiceUnavailable) getOrderReceiptRes() {}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDecimal returns new OptDecimal with value set to v.
+func NewOptDecimal(v Decimal) OptDecimal {
+	return OptDecimal{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDecimal is optional Decimal.
+type OptDecimal struct {
+	Value Decimal
+	Set   bool
+}
+
+// IsSet returns true if OptDecimal was set.
+func (o OptDecimal) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDecimal) Reset() {
+	var v Decimal
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDecimal) SetTo(v Decimal) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDecimal) Get() (v Decimal, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDecimal) Or(d Decimal) Decimal {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDisplayPrice returns new OptDisplayPrice with value set to v.
+func NewOptDisplayPrice(v DisplayPrice) OptDisplayPrice {
+	return OptDisplayPrice{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDisplayPrice is optional DisplayPrice.
+type OptDisplayPrice struct {
+	Value DisplayPrice
+	Set   bool
+}
+
+// IsSet returns true if OptDisplayPrice was set.
+func (o OptDisplayPrice) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDisplayPrice) Reset() {
+	var v DisplayPrice
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDisplayPrice) SetTo(v DisplayPrice) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDisplayPrice) Get() (v DisplayPrice, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDisplayPrice) Or(d DisplayPrice) DisplayPrice {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUUID returns new OptNilUUID with value set to v.
+func NewOptNilUUID(v uuid.UUID) OptNilUUID {
+	return OptNilUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUUID is optional nullable uuid.UUID.
+type OptNilUUID struct {
+	Value uuid.UUID
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUUID was set.
+func (o OptNilUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUUID) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUUID) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v uuid.UUID
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUUID) Get() (v uuid.UUID, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
+func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
+	return OptPaymentMethod{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPaymentMethod is optional PaymentMethod.
+type OptPaymentMethod struct {
+	Value PaymentMethod
+	Set   bool
+}
+
+// IsSet returns true if OptPaymentMethod was set.
+func (o OptPaymentMethod) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPaymentMethod) Reset() {
+	var v PaymentMethod
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPaymentMethod) SetTo(v PaymentMethod) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPaymentMethod) Get() (v PaymentMethod, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPaymentMethod) Or(d PaymentMethod) PaymentMethod {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSbpPayment returns new OptSbpPayment with value set to v.
+func NewOptSbpPayment(v SbpPayment) OptSbpPayment {
+	return OptSbpPayment{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSbpPayment is optional SbpPayment.
+type OptSbpPayment struct {
+	Value SbpPayment
+	Set   bool
+}
+
+// IsSet returns true if OptSbpPayment was set.
+func (o OptSbpPayment) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSbpPayment) Reset() {
+	var v SbpPayment
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSbpPayment) SetTo(v SbpPayment) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSbpPayment) Get() (v SbpPayment, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSbpPayment) Or(d SbpPayment) SbpPayment {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Order
+type Order struct {
+	OrderUUID  uuid.UUID   `json:"order_uuid"`
+	UserUUID   uuid.UUID   `json:"user_uuid"`
+	PartUuids  []uuid.UUID `json:"part_uuids"`
+	TotalPrice Decimal     `json:"total_price"`
+	// Валюта суммы заказа ISO 4217, в ней заказ оплачивается.
+	Currency     string          `json:"currency"`
+	DisplayPrice OptDisplayPrice `json:"display_price"`
+	// Суммарная масса деталей заказа в килограммах, по ней
+	// оценивается стоимость запуска.
+	TotalMassKg OptFloat64 `json:"total_mass_kg"`
+	// Суммарный габаритный объём деталей заказа в
+	// кубических метрах.
+	TotalVolumeM3   OptFloat64       `json:"total_volume_m3"`
+	TransactionUUID OptNilUUID       `json:"transaction_uuid"`
+	PaymentMethod   OptPaymentMethod `json:"payment_method"`
+	Status          OrderStatus      `json:"status"`
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Order) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetUserUUID returns the value of UserUUID.
+func (s *Order) GetUserUUID() uuid.UUID {
+	return s.UserUUID
+}
+
+// GetPartUuids returns the value of PartUuids.
+func (s *Order) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *Order) GetTotalPrice() Decimal {
+	return s.TotalPrice
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Order) GetCurrency() string {
+	return s.Currency
+}
+
+// GetDisplayPrice returns the value of DisplayPrice.
+func (s *Order) GetDisplayPrice() OptDisplayPrice {
+	return s.DisplayPrice
+}
+
+// GetTotalMassKg returns the value of TotalMassKg.
+func (s *Order) GetTotalMassKg() OptFloat64 {
+	return s.TotalMassKg
+}
+
+// GetTotalVolumeM3 returns the value of TotalVolumeM3.
+func (s *Order) GetTotalVolumeM3() OptFloat64 {
+	return s.TotalVolumeM3
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *Order) GetTransactionUUID() OptNilUUID {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *Order) GetPaymentMethod() OptPaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetStatus returns the value of Status.
+func (s *Order) GetStatus() OrderStatus {
+	return s.Status
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Order) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetUserUUID sets the value of UserUUID.
+func (s *Order) SetUserUUID(val uuid.UUID) {
+	s.UserUUID = val
+}
+
+// SetPartUuids sets the value of PartUuids.
+func (s *Order) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *Order) SetTotalPrice(val Decimal) {
+	s.TotalPrice = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Order) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetDisplayPrice sets the value of DisplayPrice.
+func (s *Order) SetDisplayPrice(val OptDisplayPrice) {
+	s.DisplayPrice = val
+}
+
+// SetTotalMassKg sets the value of TotalMassKg.
+func (s *Order) SetTotalMassKg(val OptFloat64) {
+	s.TotalMassKg = val
+}
+
+// SetTotalVolumeM3 sets the value of TotalVolumeM3.
+func (s *Order) SetTotalVolumeM3(val OptFloat64) {
+	s.TotalVolumeM3 = val
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *Order) SetTransactionUUID(val OptNilUUID) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *Order) SetPaymentMethod(val OptPaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Order) SetStatus(val OrderStatus) {
+	s.Status = val
+}
+
+func (*Order) getOrderRes() {}
+
+// Статус заказа. DISPUTED — банк покупателя опротестовал
+// оплату, заказ ждёт решения по спору. CHARGED_BACK — спор
+// проигран, оплата полностью возвращена покупателю
+// банком.
+// Ref: #/components/schemas/OrderStatus
+type OrderStatus string
+
+const (
+	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAID           OrderStatus = "PAID"
+	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
+	OrderStatusDISPUTED       OrderStatus = "DISPUTED"
+	OrderStatusCHARGEDBACK    OrderStatus = "CHARGED_BACK"
+)
+
+// AllValues returns all OrderStatus values.
+func (OrderStatus) AllValues() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusPENDINGPAYMENT,
+		OrderStatusPAID,
+		OrderStatusCANCELLED,
+		OrderStatusDISPUTED,
+		OrderStatusCHARGEDBACK,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrderStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrderStatusPENDINGPAYMENT:
+		return []byte(s), nil
+	case OrderStatusPAID:
+		return []byte(s), nil
+	case OrderStatusCANCELLED:
+		return []byte(s), nil
+	case OrderStatusDISPUTED:
+		return []byte(s), nil
+	case OrderStatusCHARGEDBACK:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrderStatus) UnmarshalText(data []byte) error {
+	switch OrderStatus(data) {
+	case OrderStatusPENDINGPAYMENT:
+		*s = OrderStatusPENDINGPAYMENT
+		return nil
+	case OrderStatusPAID:
+		*s = OrderStatusPAID
+		return nil
+	case OrderStatusCANCELLED:
+		*s = OrderStatusCANCELLED
+		return nil
+	case OrderStatusDISPUTED:
+		*s = OrderStatusDISPUTED
+		return nil
+	case OrderStatusCHARGEDBACK:
+		*s = OrderStatusCHARGEDBACK
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// PayOrderBadRequest is response for PayOrder operation.
+type PayOrderBadRequest struct{}
+
+func (*PayOrderBadRequest) payOrderRes() {}
+
+// PayOrderConflict is response for PayOrder operation.
+type PayOrderConflict struct{}
+
+func (*PayOrderConflict) payOrderRes() {}
+
+// PayOrderNotFound is response for PayOrder operation.
+type PayOrderNotFound struct{}
+
+func (*PayOrderNotFound) payOrderRes() {}
+
+// PayOrderPaymentRequired is response for PayOrder operation.
+type PayOrderPaymentRequired struct{}
+
+func (*PayOrderPaymentRequired) payOrderRes() {}
+
+// Ref: #/components/schemas/PayOrderRequest
+type PayOrderRequest struct {
+	PaymentMethod PaymentMethod `json:"payment_method"`
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *PayOrderRequest) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// Ref: #/components/schemas/PayOrderResponse
+type PayOrderResponse struct {
+	TransactionUUID uuid.UUID     `json:"transaction_uuid"`
+	SbpPayment      OptSbpPayment `json:"sbp_payment"`
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *PayOrderResponse) GetTransactionUUID() uuid.UUID {
+	return s.TransactionUUID
+}
+
+// GetSbpPayment returns the value of SbpPayment.
+func (s *PayOrderResponse) GetSbpPayment() OptSbpPayment {
+	return s.SbpPayment
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *PayOrderResponse) SetTransactionUUID(val uuid.UUID) {
+	s.TransactionUUID = val
+}
+
+// SetSbpPayment sets the value of SbpPayment.
+func (s *PayOrderResponse) SetSbpPayment(val OptSbpPayment) {
+	s.SbpPayment = val
+}
+
+func (*PayOrderResponse) payOrderRes() {}
+
+// PayOrderServiceUnavailable is response for PayOrder operation.
+type PayOrderServiceUnavailable struct{}
+
+func (*PayOrderServiceUnavailable) payOrderRes() {}
+
+// Ref: #/components/schemas/PaymentBlocked
+type PaymentBlocked struct {
+	Reason FraudReason `json:"reason"`
+	// Имя сработавшего правила антифрода.
+	Rule OptString `json:"rule"`
+}
+
+// GetReason returns the value of Reason.
+func (s *PaymentBlocked) GetReason() FraudReason {
+	return s.Reason
+}
+
+// GetRule returns the value of Rule.
+func (s *PaymentBlocked) GetRule() OptString {
+	return s.Rule
+}
+
+// SetReason sets the value of Reason.
+func (s *PaymentBlocked) SetReason(val FraudReason) {
+	s.Reason = val
+}
+
+// SetRule sets the value of Rule.
+func (s *PaymentBlocked) SetRule(val OptString) {
+	s.Rule = val
+}
+
+func (*PaymentBlocked) payOrderRes() {}
+
+// Ref: #/components/schemas/PaymentMethod
+type PaymentMethod string
+
+const (
+	PaymentMethodUNKNOWN       PaymentMethod = "UNKNOWN"
+	PaymentMethodCARD          PaymentMethod = "CARD"
+	PaymentMethodSBP           PaymentMethod = "SBP"
+	PaymentMethodCREDITCARD    PaymentMethod = "CREDIT_CARD"
+	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
+)
+
+// AllValues returns all PaymentMethod values.
+func (PaymentMethod) AllValues() []PaymentMethod {
+	return []PaymentMethod{
+		PaymentMethodUNKNOWN,
+		PaymentMethodCARD,
+		PaymentMethodSBP,
+		PaymentMethodCREDITCARD,
+		PaymentMethodINVESTORMONEY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PaymentMethod) MarshalText() ([]byte, error) {
+	switch s {
+	case PaymentMethodUNKNOWN:
+		return []byte(s), nil
+	case PaymentMethodCARD:
+		return []byte(s), nil
+	case PaymentMethodSBP:
+		return []byte(s), nil
+	case PaymentMethodCREDITCARD:
+		return []byte(s), nil
+	case PaymentMethodINVESTORMONEY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PaymentMethod) UnmarshalText(data []byte) error {
+	switch PaymentMethod(data) {
+	case PaymentMethodUNKNOWN:
+		*s = PaymentMethodUNKNOWN
+		return nil
+	case PaymentMethodCARD:
+		*s = PaymentMethodCARD
+		return nil
+	case PaymentMethodSBP:
+		*s = PaymentMethodSBP
+		return nil
+	case PaymentMethodCREDITCARD:
+		*s = PaymentMethodCREDITCARD
+		return nil
+	case PaymentMethodINVESTORMONEY:
+		*s = PaymentMethodINVESTORMONEY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Чек об оплате заказа с позициями, налогами и способом
+// оплаты.
+// Ref: #/components/schemas/Receipt
+type Receipt struct {
+	ReceiptUUID     uuid.UUID     `json:"receipt_uuid"`
+	OrderUUID       uuid.UUID     `json:"order_uuid"`
+	TransactionUUID uuid.UUID     `json:"transaction_uuid"`
+	PaymentMethod   PaymentMethod `json:"payment_method"`
+	// Маска номера карты, которой оплачен заказ.
+	MaskedCardNumber OptString     `json:"masked_card_number"`
+	Items            []ReceiptItem `json:"items"`
+	TotalPrice       Decimal       `json:"total_price"`
+	CreditAmount     OptDecimal    `json:"credit_amount"`
+	TaxTotal         Decimal       `json:"tax_total"`
+	// Валюта сумм чека ISO 4217.
+	Currency string    `json:"currency"`
+	PaidAt   time.Time `json:"paid_at"`
+	// Чек самостоятельным HTML-документом.
+	HTML string `json:"html"`
+	// Чек простым текстом.
+	Text string `json:"text"`
+}
+
+// GetReceiptUUID returns the value of ReceiptUUID.
+func (s *Receipt) GetReceiptUUID() uuid.UUID {
+	return s.ReceiptUUID
+}
+
+// GetOrderUUID returns the value of OrderUUID.
+func (s *Receipt) GetOrderUUID() uuid.UUID {
+	return s.OrderUUID
+}
+
+// GetTransactionUUID returns the value of TransactionUUID.
+func (s *Receipt) GetTransactionUUID() uuid.UUID {
+	return s.TransactionUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *Receipt) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetMaskedCardNumber returns the value of MaskedCardNumber.
+func (s *Receipt) GetMaskedCardNumber() OptString {
+	return s.MaskedCardNumber
+}
+
+// GetItems returns the value of Items.
+func (s *Receipt) GetItems() []ReceiptItem {
+	return s.Items
+}
+
+// GetTotalPrice returns the value of TotalPrice.
+func (s *Receipt) GetTotalPrice() Decimal {
+	return s.TotalPrice
+}
+
+// GetCreditAmount returns the value of CreditAmount.
+func (s *Receipt) GetCreditAmount() OptDecimal {
+	return s.CreditAmount
+}
+
+// GetTaxTotal returns the value of TaxTotal.
+func (s *Receipt) GetTaxTotal() Decimal {
+	return s.TaxTotal
+}
+
+// GetCurrency returns the value of Currency.
+func (s *Receipt) GetCurrency() string {
+	return s.Currency
+}
+
+// GetPaidAt returns the value of PaidAt.
+func (s *Receipt) GetPaidAt() time.Time {
+	return s.PaidAt
+}
+
+// GetHTML returns the value of HTML.
+func (s *Receipt) GetHTML() string {
+	return s.HTML
+}
+
+// GetText returns the value of Text.
+func (s *Receipt) GetText() string {
+	return s.Text
+}
+
+// SetReceiptUUID sets the value of ReceiptUUID.
+func (s *Receipt) SetReceiptUUID(val uuid.UUID) {
+	s.ReceiptUUID = val
+}
+
+// SetOrderUUID sets the value of OrderUUID.
+func (s *Receipt) SetOrderUUID(val uuid.UUID) {
+	s.OrderUUID = val
+}
+
+// SetTransactionUUID sets the value of TransactionUUID.
+func (s *Receipt) SetTransactionUUID(val uuid.UUID) {
+	s.TransactionUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *Receipt) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetMaskedCardNumber sets the value of MaskedCardNumber.
+func (s *Receipt) SetMaskedCardNumber(val OptString) {
+	s.MaskedCardNumber = val
+}
+
+// SetItems sets the value of Items.
+func (s *Receipt) SetItems(val []ReceiptItem) {
+	s.Items = val
+}
+
+// SetTotalPrice sets the value of TotalPrice.
+func (s *Receipt) SetTotalPrice(val Decimal) {
+	s.TotalPrice = val
+}
+
+// SetCreditAmount sets the value of CreditAmount.
+func (s *Receipt) SetCreditAmount(val OptDecimal) {
+	s.CreditAmount = val
+}
+
+// SetTaxTotal sets the value of TaxTotal.
+func (s *Receipt) SetTaxTotal(val Decimal) {
+	s.TaxTotal = val
+}
+
+// SetCurrency sets the value of Currency.
+func (s *Receipt) SetCurrency(val string) {
+	s.Currency = val
+}
+
+// SetPaidAt sets the value of PaidAt.
+func (s *Receipt) SetPaidAt(val time.Time) {
+	s.PaidAt = val
+}
+
+// SetHTML sets the value of HTML.
+func (s *Receipt) SetHTML(val string) {
+	s.HTML = val
+}
+
+// SetText sets the value of Text.
+func (s *Receipt) SetText(val string) {
+	s.Text = val
+}
+
+func (*Receipt) getOrderReceiptRes() {}
+
+// Ref: #/components/schemas/ReceiptItem
+type ReceiptItem struct {
+	// Идентификатор позиции, для деталей — UUID детали.
+	Sku       OptString `json:"sku"`
+	Name      string    `json:"name"`
+	Quantity  int32     `json:"quantity"`
+	UnitPrice Decimal   `json:"unit_price"`
+	Amount    Decimal   `json:"amount"`
+	// Ставка НДС в базисных пунктах, налог включён в цену.
+	TaxRateBasisPoints int64   `json:"tax_rate_basis_points"`
+	Tax                Decimal `json:"tax"`
+}
+
+// GetSku returns the value of Sku.
+func (s *ReceiptItem) GetSku() OptString {
+	return s.Sku
+}
+
+// GetName returns the value of Name.
+func (s *ReceiptItem) GetName() string {
+	return s.Name
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *ReceiptItem) GetQuantity() int32 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *ReceiptItem) GetUnitPrice() Decimal {
+	return s.UnitPrice
+}
+
+// GetAmount returns the value of Amount.
+func (s *ReceiptItem) GetAmount() Decimal {
+	return s.Amount
+}
+
+// GetTaxRateBasisPoints returns the value of TaxRateBasisPoints.
+func (s *ReceiptItem) GetTaxRateBasisPoints() int64 {
+	return s.TaxRateBasisPoints
+}
+
+// GetTax returns the value of Tax.
+func (s *ReceiptItem) GetTax() Decimal {
+	return s.Tax
+}
+
+// SetSku sets the value of Sku.
+func (s *ReceiptItem) SetSku(val OptString) {
+	s.Sku = val
+}
+
+// SetName sets the value of Name.
+func (s *ReceiptItem) SetName(val string) {
+	s.Name = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *ReceiptItem) SetQuantity(val int32) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *ReceiptItem) SetUnitPrice(val Decimal) {
+	s.UnitPrice = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *ReceiptItem) SetAmount(val Decimal) {
+	s.Amount = val
+}
+
+// SetTaxRateBasisPoints sets the value of TaxRateBasisPoints.
+func (s *ReceiptItem) SetTaxRateBasisPoints(val int64) {
+	s.TaxRateBasisPoints = val
+}
+
+// SetTax sets the value of Tax.
+func (s *ReceiptItem) SetTax(val Decimal) {
+	s.Tax = val
+}
+
+// Платёж СБП, ожидающий перевода. Заказ остаётся в
+// статусе PENDING_PAYMENT, пока покупатель не отсканирует
+// QR-код и не оплатит его в приложении банка.
+// Ref: #/components/schemas/SbpPayment
+type SbpPayment struct {
+	// Ссылка НСПК, закодированная в QR-коде.
+	Payload string `json:"payload"`
+	// Изображение QR-кода в base64.
+	QrImage            []byte `json:"qr_image"`
+	QrImageContentType string `json:"qr_image_content_type"`
+	// Когда QR-код перестанет действовать.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetPayload returns the value of Payload.
+func (s *SbpPayment) GetPayload() string {
+	return s.Payload
+}
+
+// GetQrImage returns the value of QrImage.
+func (s *SbpPayment) GetQrImage() []byte {
+	return s.QrImage
+}
+
+// GetQrImageContentType returns the value of QrImageContentType.
+func (s *SbpPayment) GetQrImageContentType() string {
+	return s.QrImageContentType
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *SbpPayment) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetPayload sets the value of Payload.
+func (s *SbpPayment) SetPayload(val string) {
+	s.Payload = val
+}
+
+// SetQrImage sets the value of QrImage.
+func (s *SbpPayment) SetQrImage(val []byte) {
+	s.QrImage = val
+}
+
+// SetQrImageContentType sets the value of QrImageContentType.
+func (s *SbpPayment) SetQrImageContentType(val string) {
+	s.QrImageContentType = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *SbpPayment) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
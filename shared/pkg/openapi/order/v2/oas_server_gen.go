@@ -0,0 +1,60 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package order_v2
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// CancelOrder implements cancelOrder operation.
+	//
+	// Отменить заказ.
+	//
+	// POST /orders/{order_uuid}/cancel
+	CancelOrder(ctx context.Context, params CancelOrderParams) (CancelOrderRes, error)
+	// CreateOrder implements createOrder operation.
+	//
+	// Создать заказ.
+	//
+	// POST /orders
+	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	// GetOrder implements getOrder operation.
+	//
+	// Получить заказ по UUID.
+	//
+	// GET /orders/{order_uuid}
+	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
+	// GetOrderReceipt implements getOrderReceipt operation.
+	//
+	// Получить чек об оплате заказа.
+	//
+	// GET /orders/{order_uuid}/receipt
+	GetOrderReceipt(ctx context.Context, params GetOrderReceiptParams) (GetOrderReceiptRes, error)
+	// PayOrder implements payOrder operation.
+	//
+	// Оплатить заказ.
+	//
+	// POST /orders/{order_uuid}/pay
+	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
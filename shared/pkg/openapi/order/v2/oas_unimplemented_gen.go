@@ -0,0 +1,59 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package order_v2
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// CancelOrder implements cancelOrder operation.
+//
+// Отменить заказ.
+//
+// POST /orders/{order_uuid}/cancel
+func (UnimplementedHandler) CancelOrder(ctx context.Context, params CancelOrderParams) (r CancelOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateOrder implements createOrder operation.
+//
+// Создать заказ.
+//
+// POST /orders
+func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderRequest) (r CreateOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrder implements getOrder operation.
+//
+// Получить заказ по UUID.
+//
+// GET /orders/{order_uuid}
+func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams) (r GetOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrderReceipt implements getOrderReceipt operation.
+//
+// Получить чек об оплате заказа.
+//
+// GET /orders/{order_uuid}/receipt
+func (UnimplementedHandler) GetOrderReceipt(ctx context.Context, params GetOrderReceiptParams) (r GetOrderReceiptRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PayOrder implements payOrder operation.
+//
+// Оплатить заказ.
+//
+// POST /orders/{order_uuid}/pay
+func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (r PayOrderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
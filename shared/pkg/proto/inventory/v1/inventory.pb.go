@@ -7,6 +7,9 @@
 package inventoryv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/money/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +25,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AttachmentKind is a kind of a part attachment.
+type AttachmentKind int32
+
+const (
+	AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	// Photo or rendering, must be PNG, JPEG, GIF or WebP.
+	AttachmentKind_ATTACHMENT_KIND_IMAGE AttachmentKind = 1
+	// CAD model in any format.
+	AttachmentKind_ATTACHMENT_KIND_CAD AttachmentKind = 2
+	// Certification document, must be PDF.
+	AttachmentKind_ATTACHMENT_KIND_CERTIFICATE AttachmentKind = 3
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNSPECIFIED",
+		1: "ATTACHMENT_KIND_IMAGE",
+		2: "ATTACHMENT_KIND_CAD",
+		3: "ATTACHMENT_KIND_CERTIFICATE",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNSPECIFIED": 0,
+		"ATTACHMENT_KIND_IMAGE":       1,
+		"ATTACHMENT_KIND_CAD":         2,
+		"ATTACHMENT_KIND_CERTIFICATE": 3,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
 // Category is a category of a part.
 type Category int32
 
@@ -62,11 +121,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -75,13 +134,245 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// LengthUnit is a unit of length.
+type LengthUnit int32
+
+const (
+	LengthUnit_LENGTH_UNIT_UNSPECIFIED LengthUnit = 0
+	LengthUnit_LENGTH_UNIT_MILLIMETER  LengthUnit = 1
+	LengthUnit_LENGTH_UNIT_CENTIMETER  LengthUnit = 2
+	LengthUnit_LENGTH_UNIT_METER       LengthUnit = 3
+	LengthUnit_LENGTH_UNIT_INCH        LengthUnit = 4
+	LengthUnit_LENGTH_UNIT_FOOT        LengthUnit = 5
+)
+
+// Enum value maps for LengthUnit.
+var (
+	LengthUnit_name = map[int32]string{
+		0: "LENGTH_UNIT_UNSPECIFIED",
+		1: "LENGTH_UNIT_MILLIMETER",
+		2: "LENGTH_UNIT_CENTIMETER",
+		3: "LENGTH_UNIT_METER",
+		4: "LENGTH_UNIT_INCH",
+		5: "LENGTH_UNIT_FOOT",
+	}
+	LengthUnit_value = map[string]int32{
+		"LENGTH_UNIT_UNSPECIFIED": 0,
+		"LENGTH_UNIT_MILLIMETER":  1,
+		"LENGTH_UNIT_CENTIMETER":  2,
+		"LENGTH_UNIT_METER":       3,
+		"LENGTH_UNIT_INCH":        4,
+		"LENGTH_UNIT_FOOT":        5,
+	}
+)
+
+func (x LengthUnit) Enum() *LengthUnit {
+	p := new(LengthUnit)
+	*p = x
+	return p
+}
+
+func (x LengthUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LengthUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (LengthUnit) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x LengthUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LengthUnit.Descriptor instead.
+func (LengthUnit) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// MassUnit is a unit of mass.
+type MassUnit int32
+
+const (
+	MassUnit_MASS_UNIT_UNSPECIFIED MassUnit = 0
+	MassUnit_MASS_UNIT_GRAM        MassUnit = 1
+	MassUnit_MASS_UNIT_KILOGRAM    MassUnit = 2
+	MassUnit_MASS_UNIT_TONNE       MassUnit = 3
+	MassUnit_MASS_UNIT_POUND       MassUnit = 4
+)
+
+// Enum value maps for MassUnit.
+var (
+	MassUnit_name = map[int32]string{
+		0: "MASS_UNIT_UNSPECIFIED",
+		1: "MASS_UNIT_GRAM",
+		2: "MASS_UNIT_KILOGRAM",
+		3: "MASS_UNIT_TONNE",
+		4: "MASS_UNIT_POUND",
+	}
+	MassUnit_value = map[string]int32{
+		"MASS_UNIT_UNSPECIFIED": 0,
+		"MASS_UNIT_GRAM":        1,
+		"MASS_UNIT_KILOGRAM":    2,
+		"MASS_UNIT_TONNE":       3,
+		"MASS_UNIT_POUND":       4,
+	}
+)
+
+func (x MassUnit) Enum() *MassUnit {
+	p := new(MassUnit)
+	*p = x
+	return p
+}
+
+func (x MassUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MassUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (MassUnit) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x MassUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MassUnit.Descriptor instead.
+func (MassUnit) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// UnitSystem is a system of units physical properties are converted to on read.
+type UnitSystem int32
+
+const (
+	UnitSystem_UNIT_SYSTEM_UNSPECIFIED UnitSystem = 0
+	// Meters and kilograms.
+	UnitSystem_UNIT_SYSTEM_METRIC UnitSystem = 1
+	// Feet and pounds.
+	UnitSystem_UNIT_SYSTEM_IMPERIAL UnitSystem = 2
+)
+
+// Enum value maps for UnitSystem.
+var (
+	UnitSystem_name = map[int32]string{
+		0: "UNIT_SYSTEM_UNSPECIFIED",
+		1: "UNIT_SYSTEM_METRIC",
+		2: "UNIT_SYSTEM_IMPERIAL",
+	}
+	UnitSystem_value = map[string]int32{
+		"UNIT_SYSTEM_UNSPECIFIED": 0,
+		"UNIT_SYSTEM_METRIC":      1,
+		"UNIT_SYSTEM_IMPERIAL":    2,
+	}
+)
+
+func (x UnitSystem) Enum() *UnitSystem {
+	p := new(UnitSystem)
+	*p = x
+	return p
+}
+
+func (x UnitSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnitSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (UnitSystem) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x UnitSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnitSystem.Descriptor instead.
+func (UnitSystem) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+// ErrorReason is a machine-readable reason of an InventoryService error.
+// It is sent as google.rpc.ErrorInfo.reason with the "inventory.spaceyard" domain.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED                         ErrorReason = 0
+	ErrorReason_ERROR_REASON_INVALID_ARGUMENT                    ErrorReason = 1
+	ErrorReason_ERROR_REASON_PART_NOT_FOUND                      ErrorReason = 2
+	ErrorReason_ERROR_REASON_ATTACHMENT_NOT_FOUND                ErrorReason = 3
+	ErrorReason_ERROR_REASON_ATTACHMENT_TOO_LARGE                ErrorReason = 4
+	ErrorReason_ERROR_REASON_ATTACHMENT_CHECKSUM_MISMATCH        ErrorReason = 5
+	ErrorReason_ERROR_REASON_ATTACHMENT_CONTENT_TYPE_NOT_ALLOWED ErrorReason = 6
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "ERROR_REASON_UNSPECIFIED",
+		1: "ERROR_REASON_INVALID_ARGUMENT",
+		2: "ERROR_REASON_PART_NOT_FOUND",
+		3: "ERROR_REASON_ATTACHMENT_NOT_FOUND",
+		4: "ERROR_REASON_ATTACHMENT_TOO_LARGE",
+		5: "ERROR_REASON_ATTACHMENT_CHECKSUM_MISMATCH",
+		6: "ERROR_REASON_ATTACHMENT_CONTENT_TYPE_NOT_ALLOWED",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":                         0,
+		"ERROR_REASON_INVALID_ARGUMENT":                    1,
+		"ERROR_REASON_PART_NOT_FOUND":                      2,
+		"ERROR_REASON_ATTACHMENT_NOT_FOUND":                3,
+		"ERROR_REASON_ATTACHMENT_TOO_LARGE":                4,
+		"ERROR_REASON_ATTACHMENT_CHECKSUM_MISMATCH":        5,
+		"ERROR_REASON_ATTACHMENT_CONTENT_TYPE_NOT_ALLOWED": 6,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[5].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[5]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
 }
 
 // GetPartRequest is a request to get a part by its UUID.
 type GetPartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Unit system for part dimensions. Unspecified returns them in stored units.
+	UnitSystem    UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=inventory.v1.UnitSystem" json:"unit_system,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,6 +414,13 @@ func (x *GetPartRequest) GetUuid() string {
 	return ""
 }
 
+func (x *GetPartRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
 // GetPartResponse is a response with a part.
 type GetPartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -170,8 +468,10 @@ func (x *GetPartResponse) GetPart() *Part {
 
 // ListPartsRequest is a request to list parts with optional filtering.
 type ListPartsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *PartsFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Unit system for part dimensions. Unspecified returns them in stored units.
+	UnitSystem    UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=inventory.v1.UnitSystem" json:"unit_system,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,6 +513,13 @@ func (x *ListPartsRequest) GetFilter() *PartsFilter {
 	return nil
 }
 
+func (x *ListPartsRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
 // ListPartsResponse is a response with a list of parts.
 type ListPartsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -266,8 +573,10 @@ type PartsFilter struct {
 	Categories            []Category             `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
 	ManufacturerCountries []string               `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
 	Tags                  []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Case-insensitive substring search over names and descriptions in all locales.
+	Search        string `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PartsFilter) Reset() {
@@ -335,13 +644,19 @@ func (x *PartsFilter) GetTags() []string {
 	return nil
 }
 
+func (x *PartsFilter) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
 // Part is a part of a spaceship.
 type Part struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	StockQuantity int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
 	Category      Category               `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
 	Dimensions    *Dimensions            `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
@@ -350,6 +665,18 @@ type Part struct {
 	Metadata      map[string]*Value      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,13,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Locale of the returned name and description.
+	Locale string `protobuf:"bytes,14,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Name and description in every available locale, keyed by BCP 47 tag.
+	Translations map[string]*PartTranslation `protobuf:"bytes,15,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Three-letter ISO 4217 code of the price currency, e.g. "RUB".
+	// Deprecated: use price.currency_code, which always holds the same value.
+	//
+	// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+	CurrencyCode string `protobuf:"bytes,16,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Exact price of the part in its currency.
+	Price         *v1.Money `protobuf:"bytes,17,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -405,13 +732,6 @@ func (x *Part) GetDescription() string {
 	return ""
 }
 
-func (x *Part) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
 func (x *Part) GetStockQuantity() int64 {
 	if x != nil {
 		return x.StockQuantity
@@ -468,31 +788,65 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Dimensions is a dimensions of a part.
-type Dimensions struct {
+func (x *Part) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Part) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Part) GetTranslations() map[string]*PartTranslation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in inventory/v1/inventory.proto.
+func (x *Part) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Part) GetPrice() *v1.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// PartTranslation is a name and description of a part in one locale.
+type PartTranslation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Length        float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
-	Width         float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height        float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
-	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Dimensions) Reset() {
-	*x = Dimensions{}
+func (x *PartTranslation) Reset() {
+	*x = PartTranslation{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Dimensions) String() string {
+func (x *PartTranslation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dimensions) ProtoMessage() {}
+func (*PartTranslation) ProtoMessage() {}
 
-func (x *Dimensions) ProtoReflect() protoreflect.Message {
+func (x *PartTranslation) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,63 +858,55 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
-func (*Dimensions) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartTranslation.ProtoReflect.Descriptor instead.
+func (*PartTranslation) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Dimensions) GetLength() float64 {
-	if x != nil {
-		return x.Length
-	}
-	return 0
-}
-
-func (x *Dimensions) GetWidth() float64 {
-	if x != nil {
-		return x.Width
-	}
-	return 0
-}
-
-func (x *Dimensions) GetHeight() float64 {
+func (x *PartTranslation) GetName() string {
 	if x != nil {
-		return x.Height
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Dimensions) GetWeight() float64 {
+func (x *PartTranslation) GetDescription() string {
 	if x != nil {
-		return x.Weight
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-// Manufacturer is a manufacturer of a part.
-type Manufacturer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
-	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+// Attachment is metadata of a file attached to a part.
+type Attachment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Uuid     string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	FileName string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Kind     AttachmentKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// Content type sniffed from the file content.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Hex-encoded SHA-256 checksum of the file content.
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Manufacturer) Reset() {
-	*x = Manufacturer{}
+func (x *Attachment) Reset() {
+	*x = Attachment{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Manufacturer) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Manufacturer) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *Manufacturer) ProtoReflect() protoreflect.Message {
+func (x *Attachment) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,49 +918,711 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
-func (*Manufacturer) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Manufacturer) GetName() string {
+func (x *Attachment) GetUuid() string {
 	if x != nil {
-		return x.Name
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *Manufacturer) GetCountry() string {
+func (x *Attachment) GetFileName() string {
 	if x != nil {
-		return x.Country
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *Manufacturer) GetWebsite() string {
+func (x *Attachment) GetKind() AttachmentKind {
 	if x != nil {
-		return x.Website
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-// Value is a value for metadata.
-type Value struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*Value_StringValue
-	//	*Value_Int64Value
-	//	*Value_DoubleValue
-	//	*Value_BoolValue
-	Value         isValue_Value `protobuf_oneof:"value"`
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UploadAttachmentRequest is a message of an attachment upload stream.
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *UploadAttachmentInfo {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *UploadAttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+// UploadAttachmentInfo describes an uploaded attachment.
+type UploadAttachmentInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	FileName string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Kind     AttachmentKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// Optional hex-encoded SHA-256 checksum the content is verified against.
+	Sha256        string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentInfo) Reset() {
+	*x = UploadAttachmentInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentInfo) ProtoMessage() {}
+
+func (x *UploadAttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentInfo.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadAttachmentInfo) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *UploadAttachmentInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadAttachmentInfo) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *UploadAttachmentInfo) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// UploadAttachmentResponse is a response with the stored attachment.
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DownloadAttachmentRequest is a request to download a part attachment.
+type DownloadAttachmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PartUuid       string                 `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	AttachmentUuid string                 `protobuf:"bytes,2,opt,name=attachment_uuid,json=attachmentUuid,proto3" json:"attachment_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownloadAttachmentRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentUuid() string {
+	if x != nil {
+		return x.AttachmentUuid
+	}
+	return ""
+}
+
+// DownloadAttachmentResponse is a message of an attachment download stream.
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Payload       isDownloadAttachmentResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DownloadAttachmentResponse) GetPayload() isDownloadAttachmentResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Payload interface {
+	isDownloadAttachmentResponse_Payload()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Payload() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Payload() {}
+
+// Dimensions is a dimensions of a part.
+type Dimensions struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Length float64                `protobuf:"fixed64,1,opt,name=length,proto3" json:"length,omitempty"`
+	Width  float64                `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height float64                `protobuf:"fixed64,3,opt,name=height,proto3" json:"height,omitempty"`
+	Weight float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Unit of length, width and height. Unspecified is treated as meters.
+	LengthUnit LengthUnit `protobuf:"varint,5,opt,name=length_unit,json=lengthUnit,proto3,enum=inventory.v1.LengthUnit" json:"length_unit,omitempty"`
+	// Unit of weight. Unspecified is treated as kilograms.
+	WeightUnit MassUnit `protobuf:"varint,6,opt,name=weight_unit,json=weightUnit,proto3,enum=inventory.v1.MassUnit" json:"weight_unit,omitempty"`
+	// Computed bounding volume in cubic length_unit. Read only.
+	Volume float64 `protobuf:"fixed64,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	// Computed density in weight_unit per cubic length_unit. Read only.
+	Density       float64 `protobuf:"fixed64,8,opt,name=density,proto3" json:"density,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Dimensions) GetLength() float64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Dimensions) GetLengthUnit() LengthUnit {
+	if x != nil {
+		return x.LengthUnit
+	}
+	return LengthUnit_LENGTH_UNIT_UNSPECIFIED
+}
+
+func (x *Dimensions) GetWeightUnit() MassUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return MassUnit_MASS_UNIT_UNSPECIFIED
+}
+
+func (x *Dimensions) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Dimensions) GetDensity() float64 {
+	if x != nil {
+		return x.Density
+	}
+	return 0
+}
+
+// AggregatePhysicalPropertiesRequest is a request to aggregate physical properties of parts.
+type AggregatePhysicalPropertiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part UUIDs. A UUID listed several times is counted several times.
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// Unit system of the result. Unspecified is treated as metric.
+	UnitSystem    UnitSystem `protobuf:"varint,2,opt,name=unit_system,json=unitSystem,proto3,enum=inventory.v1.UnitSystem" json:"unit_system,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregatePhysicalPropertiesRequest) Reset() {
+	*x = AggregatePhysicalPropertiesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregatePhysicalPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatePhysicalPropertiesRequest) ProtoMessage() {}
+
+func (x *AggregatePhysicalPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatePhysicalPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*AggregatePhysicalPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AggregatePhysicalPropertiesRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *AggregatePhysicalPropertiesRequest) GetUnitSystem() UnitSystem {
+	if x != nil {
+		return x.UnitSystem
+	}
+	return UnitSystem_UNIT_SYSTEM_UNSPECIFIED
+}
+
+// AggregatePhysicalPropertiesResponse is a response with aggregated physical properties.
+type AggregatePhysicalPropertiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sum of part weights in mass_unit.
+	TotalMass float64 `protobuf:"fixed64,1,opt,name=total_mass,json=totalMass,proto3" json:"total_mass,omitempty"`
+	// Sum of part bounding volumes in cubic length_unit.
+	TotalVolume   float64    `protobuf:"fixed64,2,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	MassUnit      MassUnit   `protobuf:"varint,3,opt,name=mass_unit,json=massUnit,proto3,enum=inventory.v1.MassUnit" json:"mass_unit,omitempty"`
+	LengthUnit    LengthUnit `protobuf:"varint,4,opt,name=length_unit,json=lengthUnit,proto3,enum=inventory.v1.LengthUnit" json:"length_unit,omitempty"`
+	PartsCount    int64      `protobuf:"varint,5,opt,name=parts_count,json=partsCount,proto3" json:"parts_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregatePhysicalPropertiesResponse) Reset() {
+	*x = AggregatePhysicalPropertiesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregatePhysicalPropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatePhysicalPropertiesResponse) ProtoMessage() {}
+
+func (x *AggregatePhysicalPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatePhysicalPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*AggregatePhysicalPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AggregatePhysicalPropertiesResponse) GetTotalMass() float64 {
+	if x != nil {
+		return x.TotalMass
+	}
+	return 0
+}
+
+func (x *AggregatePhysicalPropertiesResponse) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *AggregatePhysicalPropertiesResponse) GetMassUnit() MassUnit {
+	if x != nil {
+		return x.MassUnit
+	}
+	return MassUnit_MASS_UNIT_UNSPECIFIED
+}
+
+func (x *AggregatePhysicalPropertiesResponse) GetLengthUnit() LengthUnit {
+	if x != nil {
+		return x.LengthUnit
+	}
+	return LengthUnit_LENGTH_UNIT_UNSPECIFIED
+}
+
+func (x *AggregatePhysicalPropertiesResponse) GetPartsCount() int64 {
+	if x != nil {
+		return x.PartsCount
+	}
+	return 0
+}
+
+// Manufacturer is a manufacturer of a part.
+type Manufacturer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Manufacturer) Reset() {
+	*x = Manufacturer{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Manufacturer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manufacturer) ProtoMessage() {}
+
+func (x *Manufacturer) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
+func (*Manufacturer) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Manufacturer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+// Value is a value for metadata.
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Value_StringValue
+	//	*Value_Int64Value
+	//	*Value_DoubleValue
+	//	*Value_BoolValue
+	Value         isValue_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +1634,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +1647,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Value) GetValue() isValue_Value {
@@ -717,15 +1725,19 @@ var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"$\n" +
-	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14money/v1/money.proto\"i\n" +
+	"\x0eGetPartRequest\x12\x1c\n" +
+	"\x04uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x04uuid\x129\n" +
+	"\vunit_system\x18\x02 \x01(\x0e2\x18.inventory.v1.UnitSystemR\n" +
+	"unitSystem\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\x80\x01\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x129\n" +
+	"\vunit_system\x18\x02 \x01(\x0e2\x18.inventory.v1.UnitSystemR\n" +
+	"unitSystem\"=\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xbc\x01\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xd4\x01\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -733,12 +1745,12 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xd5\x04\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x16\n" +
+	"\x06search\x18\x06 \x01(\tR\x06search\"\x93\a\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
 	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x122\n" +
 	"\bcategory\x18\x06 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
 	"\n" +
@@ -751,16 +1763,80 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\vattachments\x18\r \x03(\v2\x18.inventory.v1.AttachmentR\vattachments\x12\x16\n" +
+	"\x06locale\x18\x0e \x01(\tR\x06locale\x12H\n" +
+	"\ftranslations\x18\x0f \x03(\v2$.inventory.v1.Part.TranslationsEntryR\ftranslations\x12'\n" +
+	"\rcurrency_code\x18\x10 \x01(\tB\x02\x18\x01R\fcurrencyCode\x12%\n" +
+	"\x05price\x18\x11 \x01(\v2\x0f.money.v1.MoneyR\x05price\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\x1a^\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.inventory.v1.PartTranslationR\x05value:\x028\x01J\x04\b\x04\x10\x05\"G\n" +
+	"\x0fPartTranslation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xf9\x01\n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.inventory.v1.AttachmentKindR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"v\n" +
+	"\x17UploadAttachmentRequest\x128\n" +
+	"\x04info\x18\x01 \x01(\v2\".inventory.v1.UploadAttachmentInfoH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xd6\x01\n" +
+	"\x14UploadAttachmentInfo\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x12$\n" +
+	"\tfile_name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfileName\x12<\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.inventory.v1.AttachmentKindB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04kind\x123\n" +
+	"\x06sha256\x18\x04 \x01(\tB\x1b\xbaH\x18r\x162\x14^([0-9a-fA-F]{64})?$R\x06sha256\"T\n" +
+	"\x18UploadAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentR\n" +
+	"attachment\"u\n" +
+	"\x19DownloadAttachmentRequest\x12%\n" +
+	"\tpart_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bpartUuid\x121\n" +
+	"\x0fattachment_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0eattachmentUuid\"{\n" +
+	"\x1aDownloadAttachmentResponse\x12:\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\x90\x02\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
 	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x16\n" +
 	"\x06height\x18\x03 \x01(\x01R\x06height\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x01R\x06weight\"V\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x129\n" +
+	"\vlength_unit\x18\x05 \x01(\x0e2\x18.inventory.v1.LengthUnitR\n" +
+	"lengthUnit\x127\n" +
+	"\vweight_unit\x18\x06 \x01(\x0e2\x16.inventory.v1.MassUnitR\n" +
+	"weightUnit\x12\x16\n" +
+	"\x06volume\x18\a \x01(\x01R\x06volume\x12\x18\n" +
+	"\adensity\x18\b \x01(\x01R\adensity\"\x84\x01\n" +
+	"\"AggregatePhysicalPropertiesRequest\x12#\n" +
+	"\x05uuids\x18\x01 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x05uuids\x129\n" +
+	"\vunit_system\x18\x02 \x01(\x0e2\x18.inventory.v1.UnitSystemR\n" +
+	"unitSystem\"\xf8\x01\n" +
+	"#AggregatePhysicalPropertiesResponse\x12\x1d\n" +
+	"\n" +
+	"total_mass\x18\x01 \x01(\x01R\ttotalMass\x12!\n" +
+	"\ftotal_volume\x18\x02 \x01(\x01R\vtotalVolume\x123\n" +
+	"\tmass_unit\x18\x03 \x01(\x0e2\x16.inventory.v1.MassUnitR\bmassUnit\x129\n" +
+	"\vlength_unit\x18\x04 \x01(\x0e2\x18.inventory.v1.LengthUnitR\n" +
+	"lengthUnit\x12\x1f\n" +
+	"\vparts_count\x18\x05 \x01(\x03R\n" +
+	"partsCount\"V\n" +
 	"\fManufacturer\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x18\n" +
@@ -772,16 +1848,51 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\a\n" +
-	"\x05value*v\n" +
+	"\x05value*\x86\x01\n" +
+	"\x0eAttachmentKind\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTACHMENT_KIND_IMAGE\x10\x01\x12\x17\n" +
+	"\x13ATTACHMENT_KIND_CAD\x10\x02\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_CERTIFICATE\x10\x03*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xac\x01\n" +
-	"\x10InventoryService\x12H\n" +
-	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"\x00\x12N\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x00B=Z;github.com/ms_bigtech/shared/proto/inventory/v1;inventoryv1b\x06proto3"
+	"\rCATEGORY_WING\x10\x04*\xa4\x01\n" +
+	"\n" +
+	"LengthUnit\x12\x1b\n" +
+	"\x17LENGTH_UNIT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16LENGTH_UNIT_MILLIMETER\x10\x01\x12\x1a\n" +
+	"\x16LENGTH_UNIT_CENTIMETER\x10\x02\x12\x15\n" +
+	"\x11LENGTH_UNIT_METER\x10\x03\x12\x14\n" +
+	"\x10LENGTH_UNIT_INCH\x10\x04\x12\x14\n" +
+	"\x10LENGTH_UNIT_FOOT\x10\x05*{\n" +
+	"\bMassUnit\x12\x19\n" +
+	"\x15MASS_UNIT_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eMASS_UNIT_GRAM\x10\x01\x12\x16\n" +
+	"\x12MASS_UNIT_KILOGRAM\x10\x02\x12\x13\n" +
+	"\x0fMASS_UNIT_TONNE\x10\x03\x12\x13\n" +
+	"\x0fMASS_UNIT_POUND\x10\x04*[\n" +
+	"\n" +
+	"UnitSystem\x12\x1b\n" +
+	"\x17UNIT_SYSTEM_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12UNIT_SYSTEM_METRIC\x10\x01\x12\x18\n" +
+	"\x14UNIT_SYSTEM_IMPERIAL\x10\x02*\xa2\x02\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dERROR_REASON_INVALID_ARGUMENT\x10\x01\x12\x1f\n" +
+	"\x1bERROR_REASON_PART_NOT_FOUND\x10\x02\x12%\n" +
+	"!ERROR_REASON_ATTACHMENT_NOT_FOUND\x10\x03\x12%\n" +
+	"!ERROR_REASON_ATTACHMENT_TOO_LARGE\x10\x04\x12-\n" +
+	")ERROR_REASON_ATTACHMENT_CHECKSUM_MISMATCH\x10\x05\x124\n" +
+	"0ERROR_REASON_ATTACHMENT_CONTENT_TYPE_NOT_ALLOWED\x10\x062\x9a\x05\n" +
+	"\x10InventoryService\x12d\n" +
+	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/parts/{uuid}\x12c\n" +
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/parts\x12\xa6\x01\n" +
+	"\x1bAggregatePhysicalProperties\x120.inventory.v1.AggregatePhysicalPropertiesRequest\x1a1.inventory.v1.AggregatePhysicalPropertiesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/parts:aggregate\x12e\n" +
+	"\x10UploadAttachment\x12%.inventory.v1.UploadAttachmentRequest\x1a&.inventory.v1.UploadAttachmentResponse\"\x00(\x01\x12\xaa\x01\n" +
+	"\x12DownloadAttachment\x12'.inventory.v1.DownloadAttachmentRequest\x1a(.inventory.v1.DownloadAttachmentResponse\"?\x82\xd3\xe4\x93\x029\x127/api/v1/parts/{part_uuid}/attachments/{attachment_uuid}0\x01BKZIgithub.com/Denisz0785/spaceyard/shared/pkg/proto/inventory/v1;inventoryv1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -795,43 +1906,82 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*Part)(nil),                  // 6: inventory.v1.Part
-	(*Dimensions)(nil),            // 7: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 8: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 9: inventory.v1.Value
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(AttachmentKind)(0),                         // 0: inventory.v1.AttachmentKind
+	(Category)(0),                               // 1: inventory.v1.Category
+	(LengthUnit)(0),                             // 2: inventory.v1.LengthUnit
+	(MassUnit)(0),                               // 3: inventory.v1.MassUnit
+	(UnitSystem)(0),                             // 4: inventory.v1.UnitSystem
+	(ErrorReason)(0),                            // 5: inventory.v1.ErrorReason
+	(*GetPartRequest)(nil),                      // 6: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),                     // 7: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),                    // 8: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),                   // 9: inventory.v1.ListPartsResponse
+	(*PartsFilter)(nil),                         // 10: inventory.v1.PartsFilter
+	(*Part)(nil),                                // 11: inventory.v1.Part
+	(*PartTranslation)(nil),                     // 12: inventory.v1.PartTranslation
+	(*Attachment)(nil),                          // 13: inventory.v1.Attachment
+	(*UploadAttachmentRequest)(nil),             // 14: inventory.v1.UploadAttachmentRequest
+	(*UploadAttachmentInfo)(nil),                // 15: inventory.v1.UploadAttachmentInfo
+	(*UploadAttachmentResponse)(nil),            // 16: inventory.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),           // 17: inventory.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),          // 18: inventory.v1.DownloadAttachmentResponse
+	(*Dimensions)(nil),                          // 19: inventory.v1.Dimensions
+	(*AggregatePhysicalPropertiesRequest)(nil),  // 20: inventory.v1.AggregatePhysicalPropertiesRequest
+	(*AggregatePhysicalPropertiesResponse)(nil), // 21: inventory.v1.AggregatePhysicalPropertiesResponse
+	(*Manufacturer)(nil),                        // 22: inventory.v1.Manufacturer
+	(*Value)(nil),                               // 23: inventory.v1.Value
+	nil,                                         // 24: inventory.v1.Part.MetadataEntry
+	nil,                                         // 25: inventory.v1.Part.TranslationsEntry
+	(*timestamppb.Timestamp)(nil),               // 26: google.protobuf.Timestamp
+	(*v1.Money)(nil),                            // 27: money.v1.Money
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	6,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	6,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	0,  // 4: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	7,  // 5: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	8,  // 6: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 7: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 8: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 0: inventory.v1.GetPartRequest.unit_system:type_name -> inventory.v1.UnitSystem
+	11, // 1: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	10, // 2: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	4,  // 3: inventory.v1.ListPartsRequest.unit_system:type_name -> inventory.v1.UnitSystem
+	11, // 4: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	1,  // 5: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	1,  // 6: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	19, // 7: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	22, // 8: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	24, // 9: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	26, // 10: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	26, // 11: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 12: inventory.v1.Part.attachments:type_name -> inventory.v1.Attachment
+	25, // 13: inventory.v1.Part.translations:type_name -> inventory.v1.Part.TranslationsEntry
+	27, // 14: inventory.v1.Part.price:type_name -> money.v1.Money
+	0,  // 15: inventory.v1.Attachment.kind:type_name -> inventory.v1.AttachmentKind
+	26, // 16: inventory.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	15, // 17: inventory.v1.UploadAttachmentRequest.info:type_name -> inventory.v1.UploadAttachmentInfo
+	0,  // 18: inventory.v1.UploadAttachmentInfo.kind:type_name -> inventory.v1.AttachmentKind
+	13, // 19: inventory.v1.UploadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	13, // 20: inventory.v1.DownloadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	2,  // 21: inventory.v1.Dimensions.length_unit:type_name -> inventory.v1.LengthUnit
+	3,  // 22: inventory.v1.Dimensions.weight_unit:type_name -> inventory.v1.MassUnit
+	4,  // 23: inventory.v1.AggregatePhysicalPropertiesRequest.unit_system:type_name -> inventory.v1.UnitSystem
+	3,  // 24: inventory.v1.AggregatePhysicalPropertiesResponse.mass_unit:type_name -> inventory.v1.MassUnit
+	2,  // 25: inventory.v1.AggregatePhysicalPropertiesResponse.length_unit:type_name -> inventory.v1.LengthUnit
+	23, // 26: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	12, // 27: inventory.v1.Part.TranslationsEntry.value:type_name -> inventory.v1.PartTranslation
+	6,  // 28: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	8,  // 29: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	20, // 30: inventory.v1.InventoryService.AggregatePhysicalProperties:input_type -> inventory.v1.AggregatePhysicalPropertiesRequest
+	14, // 31: inventory.v1.InventoryService.UploadAttachment:input_type -> inventory.v1.UploadAttachmentRequest
+	17, // 32: inventory.v1.InventoryService.DownloadAttachment:input_type -> inventory.v1.DownloadAttachmentRequest
+	7,  // 33: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	9,  // 34: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	21, // 35: inventory.v1.InventoryService.AggregatePhysicalProperties:output_type -> inventory.v1.AggregatePhysicalPropertiesResponse
+	16, // 36: inventory.v1.InventoryService.UploadAttachment:output_type -> inventory.v1.UploadAttachmentResponse
+	18, // 37: inventory.v1.InventoryService.DownloadAttachment:output_type -> inventory.v1.DownloadAttachmentResponse
+	33, // [33:38] is the sub-list for method output_type
+	28, // [28:33] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -840,6 +1990,14 @@ func file_inventory_v1_inventory_proto_init() {
 		return
 	}
 	file_inventory_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[12].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_inventory_v1_inventory_proto_msgTypes[17].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -850,8 +2008,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName                     = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName                   = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_AggregatePhysicalProperties_FullMethodName = "/inventory.v1.InventoryService/AggregatePhysicalProperties"
+	InventoryService_UploadAttachment_FullMethodName            = "/inventory.v1.InventoryService/UploadAttachment"
+	InventoryService_DownloadAttachment_FullMethodName          = "/inventory.v1.InventoryService/DownloadAttachment"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -29,10 +32,20 @@ const (
 //
 // InventoryService is a service for managing inventory.
 type InventoryServiceClient interface {
-	// GetPart returns a part by its UUID.
+	// GetPart returns a part by its UUID. Name and description are localized
+	// according to the "accept-language" metadata, falling back to the default locale.
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
-	// ListParts returns a list of parts with optional filtering.
+	// ListParts returns a list of parts with optional filtering. Parts are localized
+	// the same way as in GetPart, while filters match names in any locale.
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// AggregatePhysicalProperties returns total mass and bounding volume of a set of parts.
+	AggregatePhysicalProperties(ctx context.Context, in *AggregatePhysicalPropertiesRequest, opts ...grpc.CallOption) (*AggregatePhysicalPropertiesResponse, error)
+	// UploadAttachment stores a file attached to a part. The first message carries
+	// the attachment info, the following ones carry the file content.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// DownloadAttachment streams a file attached to a part. The first message carries
+	// the attachment metadata, the following ones carry the file content.
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
 }
 
 type inventoryServiceClient struct {
@@ -63,16 +76,68 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) AggregatePhysicalProperties(ctx context.Context, in *AggregatePhysicalPropertiesRequest, opts ...grpc.CallOption) (*AggregatePhysicalPropertiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregatePhysicalPropertiesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AggregatePhysicalProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *inventoryServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[1], InventoryService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
 //
 // InventoryService is a service for managing inventory.
 type InventoryServiceServer interface {
-	// GetPart returns a part by its UUID.
+	// GetPart returns a part by its UUID. Name and description are localized
+	// according to the "accept-language" metadata, falling back to the default locale.
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
-	// ListParts returns a list of parts with optional filtering.
+	// ListParts returns a list of parts with optional filtering. Parts are localized
+	// the same way as in GetPart, while filters match names in any locale.
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// AggregatePhysicalProperties returns total mass and bounding volume of a set of parts.
+	AggregatePhysicalProperties(context.Context, *AggregatePhysicalPropertiesRequest) (*AggregatePhysicalPropertiesResponse, error)
+	// UploadAttachment stores a file attached to a part. The first message carries
+	// the attachment info, the following ones carry the file content.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// DownloadAttachment streams a file attached to a part. The first message carries
+	// the attachment metadata, the following ones carry the file content.
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -89,6 +154,15 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
 }
+func (UnimplementedInventoryServiceServer) AggregatePhysicalProperties(context.Context, *AggregatePhysicalPropertiesRequest) (*AggregatePhysicalPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePhysicalProperties not implemented")
+}
+func (UnimplementedInventoryServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedInventoryServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -146,6 +220,42 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_AggregatePhysicalProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatePhysicalPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AggregatePhysicalProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AggregatePhysicalProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AggregatePhysicalProperties(ctx, req.(*AggregatePhysicalPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InventoryServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _InventoryService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,7 +271,22 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "AggregatePhysicalProperties",
+			Handler:    _InventoryService_AggregatePhysicalProperties_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _InventoryService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _InventoryService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: money/v1/money.proto
+
+package moneyv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Money is an exact amount of money in a currency.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Three-letter ISO 4217 currency code, e.g. "RUB".
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Whole units of the amount.
+	Units int64 `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	// Nano (10^-9) units of the amount. Must be within ±999,999,999
+	// and have the same sign as units.
+	Nanos         int32 `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_money_v1_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+var File_money_v1_money_proto protoreflect.FileDescriptor
+
+const file_money_v1_money_proto_rawDesc = "" +
+	"\n" +
+	"\x14money/v1/money.proto\x12\bmoney.v1\x1a\x1bbuf/validate/validate.proto\"\x93\x02\n" +
+	"\x05Money\x126\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$R\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12,\n" +
+	"\x05nanos\x18\x03 \x01(\x05B\x16\xbaH\x13\x1a\x11\x18\xff\x93\xeb\xdc\x03(\x81씣\xfc\xff\xff\xff\xff\x01R\x05nanos:\x8d\x01\xbaH\x89\x01\x1a\x86\x01\n" +
+	"\x10money.nanos_sign\x12&nanos must have the same sign as units\x1aJthis.units == 0 || this.nanos == 0 || (this.units > 0) == (this.nanos > 0)BCZAgithub.com/Denisz0785/spaceyard/shared/pkg/proto/money/v1;moneyv1b\x06proto3"
+
+var (
+	file_money_v1_money_proto_rawDescOnce sync.Once
+	file_money_v1_money_proto_rawDescData []byte
+)
+
+func file_money_v1_money_proto_rawDescGZIP() []byte {
+	file_money_v1_money_proto_rawDescOnce.Do(func() {
+		file_money_v1_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_money_v1_money_proto_rawDesc), len(file_money_v1_money_proto_rawDesc)))
+	})
+	return file_money_v1_money_proto_rawDescData
+}
+
+var file_money_v1_money_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_money_v1_money_proto_goTypes = []any{
+	(*Money)(nil), // 0: money.v1.Money
+}
+var file_money_v1_money_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_money_v1_money_proto_init() }
+func file_money_v1_money_proto_init() {
+	if File_money_v1_money_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_money_v1_money_proto_rawDesc), len(file_money_v1_money_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_money_v1_money_proto_goTypes,
+		DependencyIndexes: file_money_v1_money_proto_depIdxs,
+		MessageInfos:      file_money_v1_money_proto_msgTypes,
+	}.Build()
+	File_money_v1_money_proto = out.File
+	file_money_v1_money_proto_goTypes = nil
+	file_money_v1_money_proto_depIdxs = nil
+}
@@ -7,8 +7,11 @@
 package paymentv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/Denisz0785/spaceyard/shared/pkg/proto/money/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,87 +24,7821 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// PaymentMethod is a method of pay
-type PaymentMethod int32
+// QrImageFormat is a format of a QR code image.
+type QrImageFormat int32
 
 const (
-	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED    PaymentMethod = 0
-	PaymentMethod_PAYMENT_METHOD_CARD           PaymentMethod = 1
-	PaymentMethod_PAYMENT_METHOD_SBP            PaymentMethod = 2
-	PaymentMethod_PAYMENT_METHOD_CREDIT_CARD    PaymentMethod = 3
-	PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY PaymentMethod = 4
+	// PNG is used.
+	QrImageFormat_QR_IMAGE_FORMAT_UNSPECIFIED QrImageFormat = 0
+	QrImageFormat_QR_IMAGE_FORMAT_PNG         QrImageFormat = 1
+	QrImageFormat_QR_IMAGE_FORMAT_SVG         QrImageFormat = 2
+)
+
+// Enum value maps for QrImageFormat.
+var (
+	QrImageFormat_name = map[int32]string{
+		0: "QR_IMAGE_FORMAT_UNSPECIFIED",
+		1: "QR_IMAGE_FORMAT_PNG",
+		2: "QR_IMAGE_FORMAT_SVG",
+	}
+	QrImageFormat_value = map[string]int32{
+		"QR_IMAGE_FORMAT_UNSPECIFIED": 0,
+		"QR_IMAGE_FORMAT_PNG":         1,
+		"QR_IMAGE_FORMAT_SVG":         2,
+	}
+)
+
+func (x QrImageFormat) Enum() *QrImageFormat {
+	p := new(QrImageFormat)
+	*p = x
+	return p
+}
+
+func (x QrImageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QrImageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[0].Descriptor()
+}
+
+func (QrImageFormat) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[0]
+}
+
+func (x QrImageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QrImageFormat.Descriptor instead.
+func (QrImageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+// RefundReason is a reason of a refund.
+type RefundReason int32
+
+const (
+	RefundReason_REFUND_REASON_UNSPECIFIED           RefundReason = 0
+	RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER RefundReason = 1
+	RefundReason_REFUND_REASON_ORDER_CANCELLED       RefundReason = 2
+	RefundReason_REFUND_REASON_DUPLICATE             RefundReason = 3
+	RefundReason_REFUND_REASON_FRAUDULENT            RefundReason = 4
+)
+
+// Enum value maps for RefundReason.
+var (
+	RefundReason_name = map[int32]string{
+		0: "REFUND_REASON_UNSPECIFIED",
+		1: "REFUND_REASON_REQUESTED_BY_CUSTOMER",
+		2: "REFUND_REASON_ORDER_CANCELLED",
+		3: "REFUND_REASON_DUPLICATE",
+		4: "REFUND_REASON_FRAUDULENT",
+	}
+	RefundReason_value = map[string]int32{
+		"REFUND_REASON_UNSPECIFIED":           0,
+		"REFUND_REASON_REQUESTED_BY_CUSTOMER": 1,
+		"REFUND_REASON_ORDER_CANCELLED":       2,
+		"REFUND_REASON_DUPLICATE":             3,
+		"REFUND_REASON_FRAUDULENT":            4,
+	}
+)
+
+func (x RefundReason) Enum() *RefundReason {
+	p := new(RefundReason)
+	*p = x
+	return p
+}
+
+func (x RefundReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (RefundReason) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[1]
+}
+
+func (x RefundReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundReason.Descriptor instead.
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+// RefundStatus is a status of a refund.
+type RefundStatus int32
+
+const (
+	RefundStatus_REFUND_STATUS_UNSPECIFIED RefundStatus = 0
+	RefundStatus_REFUND_STATUS_SUCCEEDED   RefundStatus = 1
+	RefundStatus_REFUND_STATUS_FAILED      RefundStatus = 2
+)
+
+// Enum value maps for RefundStatus.
+var (
+	RefundStatus_name = map[int32]string{
+		0: "REFUND_STATUS_UNSPECIFIED",
+		1: "REFUND_STATUS_SUCCEEDED",
+		2: "REFUND_STATUS_FAILED",
+	}
+	RefundStatus_value = map[string]int32{
+		"REFUND_STATUS_UNSPECIFIED": 0,
+		"REFUND_STATUS_SUCCEEDED":   1,
+		"REFUND_STATUS_FAILED":      2,
+	}
+)
+
+func (x RefundStatus) Enum() *RefundStatus {
+	p := new(RefundStatus)
+	*p = x
+	return p
+}
+
+func (x RefundStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[2].Descriptor()
+}
+
+func (RefundStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[2]
+}
+
+func (x RefundStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundStatus.Descriptor instead.
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+// LedgerAccountType is a kind of ledger account.
+type LedgerAccountType int32
+
+const (
+	// Unspecified account type.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_UNSPECIFIED LedgerAccountType = 0
+	// Account of a customer, one per user.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_CUSTOMER LedgerAccountType = 1
+	// Revenue of the merchant net of provider fees.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_MERCHANT LedgerAccountType = 2
+	// Money returned to customers.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_REFUNDS LedgerAccountType = 3
+	// Fees withheld by payment providers.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_FEES LedgerAccountType = 4
+	// Money returned to customers by lost disputes.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_CHARGEBACKS LedgerAccountType = 5
+	// Interest paid by customers on installment plans.
+	LedgerAccountType_LEDGER_ACCOUNT_TYPE_INTEREST LedgerAccountType = 6
+)
+
+// Enum value maps for LedgerAccountType.
+var (
+	LedgerAccountType_name = map[int32]string{
+		0: "LEDGER_ACCOUNT_TYPE_UNSPECIFIED",
+		1: "LEDGER_ACCOUNT_TYPE_CUSTOMER",
+		2: "LEDGER_ACCOUNT_TYPE_MERCHANT",
+		3: "LEDGER_ACCOUNT_TYPE_REFUNDS",
+		4: "LEDGER_ACCOUNT_TYPE_FEES",
+		5: "LEDGER_ACCOUNT_TYPE_CHARGEBACKS",
+		6: "LEDGER_ACCOUNT_TYPE_INTEREST",
+	}
+	LedgerAccountType_value = map[string]int32{
+		"LEDGER_ACCOUNT_TYPE_UNSPECIFIED": 0,
+		"LEDGER_ACCOUNT_TYPE_CUSTOMER":    1,
+		"LEDGER_ACCOUNT_TYPE_MERCHANT":    2,
+		"LEDGER_ACCOUNT_TYPE_REFUNDS":     3,
+		"LEDGER_ACCOUNT_TYPE_FEES":        4,
+		"LEDGER_ACCOUNT_TYPE_CHARGEBACKS": 5,
+		"LEDGER_ACCOUNT_TYPE_INTEREST":    6,
+	}
+)
+
+func (x LedgerAccountType) Enum() *LedgerAccountType {
+	p := new(LedgerAccountType)
+	*p = x
+	return p
+}
+
+func (x LedgerAccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerAccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[3].Descriptor()
+}
+
+func (LedgerAccountType) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[3]
+}
+
+func (x LedgerAccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerAccountType.Descriptor instead.
+func (LedgerAccountType) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+// JournalEntryKind is a business operation recorded by a journal entry.
+type JournalEntryKind int32
+
+const (
+	// Unspecified kind.
+	JournalEntryKind_JOURNAL_ENTRY_KIND_UNSPECIFIED JournalEntryKind = 0
+	// Capture of an authorized amount.
+	JournalEntryKind_JOURNAL_ENTRY_KIND_CAPTURE JournalEntryKind = 1
+	// Refund of a captured amount.
+	JournalEntryKind_JOURNAL_ENTRY_KIND_REFUND JournalEntryKind = 2
+	// Reversal of another entry.
+	JournalEntryKind_JOURNAL_ENTRY_KIND_REVERSAL JournalEntryKind = 3
+	// Chargeback of a captured amount by a lost dispute.
+	JournalEntryKind_JOURNAL_ENTRY_KIND_CHARGEBACK JournalEntryKind = 4
+)
+
+// Enum value maps for JournalEntryKind.
+var (
+	JournalEntryKind_name = map[int32]string{
+		0: "JOURNAL_ENTRY_KIND_UNSPECIFIED",
+		1: "JOURNAL_ENTRY_KIND_CAPTURE",
+		2: "JOURNAL_ENTRY_KIND_REFUND",
+		3: "JOURNAL_ENTRY_KIND_REVERSAL",
+		4: "JOURNAL_ENTRY_KIND_CHARGEBACK",
+	}
+	JournalEntryKind_value = map[string]int32{
+		"JOURNAL_ENTRY_KIND_UNSPECIFIED": 0,
+		"JOURNAL_ENTRY_KIND_CAPTURE":     1,
+		"JOURNAL_ENTRY_KIND_REFUND":      2,
+		"JOURNAL_ENTRY_KIND_REVERSAL":    3,
+		"JOURNAL_ENTRY_KIND_CHARGEBACK":  4,
+	}
+)
+
+func (x JournalEntryKind) Enum() *JournalEntryKind {
+	p := new(JournalEntryKind)
+	*p = x
+	return p
+}
+
+func (x JournalEntryKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JournalEntryKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[4].Descriptor()
+}
+
+func (JournalEntryKind) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[4]
+}
+
+func (x JournalEntryKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JournalEntryKind.Descriptor instead.
+func (JournalEntryKind) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+// PostingDirection is a side of a posting.
+type PostingDirection int32
+
+const (
+	// Unspecified direction.
+	PostingDirection_POSTING_DIRECTION_UNSPECIFIED PostingDirection = 0
+	// Debit of the account.
+	PostingDirection_POSTING_DIRECTION_DEBIT PostingDirection = 1
+	// Credit of the account.
+	PostingDirection_POSTING_DIRECTION_CREDIT PostingDirection = 2
+)
+
+// Enum value maps for PostingDirection.
+var (
+	PostingDirection_name = map[int32]string{
+		0: "POSTING_DIRECTION_UNSPECIFIED",
+		1: "POSTING_DIRECTION_DEBIT",
+		2: "POSTING_DIRECTION_CREDIT",
+	}
+	PostingDirection_value = map[string]int32{
+		"POSTING_DIRECTION_UNSPECIFIED": 0,
+		"POSTING_DIRECTION_DEBIT":       1,
+		"POSTING_DIRECTION_CREDIT":      2,
+	}
+)
+
+func (x PostingDirection) Enum() *PostingDirection {
+	p := new(PostingDirection)
+	*p = x
+	return p
+}
+
+func (x PostingDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostingDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[5].Descriptor()
+}
+
+func (PostingDirection) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[5]
+}
+
+func (x PostingDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostingDirection.Descriptor instead.
+func (PostingDirection) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+// InvestorMovementKind is a kind of change of an investor's balances.
+type InvestorMovementKind int32
+
+const (
+	// Unspecified kind.
+	InvestorMovementKind_INVESTOR_MOVEMENT_KIND_UNSPECIFIED InvestorMovementKind = 0
+	// Money added to the available balance.
+	InvestorMovementKind_INVESTOR_MOVEMENT_KIND_TOP_UP InvestorMovementKind = 1
+	// Available money held by an authorization.
+	InvestorMovementKind_INVESTOR_MOVEMENT_KIND_HOLD InvestorMovementKind = 2
+	// Held money captured and spent.
+	InvestorMovementKind_INVESTOR_MOVEMENT_KIND_CAPTURE InvestorMovementKind = 3
+	// Held money returned to the available balance by a void, an expiration or a partial capture.
+	InvestorMovementKind_INVESTOR_MOVEMENT_KIND_RELEASE InvestorMovementKind = 4
+	// Spent money returned to the available balance by a refund.
+	InvestorMovementKind_INVESTOR_MOVEMENT_KIND_REFUND InvestorMovementKind = 5
+)
+
+// Enum value maps for InvestorMovementKind.
+var (
+	InvestorMovementKind_name = map[int32]string{
+		0: "INVESTOR_MOVEMENT_KIND_UNSPECIFIED",
+		1: "INVESTOR_MOVEMENT_KIND_TOP_UP",
+		2: "INVESTOR_MOVEMENT_KIND_HOLD",
+		3: "INVESTOR_MOVEMENT_KIND_CAPTURE",
+		4: "INVESTOR_MOVEMENT_KIND_RELEASE",
+		5: "INVESTOR_MOVEMENT_KIND_REFUND",
+	}
+	InvestorMovementKind_value = map[string]int32{
+		"INVESTOR_MOVEMENT_KIND_UNSPECIFIED": 0,
+		"INVESTOR_MOVEMENT_KIND_TOP_UP":      1,
+		"INVESTOR_MOVEMENT_KIND_HOLD":        2,
+		"INVESTOR_MOVEMENT_KIND_CAPTURE":     3,
+		"INVESTOR_MOVEMENT_KIND_RELEASE":     4,
+		"INVESTOR_MOVEMENT_KIND_REFUND":      5,
+	}
+)
+
+func (x InvestorMovementKind) Enum() *InvestorMovementKind {
+	p := new(InvestorMovementKind)
+	*p = x
+	return p
+}
+
+func (x InvestorMovementKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvestorMovementKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[6].Descriptor()
+}
+
+func (InvestorMovementKind) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[6]
+}
+
+func (x InvestorMovementKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvestorMovementKind.Descriptor instead.
+func (InvestorMovementKind) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+// FraudOutcome is a fraud screening verdict.
+type FraudOutcome int32
+
+const (
+	// Unspecified outcome.
+	FraudOutcome_FRAUD_OUTCOME_UNSPECIFIED FraudOutcome = 0
+	// The attempt is passed to the payment provider.
+	FraudOutcome_FRAUD_OUTCOME_ALLOW FraudOutcome = 1
+	// The attempt is rejected.
+	FraudOutcome_FRAUD_OUTCOME_BLOCK FraudOutcome = 2
+)
+
+// Enum value maps for FraudOutcome.
+var (
+	FraudOutcome_name = map[int32]string{
+		0: "FRAUD_OUTCOME_UNSPECIFIED",
+		1: "FRAUD_OUTCOME_ALLOW",
+		2: "FRAUD_OUTCOME_BLOCK",
+	}
+	FraudOutcome_value = map[string]int32{
+		"FRAUD_OUTCOME_UNSPECIFIED": 0,
+		"FRAUD_OUTCOME_ALLOW":       1,
+		"FRAUD_OUTCOME_BLOCK":       2,
+	}
+)
+
+func (x FraudOutcome) Enum() *FraudOutcome {
+	p := new(FraudOutcome)
+	*p = x
+	return p
+}
+
+func (x FraudOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[7].Descriptor()
+}
+
+func (FraudOutcome) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[7]
+}
+
+func (x FraudOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudOutcome.Descriptor instead.
+func (FraudOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+// FraudReason is a kind of fraud rule that blocked a payment.
+// It is sent in the "fraud_reason" ErrorInfo metadata of ERROR_REASON_PAYMENT_BLOCKED.
+type FraudReason int32
+
+const (
+	// Unspecified reason.
+	FraudReason_FRAUD_REASON_UNSPECIFIED FraudReason = 0
+	// The user or the order is blocklisted.
+	FraudReason_FRAUD_REASON_BLOCKLIST FraudReason = 1
+	// The amount exceeds the threshold of the payment method.
+	FraudReason_FRAUD_REASON_AMOUNT_LIMIT FraudReason = 2
+	// The user made too many payment attempts within the window.
+	FraudReason_FRAUD_REASON_VELOCITY_LIMIT FraudReason = 3
+	// The payment provider declined too many payments of the user within the window.
+	FraudReason_FRAUD_REASON_FAILED_ATTEMPTS FraudReason = 4
+)
+
+// Enum value maps for FraudReason.
+var (
+	FraudReason_name = map[int32]string{
+		0: "FRAUD_REASON_UNSPECIFIED",
+		1: "FRAUD_REASON_BLOCKLIST",
+		2: "FRAUD_REASON_AMOUNT_LIMIT",
+		3: "FRAUD_REASON_VELOCITY_LIMIT",
+		4: "FRAUD_REASON_FAILED_ATTEMPTS",
+	}
+	FraudReason_value = map[string]int32{
+		"FRAUD_REASON_UNSPECIFIED":     0,
+		"FRAUD_REASON_BLOCKLIST":       1,
+		"FRAUD_REASON_AMOUNT_LIMIT":    2,
+		"FRAUD_REASON_VELOCITY_LIMIT":  3,
+		"FRAUD_REASON_FAILED_ATTEMPTS": 4,
+	}
+)
+
+func (x FraudReason) Enum() *FraudReason {
+	p := new(FraudReason)
+	*p = x
+	return p
+}
+
+func (x FraudReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[8].Descriptor()
+}
+
+func (FraudReason) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[8]
+}
+
+func (x FraudReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudReason.Descriptor instead.
+func (FraudReason) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+// InstallmentPlanStatus is a status of an installment plan.
+type InstallmentPlanStatus int32
+
+const (
+	// Unspecified status.
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_UNSPECIFIED InstallmentPlanStatus = 0
+	// Installments are charged by the schedule.
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_ACTIVE InstallmentPlanStatus = 1
+	// Some missed installments are still unpaid; they are retried with the next ones.
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_DELINQUENT InstallmentPlanStatus = 2
+	// Too many installments were missed, charges are stopped.
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_DEFAULTED InstallmentPlanStatus = 3
+	// All installments are paid.
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_COMPLETED InstallmentPlanStatus = 4
+	// The transaction was refunded, charges are stopped.
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_CANCELLED InstallmentPlanStatus = 5
+)
+
+// Enum value maps for InstallmentPlanStatus.
+var (
+	InstallmentPlanStatus_name = map[int32]string{
+		0: "INSTALLMENT_PLAN_STATUS_UNSPECIFIED",
+		1: "INSTALLMENT_PLAN_STATUS_ACTIVE",
+		2: "INSTALLMENT_PLAN_STATUS_DELINQUENT",
+		3: "INSTALLMENT_PLAN_STATUS_DEFAULTED",
+		4: "INSTALLMENT_PLAN_STATUS_COMPLETED",
+		5: "INSTALLMENT_PLAN_STATUS_CANCELLED",
+	}
+	InstallmentPlanStatus_value = map[string]int32{
+		"INSTALLMENT_PLAN_STATUS_UNSPECIFIED": 0,
+		"INSTALLMENT_PLAN_STATUS_ACTIVE":      1,
+		"INSTALLMENT_PLAN_STATUS_DELINQUENT":  2,
+		"INSTALLMENT_PLAN_STATUS_DEFAULTED":   3,
+		"INSTALLMENT_PLAN_STATUS_COMPLETED":   4,
+		"INSTALLMENT_PLAN_STATUS_CANCELLED":   5,
+	}
 )
 
-// Enum value maps for PaymentMethod.
-var (
-	PaymentMethod_name = map[int32]string{
-		0: "PAYMENT_METHOD_UNSPECIFIED",
-		1: "PAYMENT_METHOD_CARD",
-		2: "PAYMENT_METHOD_SBP",
-		3: "PAYMENT_METHOD_CREDIT_CARD",
-		4: "PAYMENT_METHOD_INVESTOR_MONEY",
+func (x InstallmentPlanStatus) Enum() *InstallmentPlanStatus {
+	p := new(InstallmentPlanStatus)
+	*p = x
+	return p
+}
+
+func (x InstallmentPlanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstallmentPlanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[9].Descriptor()
+}
+
+func (InstallmentPlanStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[9]
+}
+
+func (x InstallmentPlanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstallmentPlanStatus.Descriptor instead.
+func (InstallmentPlanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+// InstallmentStatus is a status of an installment.
+type InstallmentStatus int32
+
+const (
+	// Unspecified status.
+	InstallmentStatus_INSTALLMENT_STATUS_UNSPECIFIED InstallmentStatus = 0
+	// The installment is not due yet or its charge is retried within the grace period.
+	InstallmentStatus_INSTALLMENT_STATUS_SCHEDULED InstallmentStatus = 1
+	// The installment is paid.
+	InstallmentStatus_INSTALLMENT_STATUS_PAID InstallmentStatus = 2
+	// The installment was not paid within the grace period; charges are still retried.
+	InstallmentStatus_INSTALLMENT_STATUS_MISSED InstallmentStatus = 3
+)
+
+// Enum value maps for InstallmentStatus.
+var (
+	InstallmentStatus_name = map[int32]string{
+		0: "INSTALLMENT_STATUS_UNSPECIFIED",
+		1: "INSTALLMENT_STATUS_SCHEDULED",
+		2: "INSTALLMENT_STATUS_PAID",
+		3: "INSTALLMENT_STATUS_MISSED",
+	}
+	InstallmentStatus_value = map[string]int32{
+		"INSTALLMENT_STATUS_UNSPECIFIED": 0,
+		"INSTALLMENT_STATUS_SCHEDULED":   1,
+		"INSTALLMENT_STATUS_PAID":        2,
+		"INSTALLMENT_STATUS_MISSED":      3,
+	}
+)
+
+func (x InstallmentStatus) Enum() *InstallmentStatus {
+	p := new(InstallmentStatus)
+	*p = x
+	return p
+}
+
+func (x InstallmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstallmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[10].Descriptor()
+}
+
+func (InstallmentStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[10]
+}
+
+func (x InstallmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstallmentStatus.Descriptor instead.
+func (InstallmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+// PaymentEventType is a kind of a payment event.
+type PaymentEventType int32
+
+const (
+	// Unspecified type.
+	PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED PaymentEventType = 0
+	// The amount is held, including a confirmed SBP payment.
+	PaymentEventType_PAYMENT_EVENT_TYPE_AUTHORIZED PaymentEventType = 1
+	// The amount is charged; the order is paid.
+	PaymentEventType_PAYMENT_EVENT_TYPE_CAPTURED PaymentEventType = 2
+	// The payment did not happen: the provider declined it or the authorization
+	// or the SBP payment expired.
+	PaymentEventType_PAYMENT_EVENT_TYPE_FAILED PaymentEventType = 3
+	// A part or all of the captured amount is refunded.
+	PaymentEventType_PAYMENT_EVENT_TYPE_REFUNDED PaymentEventType = 4
+	// The authorization is voided.
+	PaymentEventType_PAYMENT_EVENT_TYPE_VOIDED PaymentEventType = 5
+	// The card issuer disputed the captured amount.
+	PaymentEventType_PAYMENT_EVENT_TYPE_DISPUTE_OPENED PaymentEventType = 6
+	// The dispute is resolved in favor of the merchant.
+	PaymentEventType_PAYMENT_EVENT_TYPE_DISPUTE_WON PaymentEventType = 7
+	// The dispute is lost; the disputed amount is returned to the customer.
+	PaymentEventType_PAYMENT_EVENT_TYPE_DISPUTE_LOST PaymentEventType = 8
+	// A scheduled installment is charged; the amount includes its interest.
+	PaymentEventType_PAYMENT_EVENT_TYPE_INSTALLMENT_CHARGED PaymentEventType = 9
+)
+
+// Enum value maps for PaymentEventType.
+var (
+	PaymentEventType_name = map[int32]string{
+		0: "PAYMENT_EVENT_TYPE_UNSPECIFIED",
+		1: "PAYMENT_EVENT_TYPE_AUTHORIZED",
+		2: "PAYMENT_EVENT_TYPE_CAPTURED",
+		3: "PAYMENT_EVENT_TYPE_FAILED",
+		4: "PAYMENT_EVENT_TYPE_REFUNDED",
+		5: "PAYMENT_EVENT_TYPE_VOIDED",
+		6: "PAYMENT_EVENT_TYPE_DISPUTE_OPENED",
+		7: "PAYMENT_EVENT_TYPE_DISPUTE_WON",
+		8: "PAYMENT_EVENT_TYPE_DISPUTE_LOST",
+		9: "PAYMENT_EVENT_TYPE_INSTALLMENT_CHARGED",
+	}
+	PaymentEventType_value = map[string]int32{
+		"PAYMENT_EVENT_TYPE_UNSPECIFIED":         0,
+		"PAYMENT_EVENT_TYPE_AUTHORIZED":          1,
+		"PAYMENT_EVENT_TYPE_CAPTURED":            2,
+		"PAYMENT_EVENT_TYPE_FAILED":              3,
+		"PAYMENT_EVENT_TYPE_REFUNDED":            4,
+		"PAYMENT_EVENT_TYPE_VOIDED":              5,
+		"PAYMENT_EVENT_TYPE_DISPUTE_OPENED":      6,
+		"PAYMENT_EVENT_TYPE_DISPUTE_WON":         7,
+		"PAYMENT_EVENT_TYPE_DISPUTE_LOST":        8,
+		"PAYMENT_EVENT_TYPE_INSTALLMENT_CHARGED": 9,
+	}
+)
+
+func (x PaymentEventType) Enum() *PaymentEventType {
+	p := new(PaymentEventType)
+	*p = x
+	return p
+}
+
+func (x PaymentEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[11].Descriptor()
+}
+
+func (PaymentEventType) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[11]
+}
+
+func (x PaymentEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentEventType.Descriptor instead.
+func (PaymentEventType) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+// WebhookDeliveryStatus is a status of a webhook delivery.
+type WebhookDeliveryStatus int32
+
+const (
+	// Unspecified status.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	// The delivery waits for its first attempt or a retry.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING WebhookDeliveryStatus = 1
+	// The endpoint answered with a 2xx status.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED WebhookDeliveryStatus = 2
+	// Attempts are exhausted or the subscription is deleted; the delivery can be replayed.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[12].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[12]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+// CardBrand is a payment system of a card, detected by the card number.
+type CardBrand int32
+
+const (
+	// The payment system is not recognized.
+	CardBrand_CARD_BRAND_UNSPECIFIED CardBrand = 0
+	CardBrand_CARD_BRAND_VISA        CardBrand = 1
+	CardBrand_CARD_BRAND_MASTERCARD  CardBrand = 2
+	CardBrand_CARD_BRAND_MIR         CardBrand = 3
+	CardBrand_CARD_BRAND_AMEX        CardBrand = 4
+	CardBrand_CARD_BRAND_UNIONPAY    CardBrand = 5
+	CardBrand_CARD_BRAND_JCB         CardBrand = 6
+)
+
+// Enum value maps for CardBrand.
+var (
+	CardBrand_name = map[int32]string{
+		0: "CARD_BRAND_UNSPECIFIED",
+		1: "CARD_BRAND_VISA",
+		2: "CARD_BRAND_MASTERCARD",
+		3: "CARD_BRAND_MIR",
+		4: "CARD_BRAND_AMEX",
+		5: "CARD_BRAND_UNIONPAY",
+		6: "CARD_BRAND_JCB",
+	}
+	CardBrand_value = map[string]int32{
+		"CARD_BRAND_UNSPECIFIED": 0,
+		"CARD_BRAND_VISA":        1,
+		"CARD_BRAND_MASTERCARD":  2,
+		"CARD_BRAND_MIR":         3,
+		"CARD_BRAND_AMEX":        4,
+		"CARD_BRAND_UNIONPAY":    5,
+		"CARD_BRAND_JCB":         6,
+	}
+)
+
+func (x CardBrand) Enum() *CardBrand {
+	p := new(CardBrand)
+	*p = x
+	return p
+}
+
+func (x CardBrand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CardBrand) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[13].Descriptor()
+}
+
+func (CardBrand) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[13]
+}
+
+func (x CardBrand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CardBrand.Descriptor instead.
+func (CardBrand) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+// DisputeReason is a reason the customer gave to the issuer.
+type DisputeReason int32
+
+const (
+	DisputeReason_DISPUTE_REASON_UNSPECIFIED DisputeReason = 0
+	// The customer did not authorize the payment.
+	DisputeReason_DISPUTE_REASON_FRAUDULENT           DisputeReason = 1
+	DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED DisputeReason = 2
+	// The order was defective or not as described.
+	DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE DisputeReason = 3
+	DisputeReason_DISPUTE_REASON_DUPLICATE            DisputeReason = 4
+	// A promised refund was not made.
+	DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED DisputeReason = 5
+	DisputeReason_DISPUTE_REASON_GENERAL              DisputeReason = 6
+)
+
+// Enum value maps for DisputeReason.
+var (
+	DisputeReason_name = map[int32]string{
+		0: "DISPUTE_REASON_UNSPECIFIED",
+		1: "DISPUTE_REASON_FRAUDULENT",
+		2: "DISPUTE_REASON_PRODUCT_NOT_RECEIVED",
+		3: "DISPUTE_REASON_PRODUCT_UNACCEPTABLE",
+		4: "DISPUTE_REASON_DUPLICATE",
+		5: "DISPUTE_REASON_CREDIT_NOT_PROCESSED",
+		6: "DISPUTE_REASON_GENERAL",
+	}
+	DisputeReason_value = map[string]int32{
+		"DISPUTE_REASON_UNSPECIFIED":          0,
+		"DISPUTE_REASON_FRAUDULENT":           1,
+		"DISPUTE_REASON_PRODUCT_NOT_RECEIVED": 2,
+		"DISPUTE_REASON_PRODUCT_UNACCEPTABLE": 3,
+		"DISPUTE_REASON_DUPLICATE":            4,
+		"DISPUTE_REASON_CREDIT_NOT_PROCESSED": 5,
+		"DISPUTE_REASON_GENERAL":              6,
+	}
+)
+
+func (x DisputeReason) Enum() *DisputeReason {
+	p := new(DisputeReason)
+	*p = x
+	return p
+}
+
+func (x DisputeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[14].Descriptor()
+}
+
+func (DisputeReason) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[14]
+}
+
+func (x DisputeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeReason.Descriptor instead.
+func (DisputeReason) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+// DisputeStatus is a stage of a dispute.
+type DisputeStatus int32
+
+const (
+	DisputeStatus_DISPUTE_STATUS_UNSPECIFIED DisputeStatus = 0
+	// The dispute waits for evidence or acceptance.
+	DisputeStatus_DISPUTE_STATUS_NEEDS_RESPONSE DisputeStatus = 1
+	// Evidence is submitted; the issuer is reviewing it.
+	DisputeStatus_DISPUTE_STATUS_UNDER_REVIEW DisputeStatus = 2
+	// The issuer decided in favor of the merchant.
+	DisputeStatus_DISPUTE_STATUS_WON DisputeStatus = 3
+	// The disputed amount is returned to the customer.
+	DisputeStatus_DISPUTE_STATUS_LOST DisputeStatus = 4
+)
+
+// Enum value maps for DisputeStatus.
+var (
+	DisputeStatus_name = map[int32]string{
+		0: "DISPUTE_STATUS_UNSPECIFIED",
+		1: "DISPUTE_STATUS_NEEDS_RESPONSE",
+		2: "DISPUTE_STATUS_UNDER_REVIEW",
+		3: "DISPUTE_STATUS_WON",
+		4: "DISPUTE_STATUS_LOST",
+	}
+	DisputeStatus_value = map[string]int32{
+		"DISPUTE_STATUS_UNSPECIFIED":    0,
+		"DISPUTE_STATUS_NEEDS_RESPONSE": 1,
+		"DISPUTE_STATUS_UNDER_REVIEW":   2,
+		"DISPUTE_STATUS_WON":            3,
+		"DISPUTE_STATUS_LOST":           4,
+	}
+)
+
+func (x DisputeStatus) Enum() *DisputeStatus {
+	p := new(DisputeStatus)
+	*p = x
+	return p
+}
+
+func (x DisputeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[15].Descriptor()
+}
+
+func (DisputeStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[15]
+}
+
+func (x DisputeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeStatus.Descriptor instead.
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+}
+
+// DisputeOutcome is a decision of the issuer.
+type DisputeOutcome int32
+
+const (
+	DisputeOutcome_DISPUTE_OUTCOME_UNSPECIFIED DisputeOutcome = 0
+	DisputeOutcome_DISPUTE_OUTCOME_WON         DisputeOutcome = 1
+	DisputeOutcome_DISPUTE_OUTCOME_LOST        DisputeOutcome = 2
+)
+
+// Enum value maps for DisputeOutcome.
+var (
+	DisputeOutcome_name = map[int32]string{
+		0: "DISPUTE_OUTCOME_UNSPECIFIED",
+		1: "DISPUTE_OUTCOME_WON",
+		2: "DISPUTE_OUTCOME_LOST",
+	}
+	DisputeOutcome_value = map[string]int32{
+		"DISPUTE_OUTCOME_UNSPECIFIED": 0,
+		"DISPUTE_OUTCOME_WON":         1,
+		"DISPUTE_OUTCOME_LOST":        2,
+	}
+)
+
+func (x DisputeOutcome) Enum() *DisputeOutcome {
+	p := new(DisputeOutcome)
+	*p = x
+	return p
+}
+
+func (x DisputeOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[16].Descriptor()
+}
+
+func (DisputeOutcome) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[16]
+}
+
+func (x DisputeOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeOutcome.Descriptor instead.
+func (DisputeOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{16}
+}
+
+// TransactionStatus is a status of a transaction.
+type TransactionStatus int32
+
+const (
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED TransactionStatus = 0
+	// The amount is captured.
+	TransactionStatus_TRANSACTION_STATUS_PAID               TransactionStatus = 1
+	TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REFUNDED TransactionStatus = 2
+	TransactionStatus_TRANSACTION_STATUS_REFUNDED           TransactionStatus = 3
+	TransactionStatus_TRANSACTION_STATUS_AUTHORIZED         TransactionStatus = 4
+	TransactionStatus_TRANSACTION_STATUS_VOIDED             TransactionStatus = 5
+	TransactionStatus_TRANSACTION_STATUS_EXPIRED            TransactionStatus = 6
+	// The payment provider declined the authorization.
+	TransactionStatus_TRANSACTION_STATUS_DECLINED TransactionStatus = 7
+	// The SBP payment waits for the customer to pay by the QR code.
+	TransactionStatus_TRANSACTION_STATUS_PENDING TransactionStatus = 8
+	// Lost disputes returned the rest of the captured amount to the customer.
+	TransactionStatus_TRANSACTION_STATUS_CHARGED_BACK TransactionStatus = 9
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNSPECIFIED",
+		1: "TRANSACTION_STATUS_PAID",
+		2: "TRANSACTION_STATUS_PARTIALLY_REFUNDED",
+		3: "TRANSACTION_STATUS_REFUNDED",
+		4: "TRANSACTION_STATUS_AUTHORIZED",
+		5: "TRANSACTION_STATUS_VOIDED",
+		6: "TRANSACTION_STATUS_EXPIRED",
+		7: "TRANSACTION_STATUS_DECLINED",
+		8: "TRANSACTION_STATUS_PENDING",
+		9: "TRANSACTION_STATUS_CHARGED_BACK",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED":        0,
+		"TRANSACTION_STATUS_PAID":               1,
+		"TRANSACTION_STATUS_PARTIALLY_REFUNDED": 2,
+		"TRANSACTION_STATUS_REFUNDED":           3,
+		"TRANSACTION_STATUS_AUTHORIZED":         4,
+		"TRANSACTION_STATUS_VOIDED":             5,
+		"TRANSACTION_STATUS_EXPIRED":            6,
+		"TRANSACTION_STATUS_DECLINED":           7,
+		"TRANSACTION_STATUS_PENDING":            8,
+		"TRANSACTION_STATUS_CHARGED_BACK":       9,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[17].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[17]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{17}
+}
+
+// PaymentMethod is a method of pay
+type PaymentMethod int32
+
+const (
+	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED    PaymentMethod = 0
+	PaymentMethod_PAYMENT_METHOD_CARD           PaymentMethod = 1
+	PaymentMethod_PAYMENT_METHOD_SBP            PaymentMethod = 2
+	PaymentMethod_PAYMENT_METHOD_CREDIT_CARD    PaymentMethod = 3
+	PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY PaymentMethod = 4
+)
+
+// Enum value maps for PaymentMethod.
+var (
+	PaymentMethod_name = map[int32]string{
+		0: "PAYMENT_METHOD_UNSPECIFIED",
+		1: "PAYMENT_METHOD_CARD",
+		2: "PAYMENT_METHOD_SBP",
+		3: "PAYMENT_METHOD_CREDIT_CARD",
+		4: "PAYMENT_METHOD_INVESTOR_MONEY",
+	}
+	PaymentMethod_value = map[string]int32{
+		"PAYMENT_METHOD_UNSPECIFIED":    0,
+		"PAYMENT_METHOD_CARD":           1,
+		"PAYMENT_METHOD_SBP":            2,
+		"PAYMENT_METHOD_CREDIT_CARD":    3,
+		"PAYMENT_METHOD_INVESTOR_MONEY": 4,
+	}
+)
+
+func (x PaymentMethod) Enum() *PaymentMethod {
+	p := new(PaymentMethod)
+	*p = x
+	return p
+}
+
+func (x PaymentMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[18].Descriptor()
+}
+
+func (PaymentMethod) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[18]
+}
+
+func (x PaymentMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentMethod.Descriptor instead.
+func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{18}
+}
+
+// ErrorReason is a machine-readable reason of a PaymentService error.
+// It is sent as google.rpc.ErrorInfo.reason with the "payment.spaceyard" domain.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED                  ErrorReason = 0
+	ErrorReason_ERROR_REASON_INVALID_ARGUMENT             ErrorReason = 1
+	ErrorReason_ERROR_REASON_PAYMENT_METHOD_NOT_SUPPORTED ErrorReason = 2
+	ErrorReason_ERROR_REASON_TRANSACTION_NOT_FOUND        ErrorReason = 3
+	ErrorReason_ERROR_REASON_INVALID_AMOUNT               ErrorReason = 4
+	ErrorReason_ERROR_REASON_IDEMPOTENCY_KEY_REUSED       ErrorReason = 5
+	ErrorReason_ERROR_REASON_REFUND_EXCEEDS_CAPTURED      ErrorReason = 6
+	ErrorReason_ERROR_REASON_TRANSACTION_NOT_REFUNDABLE   ErrorReason = 7
+	ErrorReason_ERROR_REASON_REFUND_NOT_FOUND             ErrorReason = 8
+	ErrorReason_ERROR_REASON_AUTHORIZATION_EXPIRED        ErrorReason = 9
+	ErrorReason_ERROR_REASON_INVALID_TRANSACTION_STATE    ErrorReason = 10
+	// The payment provider declined the operation; ErrorInfo metadata carries "decline_code".
+	ErrorReason_ERROR_REASON_PAYMENT_DECLINED ErrorReason = 11
+	// The payment provider failed or timed out; the operation can be retried.
+	ErrorReason_ERROR_REASON_PROVIDER_UNAVAILABLE ErrorReason = 12
+	ErrorReason_ERROR_REASON_INVESTOR_NOT_FOUND   ErrorReason = 13
+	// The SBP payment was not paid before its QR code expired.
+	ErrorReason_ERROR_REASON_PAYMENT_INTENT_EXPIRED ErrorReason = 14
+	// Fraud screening blocked the payment; ErrorInfo metadata carries "fraud_reason"
+	// (a FraudReason name) and "rule".
+	ErrorReason_ERROR_REASON_PAYMENT_BLOCKED ErrorReason = 15
+	// The installment term is not offered or the payment method does not support installments.
+	ErrorReason_ERROR_REASON_INVALID_INSTALLMENT_TERM       ErrorReason = 16
+	ErrorReason_ERROR_REASON_INSTALLMENT_PLAN_NOT_FOUND     ErrorReason = 17
+	ErrorReason_ERROR_REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND ErrorReason = 18
+	// The delivery does not exist or is not dead, so it cannot be replayed.
+	ErrorReason_ERROR_REASON_WEBHOOK_DELIVERY_NOT_FOUND ErrorReason = 19
+	// The payment token is unknown or the card belongs to another user.
+	ErrorReason_ERROR_REASON_CARD_NOT_FOUND ErrorReason = 20
+	// The saved card is expired and cannot be charged.
+	ErrorReason_ERROR_REASON_CARD_EXPIRED ErrorReason = 21
+	// The card vault has no encryption keys configured.
+	ErrorReason_ERROR_REASON_CARD_VAULT_UNAVAILABLE ErrorReason = 22
+	// The transaction is not paid, so it has no receipt.
+	ErrorReason_ERROR_REASON_RECEIPT_NOT_FOUND ErrorReason = 23
+	ErrorReason_ERROR_REASON_DISPUTE_NOT_FOUND ErrorReason = 24
+	// Only captured card transactions with an amount left to return can be disputed.
+	ErrorReason_ERROR_REASON_TRANSACTION_NOT_DISPUTABLE ErrorReason = 25
+	// The transaction already has an unresolved dispute, so it can be neither disputed again nor refunded.
+	ErrorReason_ERROR_REASON_DISPUTE_ALREADY_OPEN ErrorReason = 26
+	// The dispute status does not allow the operation.
+	ErrorReason_ERROR_REASON_INVALID_DISPUTE_STATE ErrorReason = 27
+	// The evidence deadline of the dispute has passed.
+	ErrorReason_ERROR_REASON_DISPUTE_EVIDENCE_OVERDUE ErrorReason = 28
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "ERROR_REASON_INVALID_ARGUMENT",
+		2:  "ERROR_REASON_PAYMENT_METHOD_NOT_SUPPORTED",
+		3:  "ERROR_REASON_TRANSACTION_NOT_FOUND",
+		4:  "ERROR_REASON_INVALID_AMOUNT",
+		5:  "ERROR_REASON_IDEMPOTENCY_KEY_REUSED",
+		6:  "ERROR_REASON_REFUND_EXCEEDS_CAPTURED",
+		7:  "ERROR_REASON_TRANSACTION_NOT_REFUNDABLE",
+		8:  "ERROR_REASON_REFUND_NOT_FOUND",
+		9:  "ERROR_REASON_AUTHORIZATION_EXPIRED",
+		10: "ERROR_REASON_INVALID_TRANSACTION_STATE",
+		11: "ERROR_REASON_PAYMENT_DECLINED",
+		12: "ERROR_REASON_PROVIDER_UNAVAILABLE",
+		13: "ERROR_REASON_INVESTOR_NOT_FOUND",
+		14: "ERROR_REASON_PAYMENT_INTENT_EXPIRED",
+		15: "ERROR_REASON_PAYMENT_BLOCKED",
+		16: "ERROR_REASON_INVALID_INSTALLMENT_TERM",
+		17: "ERROR_REASON_INSTALLMENT_PLAN_NOT_FOUND",
+		18: "ERROR_REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND",
+		19: "ERROR_REASON_WEBHOOK_DELIVERY_NOT_FOUND",
+		20: "ERROR_REASON_CARD_NOT_FOUND",
+		21: "ERROR_REASON_CARD_EXPIRED",
+		22: "ERROR_REASON_CARD_VAULT_UNAVAILABLE",
+		23: "ERROR_REASON_RECEIPT_NOT_FOUND",
+		24: "ERROR_REASON_DISPUTE_NOT_FOUND",
+		25: "ERROR_REASON_TRANSACTION_NOT_DISPUTABLE",
+		26: "ERROR_REASON_DISPUTE_ALREADY_OPEN",
+		27: "ERROR_REASON_INVALID_DISPUTE_STATE",
+		28: "ERROR_REASON_DISPUTE_EVIDENCE_OVERDUE",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":                    0,
+		"ERROR_REASON_INVALID_ARGUMENT":               1,
+		"ERROR_REASON_PAYMENT_METHOD_NOT_SUPPORTED":   2,
+		"ERROR_REASON_TRANSACTION_NOT_FOUND":          3,
+		"ERROR_REASON_INVALID_AMOUNT":                 4,
+		"ERROR_REASON_IDEMPOTENCY_KEY_REUSED":         5,
+		"ERROR_REASON_REFUND_EXCEEDS_CAPTURED":        6,
+		"ERROR_REASON_TRANSACTION_NOT_REFUNDABLE":     7,
+		"ERROR_REASON_REFUND_NOT_FOUND":               8,
+		"ERROR_REASON_AUTHORIZATION_EXPIRED":          9,
+		"ERROR_REASON_INVALID_TRANSACTION_STATE":      10,
+		"ERROR_REASON_PAYMENT_DECLINED":               11,
+		"ERROR_REASON_PROVIDER_UNAVAILABLE":           12,
+		"ERROR_REASON_INVESTOR_NOT_FOUND":             13,
+		"ERROR_REASON_PAYMENT_INTENT_EXPIRED":         14,
+		"ERROR_REASON_PAYMENT_BLOCKED":                15,
+		"ERROR_REASON_INVALID_INSTALLMENT_TERM":       16,
+		"ERROR_REASON_INSTALLMENT_PLAN_NOT_FOUND":     17,
+		"ERROR_REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND": 18,
+		"ERROR_REASON_WEBHOOK_DELIVERY_NOT_FOUND":     19,
+		"ERROR_REASON_CARD_NOT_FOUND":                 20,
+		"ERROR_REASON_CARD_EXPIRED":                   21,
+		"ERROR_REASON_CARD_VAULT_UNAVAILABLE":         22,
+		"ERROR_REASON_RECEIPT_NOT_FOUND":              23,
+		"ERROR_REASON_DISPUTE_NOT_FOUND":              24,
+		"ERROR_REASON_TRANSACTION_NOT_DISPUTABLE":     25,
+		"ERROR_REASON_DISPUTE_ALREADY_OPEN":           26,
+		"ERROR_REASON_INVALID_DISPUTE_STATE":          27,
+		"ERROR_REASON_DISPUTE_EVIDENCE_OVERDUE":       28,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[19].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[19]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{19}
+}
+
+// PayOrderRequest is a request to for pay.
+type PayOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Amount to charge, must be positive.
+	Amount *v1.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional idempotency key. It can also be passed in the "idempotency-key" metadata;
+	// if both are set they must be equal.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Investor paying with PAYMENT_METHOD_INVESTOR_MONEY. If empty, an investor who allowed
+	// the user to spend its money is chosen, preferably one with enough available money.
+	InvestorUuid string `protobuf:"bytes,6,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	// Installment term in months for PAYMENT_METHOD_CREDIT_CARD, zero to pay in full.
+	// The order is paid in full, the card is charged the first installment on capture
+	// and the rest by the schedule of the created installment plan.
+	InstallmentTermMonths int32 `protobuf:"varint,7,opt,name=installment_term_months,json=installmentTermMonths,proto3" json:"installment_term_months,omitempty"`
+	// Token of a saved card to pay with PAYMENT_METHOD_CARD. The card must belong to the user.
+	PaymentToken string `protobuf:"bytes,8,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	// Order lines to print in the receipt, in the currency of the amount. Their total must
+	// equal the amount. If empty, the receipt has a single line for the whole amount.
+	LineItems     []*LineItem `protobuf:"bytes,9,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayOrderRequest) Reset() {
+	*x = PayOrderRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderRequest) ProtoMessage() {}
+
+func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderRequest.ProtoReflect.Descriptor instead.
+func (*PayOrderRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PayOrderRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *PayOrderRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PayOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetInstallmentTermMonths() int32 {
+	if x != nil {
+		return x.InstallmentTermMonths
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+// PayOrderResponse is a response with an uuid.
+type PayOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// QR code to pay a PAYMENT_METHOD_SBP order, unset for other payment methods.
+	SbpPaymentIntent *SbpPaymentIntent `protobuf:"bytes,2,opt,name=sbp_payment_intent,json=sbpPaymentIntent,proto3" json:"sbp_payment_intent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PayOrderResponse) Reset() {
+	*x = PayOrderResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderResponse) ProtoMessage() {}
+
+func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderResponse.ProtoReflect.Descriptor instead.
+func (*PayOrderResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayOrderResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PayOrderResponse) GetSbpPaymentIntent() *SbpPaymentIntent {
+	if x != nil {
+		return x.SbpPaymentIntent
+	}
+	return nil
+}
+
+// AuthorizePaymentRequest is a request to hold an amount for an order.
+type AuthorizePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Amount to hold, must be positive.
+	Amount *v1.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional idempotency key. It can also be passed in the "idempotency-key" metadata;
+	// if both are set they must be equal.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Investor paying with PAYMENT_METHOD_INVESTOR_MONEY. If empty, an investor who allowed
+	// the user to spend its money is chosen, preferably one with enough available money.
+	InvestorUuid string `protobuf:"bytes,6,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	// Installment term in months for PAYMENT_METHOD_CREDIT_CARD, zero to pay in full.
+	// The order is paid in full, the card is charged the first installment on capture
+	// and the rest by the schedule of the created installment plan.
+	InstallmentTermMonths int32 `protobuf:"varint,7,opt,name=installment_term_months,json=installmentTermMonths,proto3" json:"installment_term_months,omitempty"`
+	// Token of a saved card to pay with PAYMENT_METHOD_CARD. The card must belong to the user.
+	PaymentToken string `protobuf:"bytes,8,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	// Order lines to print in the receipt, in the currency of the amount. Their total must
+	// equal the amount. If empty, the receipt has a single line for the whole amount.
+	LineItems     []*LineItem `protobuf:"bytes,9,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentRequest) Reset() {
+	*x = AuthorizePaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentRequest) ProtoMessage() {}
+
+func (x *AuthorizePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AuthorizePaymentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *AuthorizePaymentRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *AuthorizePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetInstallmentTermMonths() int32 {
+	if x != nil {
+		return x.InstallmentTermMonths
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *AuthorizePaymentRequest) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+// AuthorizePaymentResponse is a response with the authorized transaction.
+type AuthorizePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentResponse) Reset() {
+	*x = AuthorizePaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentResponse) ProtoMessage() {}
+
+func (x *AuthorizePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthorizePaymentResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// CapturePaymentRequest is a request to charge an authorized amount.
+type CapturePaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Amount to capture, must not exceed the authorized amount.
+	// Unset captures the whole authorized amount.
+	Amount        *v1.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturePaymentRequest) Reset() {
+	*x = CapturePaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentRequest) ProtoMessage() {}
+
+func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CapturePaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *CapturePaymentRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// CapturePaymentResponse is a response with the captured transaction.
+type CapturePaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturePaymentResponse) Reset() {
+	*x = CapturePaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentResponse) ProtoMessage() {}
+
+func (x *CapturePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentResponse.ProtoReflect.Descriptor instead.
+func (*CapturePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CapturePaymentResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// VoidAuthorizationRequest is a request to release an authorized amount.
+type VoidAuthorizationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VoidAuthorizationRequest) Reset() {
+	*x = VoidAuthorizationRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidAuthorizationRequest) ProtoMessage() {}
+
+func (x *VoidAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*VoidAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VoidAuthorizationRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// VoidAuthorizationResponse is a response with the voided transaction.
+type VoidAuthorizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidAuthorizationResponse) Reset() {
+	*x = VoidAuthorizationResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidAuthorizationResponse) ProtoMessage() {}
+
+func (x *VoidAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*VoidAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VoidAuthorizationResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// CreateSbpPaymentIntentRequest is a request to create an SBP payment paid by a QR code.
+type CreateSbpPaymentIntentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuid string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid  string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Amount in RUB with kopeck precision.
+	Amount *v1.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Optional idempotency key. It can also be passed in the "idempotency-key" metadata;
+	// if both are set they must be equal.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Format of the QR code image, PNG if unspecified.
+	ImageFormat QrImageFormat `protobuf:"varint,5,opt,name=image_format,json=imageFormat,proto3,enum=payment.v1.QrImageFormat" json:"image_format,omitempty"`
+	// Order lines to print in the receipt, in the currency of the amount. Their total must
+	// equal the amount. If empty, the receipt has a single line for the whole amount.
+	LineItems     []*LineItem `protobuf:"bytes,6,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSbpPaymentIntentRequest) Reset() {
+	*x = CreateSbpPaymentIntentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSbpPaymentIntentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSbpPaymentIntentRequest) ProtoMessage() {}
+
+func (x *CreateSbpPaymentIntentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSbpPaymentIntentRequest.ProtoReflect.Descriptor instead.
+func (*CreateSbpPaymentIntentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateSbpPaymentIntentRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *CreateSbpPaymentIntentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *CreateSbpPaymentIntentRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateSbpPaymentIntentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateSbpPaymentIntentRequest) GetImageFormat() QrImageFormat {
+	if x != nil {
+		return x.ImageFormat
+	}
+	return QrImageFormat_QR_IMAGE_FORMAT_UNSPECIFIED
+}
+
+func (x *CreateSbpPaymentIntentRequest) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+// CreateSbpPaymentIntentResponse is a response with the created SBP payment.
+type CreateSbpPaymentIntentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intent        *SbpPaymentIntent      `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSbpPaymentIntentResponse) Reset() {
+	*x = CreateSbpPaymentIntentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSbpPaymentIntentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSbpPaymentIntentResponse) ProtoMessage() {}
+
+func (x *CreateSbpPaymentIntentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSbpPaymentIntentResponse.ProtoReflect.Descriptor instead.
+func (*CreateSbpPaymentIntentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateSbpPaymentIntentResponse) GetIntent() *SbpPaymentIntent {
+	if x != nil {
+		return x.Intent
+	}
+	return nil
+}
+
+// GetSbpPaymentIntentRequest is a request to get an SBP payment by its transaction UUID.
+type GetSbpPaymentIntentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Format of the QR code image, PNG if unspecified.
+	ImageFormat   QrImageFormat `protobuf:"varint,2,opt,name=image_format,json=imageFormat,proto3,enum=payment.v1.QrImageFormat" json:"image_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSbpPaymentIntentRequest) Reset() {
+	*x = GetSbpPaymentIntentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSbpPaymentIntentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSbpPaymentIntentRequest) ProtoMessage() {}
+
+func (x *GetSbpPaymentIntentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSbpPaymentIntentRequest.ProtoReflect.Descriptor instead.
+func (*GetSbpPaymentIntentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSbpPaymentIntentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *GetSbpPaymentIntentRequest) GetImageFormat() QrImageFormat {
+	if x != nil {
+		return x.ImageFormat
+	}
+	return QrImageFormat_QR_IMAGE_FORMAT_UNSPECIFIED
+}
+
+// GetSbpPaymentIntentResponse is a response with an SBP payment.
+type GetSbpPaymentIntentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Intent        *SbpPaymentIntent      `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSbpPaymentIntentResponse) Reset() {
+	*x = GetSbpPaymentIntentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSbpPaymentIntentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSbpPaymentIntentResponse) ProtoMessage() {}
+
+func (x *GetSbpPaymentIntentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSbpPaymentIntentResponse.ProtoReflect.Descriptor instead.
+func (*GetSbpPaymentIntentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSbpPaymentIntentResponse) GetIntent() *SbpPaymentIntent {
+	if x != nil {
+		return x.Intent
+	}
+	return nil
+}
+
+// ConfirmSbpPaymentIntentRequest is a request to pay a pending SBP payment.
+type ConfirmSbpPaymentIntentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConfirmSbpPaymentIntentRequest) Reset() {
+	*x = ConfirmSbpPaymentIntentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSbpPaymentIntentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSbpPaymentIntentRequest) ProtoMessage() {}
+
+func (x *ConfirmSbpPaymentIntentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSbpPaymentIntentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmSbpPaymentIntentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmSbpPaymentIntentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// ConfirmSbpPaymentIntentResponse is a response with the paid transaction.
+type ConfirmSbpPaymentIntentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmSbpPaymentIntentResponse) Reset() {
+	*x = ConfirmSbpPaymentIntentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmSbpPaymentIntentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmSbpPaymentIntentResponse) ProtoMessage() {}
+
+func (x *ConfirmSbpPaymentIntentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmSbpPaymentIntentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmSbpPaymentIntentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmSbpPaymentIntentResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// SbpPaymentIntent is an SBP payment paid by scanning a QR code.
+type SbpPaymentIntent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Transaction of the payment. It is pending until the intent is confirmed
+	// and expires at authorization_expires_at if it is not paid.
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// NSPK link encoded in the QR code, e.g.
+	// "https://qr.nspk.ru/AD…?type=02&bank=…&sum=10000&cur=RUB&crc=…"; sum is in kopecks.
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// QR code image.
+	QrImage []byte `protobuf:"bytes,3,opt,name=qr_image,json=qrImage,proto3" json:"qr_image,omitempty"`
+	// MIME type of qr_image: "image/png" or "image/svg+xml".
+	QrImageContentType string `protobuf:"bytes,4,opt,name=qr_image_content_type,json=qrImageContentType,proto3" json:"qr_image_content_type,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SbpPaymentIntent) Reset() {
+	*x = SbpPaymentIntent{}
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SbpPaymentIntent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SbpPaymentIntent) ProtoMessage() {}
+
+func (x *SbpPaymentIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SbpPaymentIntent.ProtoReflect.Descriptor instead.
+func (*SbpPaymentIntent) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SbpPaymentIntent) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *SbpPaymentIntent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SbpPaymentIntent) GetQrImage() []byte {
+	if x != nil {
+		return x.QrImage
+	}
+	return nil
+}
+
+func (x *SbpPaymentIntent) GetQrImageContentType() string {
+	if x != nil {
+		return x.QrImageContentType
+	}
+	return ""
+}
+
+// GetTransactionRequest is a request to get a transaction by its UUID.
+type GetTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTransactionRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetTransactionResponse is a response with a transaction.
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// ListTransactionsRequest is a request to list transactions with optional filtering.
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *TransactionsFilter    `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTransactionsRequest) GetFilter() *TransactionsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ListTransactionsResponse is a response with a list of transactions ordered by creation time.
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// RefundPaymentRequest is a request to refund a transaction.
+type RefundPaymentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Amount to refund. Unset refunds the whole remaining amount. For installments the
+	// refund first reduces the unpaid installments, and only the rest is returned to the card.
+	Amount        *v1.Money    `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        RefundReason `protobuf:"varint,3,opt,name=reason,proto3,enum=payment.v1.RefundReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RefundPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundPaymentRequest) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+// RefundPaymentResponse is a response with the created refund.
+type RefundPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refund        *Refund                `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentResponse) ProtoMessage() {}
+
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefundPaymentResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// GetRefundRequest is a request to get a refund by its UUID.
+type GetRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundUuid    string                 `protobuf:"bytes,1,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundRequest) Reset() {
+	*x = GetRefundRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundRequest) ProtoMessage() {}
+
+func (x *GetRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRefundRequest) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+// GetRefundResponse is a response with a refund.
+type GetRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refund        *Refund                `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundResponse) Reset() {
+	*x = GetRefundResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundResponse) ProtoMessage() {}
+
+func (x *GetRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundResponse.ProtoReflect.Descriptor instead.
+func (*GetRefundResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// ListRefundsRequest is a request to list refunds of a transaction.
+type ListRefundsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListRefundsRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// ListRefundsResponse is a response with a list of refunds.
+type ListRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+// Refund is a return of money of a transaction.
+type Refund struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Amount returned to the customer.
+	Amount    *v1.Money              `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason    RefundReason           `protobuf:"varint,4,opt,name=reason,proto3,enum=payment.v1.RefundReason" json:"reason,omitempty"`
+	Status    RefundStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.RefundStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Part of the refund that reduced the unpaid principal of the installments instead
+	// of being returned to the customer.
+	WrittenOffAmount *v1.Money `protobuf:"bytes,7,opt,name=written_off_amount,json=writtenOffAmount,proto3" json:"written_off_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_payment_v1_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Refund) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Refund) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Refund) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Refund) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *Refund) GetStatus() RefundStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RefundStatus_REFUND_STATUS_UNSPECIFIED
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetWrittenOffAmount() *v1.Money {
+	if x != nil {
+		return x.WrittenOffAmount
+	}
+	return nil
+}
+
+// ListAccountBalancesRequest is a request for ledger account balances. Empty fields are not applied.
+type ListAccountBalancesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountType LedgerAccountType      `protobuf:"varint,1,opt,name=account_type,json=accountType,proto3,enum=payment.v1.LedgerAccountType" json:"account_type,omitempty"`
+	// Owner of customer accounts, i.e. a user UUID.
+	OwnerUuid     string `protobuf:"bytes,2,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountBalancesRequest) Reset() {
+	*x = ListAccountBalancesRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountBalancesRequest) ProtoMessage() {}
+
+func (x *ListAccountBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAccountBalancesRequest) GetAccountType() LedgerAccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return LedgerAccountType_LEDGER_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ListAccountBalancesRequest) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+// ListAccountBalancesResponse is a response with ledger account balances.
+type ListAccountBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*AccountBalance      `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountBalancesResponse) Reset() {
+	*x = ListAccountBalancesResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountBalancesResponse) ProtoMessage() {}
+
+func (x *ListAccountBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAccountBalancesResponse) GetBalances() []*AccountBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// ListJournalEntriesRequest is a request for ledger journal entries.
+type ListJournalEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional transaction UUID; empty returns the whole journal.
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesRequest) Reset() {
+	*x = ListJournalEntriesRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListJournalEntriesRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// ListJournalEntriesResponse is a response with ledger journal entries.
+type ListJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesResponse) Reset() {
+	*x = ListJournalEntriesResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// CheckLedgerConsistencyRequest is a request to verify the ledger.
+type CheckLedgerConsistencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckLedgerConsistencyRequest) Reset() {
+	*x = CheckLedgerConsistencyRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckLedgerConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLedgerConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckLedgerConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLedgerConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckLedgerConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{30}
+}
+
+// CheckLedgerConsistencyResponse is a result of the ledger verification.
+type CheckLedgerConsistencyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when no violations were found.
+	Consistent     bool               `protobuf:"varint,1,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	EntriesChecked int64              `protobuf:"varint,2,opt,name=entries_checked,json=entriesChecked,proto3" json:"entries_checked,omitempty"`
+	Violations     []*LedgerViolation `protobuf:"bytes,3,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckLedgerConsistencyResponse) Reset() {
+	*x = CheckLedgerConsistencyResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckLedgerConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLedgerConsistencyResponse) ProtoMessage() {}
+
+func (x *CheckLedgerConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLedgerConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckLedgerConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheckLedgerConsistencyResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *CheckLedgerConsistencyResponse) GetEntriesChecked() int64 {
+	if x != nil {
+		return x.EntriesChecked
+	}
+	return 0
+}
+
+func (x *CheckLedgerConsistencyResponse) GetViolations() []*LedgerViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// LedgerViolation is a discrepancy found by the ledger verification.
+type LedgerViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entry the violation refers to, empty for violations of the whole ledger.
+	EntryUuid string `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	// Transaction the violation refers to, empty for violations of the whole ledger.
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Description     string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LedgerViolation) Reset() {
+	*x = LedgerViolation{}
+	mi := &file_payment_v1_payment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerViolation) ProtoMessage() {}
+
+func (x *LedgerViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerViolation.ProtoReflect.Descriptor instead.
+func (*LedgerViolation) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LedgerViolation) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *LedgerViolation) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *LedgerViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// LedgerAccount is an account of the double-entry ledger.
+type LedgerAccount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  LedgerAccountType      `protobuf:"varint,1,opt,name=type,proto3,enum=payment.v1.LedgerAccountType" json:"type,omitempty"`
+	// User UUID of a customer account, empty for shared accounts.
+	OwnerUuid     string `protobuf:"bytes,2,opt,name=owner_uuid,json=ownerUuid,proto3" json:"owner_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerAccount) Reset() {
+	*x = LedgerAccount{}
+	mi := &file_payment_v1_payment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerAccount) ProtoMessage() {}
+
+func (x *LedgerAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerAccount.ProtoReflect.Descriptor instead.
+func (*LedgerAccount) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LedgerAccount) GetType() LedgerAccountType {
+	if x != nil {
+		return x.Type
+	}
+	return LedgerAccountType_LEDGER_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *LedgerAccount) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+// AccountBalance is turnover and balance of an account in one currency.
+type AccountBalance struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *LedgerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Debits  *v1.Money              `protobuf:"bytes,2,opt,name=debits,proto3" json:"debits,omitempty"`
+	Credits *v1.Money              `protobuf:"bytes,3,opt,name=credits,proto3" json:"credits,omitempty"`
+	// Debits minus credits.
+	Balance       *v1.Money `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_payment_v1_payment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AccountBalance) GetAccount() *LedgerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetDebits() *v1.Money {
+	if x != nil {
+		return x.Debits
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetCredits() *v1.Money {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetBalance() *v1.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// JournalEntry is a balanced ledger entry. Entries are never changed;
+// mistakes are corrected by reversal entries.
+type JournalEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Kind            JournalEntryKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=payment.v1.JournalEntryKind" json:"kind,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,3,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Refund of a refund entry.
+	RefundUuid string `protobuf:"bytes,4,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	// Entry cancelled by a reversal entry.
+	ReversesEntryUuid string                 `protobuf:"bytes,5,opt,name=reverses_entry_uuid,json=reversesEntryUuid,proto3" json:"reverses_entry_uuid,omitempty"`
+	Postings          []*Posting             `protobuf:"bytes,6,rep,name=postings,proto3" json:"postings,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Dispute of a chargeback entry.
+	DisputeUuid   string `protobuf:"bytes,8,opt,name=dispute_uuid,json=disputeUuid,proto3" json:"dispute_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_payment_v1_payment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *JournalEntry) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetKind() JournalEntryKind {
+	if x != nil {
+		return x.Kind
+	}
+	return JournalEntryKind_JOURNAL_ENTRY_KIND_UNSPECIFIED
+}
+
+func (x *JournalEntry) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetReversesEntryUuid() string {
+	if x != nil {
+		return x.ReversesEntryUuid
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetPostings() []*Posting {
+	if x != nil {
+		return x.Postings
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetDisputeUuid() string {
+	if x != nil {
+		return x.DisputeUuid
+	}
+	return ""
+}
+
+// Posting is a debit or a credit of an account within a journal entry.
+type Posting struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Account   *LedgerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Direction PostingDirection       `protobuf:"varint,2,opt,name=direction,proto3,enum=payment.v1.PostingDirection" json:"direction,omitempty"`
+	// Positive amount of the posting.
+	Amount        *v1.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Posting) Reset() {
+	*x = Posting{}
+	mi := &file_payment_v1_payment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Posting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Posting) ProtoMessage() {}
+
+func (x *Posting) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Posting.ProtoReflect.Descriptor instead.
+func (*Posting) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Posting) GetAccount() *LedgerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Posting) GetDirection() PostingDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return PostingDirection_POSTING_DIRECTION_UNSPECIFIED
+}
+
+func (x *Posting) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// CreateInvestorRequest is a request to create an investor.
+type CreateInvestorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Currency of all balances of the investor.
+	CurrencyCode string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Users allowed to spend the money of the investor.
+	AuthorizedUserUuids []string `protobuf:"bytes,3,rep,name=authorized_user_uuids,json=authorizedUserUuids,proto3" json:"authorized_user_uuids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateInvestorRequest) Reset() {
+	*x = CreateInvestorRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvestorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvestorRequest) ProtoMessage() {}
+
+func (x *CreateInvestorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvestorRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvestorRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateInvestorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInvestorRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateInvestorRequest) GetAuthorizedUserUuids() []string {
+	if x != nil {
+		return x.AuthorizedUserUuids
+	}
+	return nil
+}
+
+// CreateInvestorResponse is a response with the created investor.
+type CreateInvestorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investor      *Investor              `protobuf:"bytes,1,opt,name=investor,proto3" json:"investor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvestorResponse) Reset() {
+	*x = CreateInvestorResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvestorResponse) ProtoMessage() {}
+
+func (x *CreateInvestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvestorResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvestorResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateInvestorResponse) GetInvestor() *Investor {
+	if x != nil {
+		return x.Investor
+	}
+	return nil
+}
+
+// GetInvestorRequest is a request to get an investor by its UUID.
+type GetInvestorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvestorUuid  string                 `protobuf:"bytes,1,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorRequest) Reset() {
+	*x = GetInvestorRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorRequest) ProtoMessage() {}
+
+func (x *GetInvestorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestorRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetInvestorRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+// GetInvestorResponse is a response with an investor.
+type GetInvestorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investor      *Investor              `protobuf:"bytes,1,opt,name=investor,proto3" json:"investor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorResponse) Reset() {
+	*x = GetInvestorResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorResponse) ProtoMessage() {}
+
+func (x *GetInvestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestorResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetInvestorResponse) GetInvestor() *Investor {
+	if x != nil {
+		return x.Investor
+	}
+	return nil
+}
+
+// ListInvestorsRequest is a request to list investors.
+type ListInvestorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvestorsRequest) Reset() {
+	*x = ListInvestorsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvestorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvestorsRequest) ProtoMessage() {}
+
+func (x *ListInvestorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvestorsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvestorsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{41}
+}
+
+// ListInvestorsResponse is a response with investors.
+type ListInvestorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investors     []*Investor            `protobuf:"bytes,1,rep,name=investors,proto3" json:"investors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvestorsResponse) Reset() {
+	*x = ListInvestorsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvestorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvestorsResponse) ProtoMessage() {}
+
+func (x *ListInvestorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvestorsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvestorsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListInvestorsResponse) GetInvestors() []*Investor {
+	if x != nil {
+		return x.Investors
+	}
+	return nil
+}
+
+// TopUpInvestorRequest is a request to add money to an investor.
+type TopUpInvestorRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	InvestorUuid string                 `protobuf:"bytes,1,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	// Positive amount in the currency of the investor.
+	Amount        *v1.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpInvestorRequest) Reset() {
+	*x = TopUpInvestorRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpInvestorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpInvestorRequest) ProtoMessage() {}
+
+func (x *TopUpInvestorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpInvestorRequest.ProtoReflect.Descriptor instead.
+func (*TopUpInvestorRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TopUpInvestorRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *TopUpInvestorRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// TopUpInvestorResponse is a response with the updated investor.
+type TopUpInvestorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investor      *Investor              `protobuf:"bytes,1,opt,name=investor,proto3" json:"investor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpInvestorResponse) Reset() {
+	*x = TopUpInvestorResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpInvestorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpInvestorResponse) ProtoMessage() {}
+
+func (x *TopUpInvestorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpInvestorResponse.ProtoReflect.Descriptor instead.
+func (*TopUpInvestorResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TopUpInvestorResponse) GetInvestor() *Investor {
+	if x != nil {
+		return x.Investor
+	}
+	return nil
+}
+
+// GrantInvestorAccessRequest is a request to allow a user to spend the money of an investor.
+type GrantInvestorAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvestorUuid  string                 `protobuf:"bytes,1,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantInvestorAccessRequest) Reset() {
+	*x = GrantInvestorAccessRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantInvestorAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantInvestorAccessRequest) ProtoMessage() {}
+
+func (x *GrantInvestorAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantInvestorAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantInvestorAccessRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GrantInvestorAccessRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *GrantInvestorAccessRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// GrantInvestorAccessResponse is a response with the updated investor.
+type GrantInvestorAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investor      *Investor              `protobuf:"bytes,1,opt,name=investor,proto3" json:"investor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantInvestorAccessResponse) Reset() {
+	*x = GrantInvestorAccessResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantInvestorAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantInvestorAccessResponse) ProtoMessage() {}
+
+func (x *GrantInvestorAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantInvestorAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantInvestorAccessResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GrantInvestorAccessResponse) GetInvestor() *Investor {
+	if x != nil {
+		return x.Investor
+	}
+	return nil
+}
+
+// RevokeInvestorAccessRequest is a request to forbid a user to spend the money of an investor.
+type RevokeInvestorAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvestorUuid  string                 `protobuf:"bytes,1,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvestorAccessRequest) Reset() {
+	*x = RevokeInvestorAccessRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvestorAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvestorAccessRequest) ProtoMessage() {}
+
+func (x *RevokeInvestorAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvestorAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvestorAccessRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RevokeInvestorAccessRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *RevokeInvestorAccessRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// RevokeInvestorAccessResponse is a response with the updated investor.
+type RevokeInvestorAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Investor      *Investor              `protobuf:"bytes,1,opt,name=investor,proto3" json:"investor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvestorAccessResponse) Reset() {
+	*x = RevokeInvestorAccessResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvestorAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvestorAccessResponse) ProtoMessage() {}
+
+func (x *RevokeInvestorAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvestorAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvestorAccessResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RevokeInvestorAccessResponse) GetInvestor() *Investor {
+	if x != nil {
+		return x.Investor
+	}
+	return nil
+}
+
+// ListInvestorMovementsRequest is a request for movements of an investor's money.
+type ListInvestorMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvestorUuid  string                 `protobuf:"bytes,1,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvestorMovementsRequest) Reset() {
+	*x = ListInvestorMovementsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvestorMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvestorMovementsRequest) ProtoMessage() {}
+
+func (x *ListInvestorMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvestorMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvestorMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListInvestorMovementsRequest) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+// ListInvestorMovementsResponse is a response with movements of an investor's money.
+type ListInvestorMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*InvestorMovement    `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvestorMovementsResponse) Reset() {
+	*x = ListInvestorMovementsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvestorMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvestorMovementsResponse) ProtoMessage() {}
+
+func (x *ListInvestorMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvestorMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvestorMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListInvestorMovementsResponse) GetMovements() []*InvestorMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// Investor funds orders of the users it has authorized.
+type Investor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Money that can be spent; amounts held by authorizations are already subtracted.
+	Available *v1.Money `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+	// Money held by authorizations that are not captured yet.
+	Held *v1.Money `protobuf:"bytes,4,opt,name=held,proto3" json:"held,omitempty"`
+	// Captured money net of refunds.
+	Spent *v1.Money `protobuf:"bytes,5,opt,name=spent,proto3" json:"spent,omitempty"`
+	// Users allowed to spend the money of the investor.
+	AuthorizedUserUuids []string               `protobuf:"bytes,6,rep,name=authorized_user_uuids,json=authorizedUserUuids,proto3" json:"authorized_user_uuids,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Investor) Reset() {
+	*x = Investor{}
+	mi := &file_payment_v1_payment_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Investor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Investor) ProtoMessage() {}
+
+func (x *Investor) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Investor.ProtoReflect.Descriptor instead.
+func (*Investor) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Investor) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Investor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Investor) GetAvailable() *v1.Money {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+func (x *Investor) GetHeld() *v1.Money {
+	if x != nil {
+		return x.Held
+	}
+	return nil
+}
+
+func (x *Investor) GetSpent() *v1.Money {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+func (x *Investor) GetAuthorizedUserUuids() []string {
+	if x != nil {
+		return x.AuthorizedUserUuids
+	}
+	return nil
+}
+
+func (x *Investor) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Investor) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// InvestorMovement is a change of an investor's balances.
+type InvestorMovement struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Uuid         string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	InvestorUuid string                 `protobuf:"bytes,2,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	Kind         InvestorMovementKind   `protobuf:"varint,3,opt,name=kind,proto3,enum=payment.v1.InvestorMovementKind" json:"kind,omitempty"`
+	// Positive amount of the movement.
+	Amount *v1.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Transaction that caused the movement, empty for top-ups.
+	TransactionUuid string `protobuf:"bytes,5,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// User who paid with the investor's money, empty for top-ups.
+	UserUuid      string                 `protobuf:"bytes,6,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvestorMovement) Reset() {
+	*x = InvestorMovement{}
+	mi := &file_payment_v1_payment_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvestorMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvestorMovement) ProtoMessage() {}
+
+func (x *InvestorMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvestorMovement.ProtoReflect.Descriptor instead.
+func (*InvestorMovement) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InvestorMovement) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *InvestorMovement) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *InvestorMovement) GetKind() InvestorMovementKind {
+	if x != nil {
+		return x.Kind
+	}
+	return InvestorMovementKind_INVESTOR_MOVEMENT_KIND_UNSPECIFIED
+}
+
+func (x *InvestorMovement) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *InvestorMovement) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *InvestorMovement) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *InvestorMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListFraudDecisionsRequest is a request for fraud screening decisions. Empty fields are not applied.
+type ListFraudDecisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	Outcome       FraudOutcome           `protobuf:"varint,3,opt,name=outcome,proto3,enum=payment.v1.FraudOutcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudDecisionsRequest) Reset() {
+	*x = ListFraudDecisionsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudDecisionsRequest) ProtoMessage() {}
+
+func (x *ListFraudDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListFraudDecisionsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ListFraudDecisionsRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ListFraudDecisionsRequest) GetOutcome() FraudOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return FraudOutcome_FRAUD_OUTCOME_UNSPECIFIED
+}
+
+// ListFraudDecisionsResponse is a response with fraud screening decisions.
+type ListFraudDecisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decisions     []*FraudDecision       `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudDecisionsResponse) Reset() {
+	*x = ListFraudDecisionsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudDecisionsResponse) ProtoMessage() {}
+
+func (x *ListFraudDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListFraudDecisionsResponse) GetDecisions() []*FraudDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+// FraudDecision is a result of fraud screening of a payment attempt.
+type FraudDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,4,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	Amount        *v1.Money              `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Outcome       FraudOutcome           `protobuf:"varint,6,opt,name=outcome,proto3,enum=payment.v1.FraudOutcome" json:"outcome,omitempty"`
+	// Kind of the rule that blocked the attempt, unspecified for allowed attempts.
+	Reason FraudReason `protobuf:"varint,7,opt,name=reason,proto3,enum=payment.v1.FraudReason" json:"reason,omitempty"`
+	// Name of the rule from the fraud configuration that blocked the attempt.
+	Rule string `protobuf:"bytes,8,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Human-readable explanation of why the rule fired.
+	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudDecision) Reset() {
+	*x = FraudDecision{}
+	mi := &file_payment_v1_payment_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudDecision) ProtoMessage() {}
+
+func (x *FraudDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudDecision.ProtoReflect.Descriptor instead.
+func (*FraudDecision) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FraudDecision) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *FraudDecision) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FraudDecision) GetOutcome() FraudOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return FraudOutcome_FRAUD_OUTCOME_UNSPECIFIED
+}
+
+func (x *FraudDecision) GetReason() FraudReason {
+	if x != nil {
+		return x.Reason
+	}
+	return FraudReason_FRAUD_REASON_UNSPECIFIED
+}
+
+func (x *FraudDecision) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// QuoteInstallmentPlanRequest is a request to calculate an installment schedule.
+type QuoteInstallmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *v1.Money              `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	TermMonths    int32                  `protobuf:"varint,2,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteInstallmentPlanRequest) Reset() {
+	*x = QuoteInstallmentPlanRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *QuoteInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*QuoteInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *QuoteInstallmentPlanRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *QuoteInstallmentPlanRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+// QuoteInstallmentPlanResponse is a response with an installment schedule.
+type QuoteInstallmentPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *InstallmentQuote      `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteInstallmentPlanResponse) Reset() {
+	*x = QuoteInstallmentPlanResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteInstallmentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteInstallmentPlanResponse) ProtoMessage() {}
+
+func (x *QuoteInstallmentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteInstallmentPlanResponse.ProtoReflect.Descriptor instead.
+func (*QuoteInstallmentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *QuoteInstallmentPlanResponse) GetQuote() *InstallmentQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// GetInstallmentPlanRequest is a request for an installment plan.
+type GetInstallmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanUuid      string                 `protobuf:"bytes,1,opt,name=plan_uuid,json=planUuid,proto3" json:"plan_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlanRequest) Reset() {
+	*x = GetInstallmentPlanRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *GetInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetInstallmentPlanRequest) GetPlanUuid() string {
+	if x != nil {
+		return x.PlanUuid
+	}
+	return ""
+}
+
+// GetInstallmentPlanResponse is a response with an installment plan.
+type GetInstallmentPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *InstallmentPlan       `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlanResponse) Reset() {
+	*x = GetInstallmentPlanResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlanResponse) ProtoMessage() {}
+
+func (x *GetInstallmentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetInstallmentPlanResponse) GetPlan() *InstallmentPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// ListInstallmentPlansRequest is a request for installment plans. Empty fields are not applied.
+type ListInstallmentPlansRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	UserUuid        string                  `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	TransactionUuid string                  `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Statuses        []InstallmentPlanStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=payment.v1.InstallmentPlanStatus" json:"statuses,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInstallmentPlansRequest) Reset() {
+	*x = ListInstallmentPlansRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentPlansRequest) ProtoMessage() {}
+
+func (x *ListInstallmentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListInstallmentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListInstallmentPlansRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ListInstallmentPlansRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ListInstallmentPlansRequest) GetStatuses() []InstallmentPlanStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ListInstallmentPlansResponse is a response with installment plans.
+type ListInstallmentPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*InstallmentPlan     `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallmentPlansResponse) Reset() {
+	*x = ListInstallmentPlansResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentPlansResponse) ProtoMessage() {}
+
+func (x *ListInstallmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListInstallmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListInstallmentPlansResponse) GetPlans() []*InstallmentPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// InstallmentQuote is an annuity installment schedule. Installments are rounded to
+// hundredths of the currency; the last one repays the remaining principal exactly.
+type InstallmentQuote struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Principal  *v1.Money              `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	TermMonths int32                  `protobuf:"varint,2,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	// Annual interest rate in basis points (1 bp = 0.01%).
+	AnnualRateBasisPoints int64          `protobuf:"varint,3,opt,name=annual_rate_basis_points,json=annualRateBasisPoints,proto3" json:"annual_rate_basis_points,omitempty"`
+	Installments          []*Installment `protobuf:"bytes,4,rep,name=installments,proto3" json:"installments,omitempty"`
+	TotalInterest         *v1.Money      `protobuf:"bytes,5,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	// Sum of all installments: the principal and the interest.
+	Total         *v1.Money `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentQuote) Reset() {
+	*x = InstallmentQuote{}
+	mi := &file_payment_v1_payment_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentQuote) ProtoMessage() {}
+
+func (x *InstallmentQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentQuote.ProtoReflect.Descriptor instead.
+func (*InstallmentQuote) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InstallmentQuote) GetPrincipal() *v1.Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *InstallmentQuote) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *InstallmentQuote) GetAnnualRateBasisPoints() int64 {
+	if x != nil {
+		return x.AnnualRateBasisPoints
+	}
+	return 0
+}
+
+func (x *InstallmentQuote) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *InstallmentQuote) GetTotalInterest() *v1.Money {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return nil
+}
+
+func (x *InstallmentQuote) GetTotal() *v1.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// InstallmentPlan is an installment plan of a PAYMENT_METHOD_CREDIT_CARD transaction.
+type InstallmentPlan struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Status          InstallmentPlanStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.InstallmentPlanStatus" json:"status,omitempty"`
+	Schedule        *InstallmentQuote      `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Number of installments that were not paid within the grace period.
+	MissedCount   int32                  `protobuf:"varint,7,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentPlan) Reset() {
+	*x = InstallmentPlan{}
+	mi := &file_payment_v1_payment_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentPlan) ProtoMessage() {}
+
+func (x *InstallmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentPlan.ProtoReflect.Descriptor instead.
+func (*InstallmentPlan) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InstallmentPlan) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetStatus() InstallmentPlanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_UNSPECIFIED
+}
+
+func (x *InstallmentPlan) GetSchedule() *InstallmentQuote {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *InstallmentPlan) GetMissedCount() int32 {
+	if x != nil {
+		return x.MissedCount
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InstallmentPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Installment is a single payment of an installment plan.
+type Installment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of the installment, starting from 1.
+	Number    int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DueAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Principal *v1.Money              `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest  *v1.Money              `protobuf:"bytes,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	// Amount to charge: the principal and the interest.
+	Amount *v1.Money         `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status InstallmentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=payment.v1.InstallmentStatus" json:"status,omitempty"`
+	// Time of the payment, unset for unpaid installments.
+	PaidAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// Number of declined charge attempts.
+	Attempts int32 `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Time of the next charge attempt after a decline.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// Provider decline code of the last declined attempt.
+	LastDeclineCode string `protobuf:"bytes,10,opt,name=last_decline_code,json=lastDeclineCode,proto3" json:"last_decline_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_payment_v1_payment_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Installment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Installment) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Installment) GetPrincipal() *v1.Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *Installment) GetInterest() *v1.Money {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
+func (x *Installment) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Installment) GetStatus() InstallmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstallmentStatus_INSTALLMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Installment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *Installment) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Installment) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *Installment) GetLastDeclineCode() string {
+	if x != nil {
+		return x.LastDeclineCode
+	}
+	return ""
+}
+
+// SubscribePaymentEventsRequest is a request to stream payment events.
+type SubscribePaymentEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sequence of the last processed event; zero streams all events from the start.
+	AfterSequence int64 `protobuf:"varint,1,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribePaymentEventsRequest) Reset() {
+	*x = SubscribePaymentEventsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribePaymentEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePaymentEventsRequest) ProtoMessage() {}
+
+func (x *SubscribePaymentEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePaymentEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePaymentEventsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SubscribePaymentEventsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+// SubscribePaymentEventsResponse carries a single payment event.
+type SubscribePaymentEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *PaymentEvent          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribePaymentEventsResponse) Reset() {
+	*x = SubscribePaymentEventsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribePaymentEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePaymentEventsResponse) ProtoMessage() {}
+
+func (x *SubscribePaymentEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePaymentEventsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribePaymentEventsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SubscribePaymentEventsResponse) GetEvent() *PaymentEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// PaymentEvent is a change of a transaction.
+type PaymentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sequence number of the event. Sequences start at 1 and have no gaps.
+	Sequence        int64            `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type            PaymentEventType `protobuf:"varint,2,opt,name=type,proto3,enum=payment.v1.PaymentEventType" json:"type,omitempty"`
+	TransactionUuid string           `protobuf:"bytes,3,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid       string           `protobuf:"bytes,4,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string           `protobuf:"bytes,5,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod   PaymentMethod    `protobuf:"varint,6,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Status of the transaction after the event.
+	TransactionStatus TransactionStatus `protobuf:"varint,7,opt,name=transaction_status,json=transactionStatus,proto3,enum=payment.v1.TransactionStatus" json:"transaction_status,omitempty"`
+	// Authorized, captured, refunded, released or disputed amount, or a charged installment.
+	Amount *v1.Money `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Refund of a PAYMENT_EVENT_TYPE_REFUNDED event.
+	RefundUuid string `protobuf:"bytes,9,opt,name=refund_uuid,json=refundUuid,proto3" json:"refund_uuid,omitempty"`
+	// Reason of a PAYMENT_EVENT_TYPE_FAILED event: a provider decline code,
+	// "authorization_expired" or "payment_intent_expired".
+	Reason    string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Dispute of a PAYMENT_EVENT_TYPE_DISPUTE_* event.
+	DisputeUuid   string `protobuf:"bytes,12,opt,name=dispute_uuid,json=disputeUuid,proto3" json:"dispute_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentEvent) Reset() {
+	*x = PaymentEvent{}
+	mi := &file_payment_v1_payment_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEvent) ProtoMessage() {}
+
+func (x *PaymentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEvent.ProtoReflect.Descriptor instead.
+func (*PaymentEvent) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PaymentEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PaymentEvent) GetType() PaymentEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PaymentEvent) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *PaymentEvent) GetTransactionStatus() TransactionStatus {
+	if x != nil {
+		return x.TransactionStatus
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *PaymentEvent) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetRefundUuid() string {
+	if x != nil {
+		return x.RefundUuid
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetDisputeUuid() string {
+	if x != nil {
+		return x.DisputeUuid
+	}
+	return ""
+}
+
+// CreateWebhookSubscriptionRequest is a request to register a webhook endpoint.
+type CreateWebhookSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Absolute https URL of the endpoint. Hosts resolving to loopback, private or link-local
+	// addresses are rejected, and deliveries do not follow redirects.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Event types to deliver, empty for all types.
+	EventTypes    []PaymentEventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=payment.v1.PaymentEventType" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []PaymentEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// CreateWebhookSubscriptionResponse is a response with the subscription and its secret.
+type CreateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// ListWebhookSubscriptionsRequest is a request for webhook subscriptions.
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{70}
+}
+
+// ListWebhookSubscriptionsResponse is a response with webhook subscriptions.
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// DeleteWebhookSubscriptionRequest is a request to remove a webhook subscription.
+type DeleteWebhookSubscriptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUuid string                 `protobuf:"bytes,1,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+// DeleteWebhookSubscriptionResponse is an empty response.
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{73}
+}
+
+// ListWebhookDeliveriesRequest is a request for webhook deliveries. Empty fields are not applied.
+type ListWebhookDeliveriesRequest struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	SubscriptionUuid string                  `protobuf:"bytes,1,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	Statuses         []WebhookDeliveryStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=payment.v1.WebhookDeliveryStatus" json:"statuses,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatuses() []WebhookDeliveryStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ListWebhookDeliveriesResponse is a response with webhook deliveries.
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// ReplayWebhookDeliveriesRequest is a request to retry dead deliveries.
+type ReplayWebhookDeliveriesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionUuid string                 `protobuf:"bytes,1,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	// Dead deliveries to replay, empty for all dead deliveries of the subscription.
+	DeliveryUuids []string `protobuf:"bytes,2,rep,name=delivery_uuids,json=deliveryUuids,proto3" json:"delivery_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveriesRequest) Reset() {
+	*x = ReplayWebhookDeliveriesRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReplayWebhookDeliveriesRequest) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+func (x *ReplayWebhookDeliveriesRequest) GetDeliveryUuids() []string {
+	if x != nil {
+		return x.DeliveryUuids
+	}
+	return nil
+}
+
+// ReplayWebhookDeliveriesResponse is a response with the queued deliveries.
+type ReplayWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookDeliveriesResponse) Reset() {
+	*x = ReplayWebhookDeliveriesResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReplayWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// WebhookSubscription is an HTTP endpoint receiving payment events.
+type WebhookSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Delivered event types, empty for all types.
+	EventTypes []PaymentEventType `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=payment.v1.PaymentEventType" json:"event_types,omitempty"`
+	// HMAC-SHA256 key of the X-Spaceyard-Signature header. It is returned only on creation.
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_payment_v1_payment_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *WebhookSubscription) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []PaymentEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// WebhookDelivery is a delivery of a payment event to a webhook subscription.
+type WebhookDelivery struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Uuid             string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SubscriptionUuid string                 `protobuf:"bytes,2,opt,name=subscription_uuid,json=subscriptionUuid,proto3" json:"subscription_uuid,omitempty"`
+	EventSequence    int64                  `protobuf:"varint,3,opt,name=event_sequence,json=eventSequence,proto3" json:"event_sequence,omitempty"`
+	EventType        PaymentEventType       `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=payment.v1.PaymentEventType" json:"event_type,omitempty"`
+	Status           WebhookDeliveryStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	// Number of failed attempts in the current round.
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Time of the next attempt of a pending delivery.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// Response status or connection error of the last failed attempt.
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_payment_v1_payment_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WebhookDelivery) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionUuid() string {
+	if x != nil {
+		return x.SubscriptionUuid
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventSequence() int64 {
+	if x != nil {
+		return x.EventSequence
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() PaymentEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return PaymentEventType_PAYMENT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookPayload is the JSON body POSTed to webhook endpoints, with proto field names.
+// Retries of a delivery carry the same delivery_uuid, also sent in the X-Spaceyard-Delivery header.
+type WebhookPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryUuid  string                 `protobuf:"bytes,1,opt,name=delivery_uuid,json=deliveryUuid,proto3" json:"delivery_uuid,omitempty"`
+	Event         *PaymentEvent          `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookPayload) Reset() {
+	*x = WebhookPayload{}
+	mi := &file_payment_v1_payment_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookPayload) ProtoMessage() {}
+
+func (x *WebhookPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookPayload.ProtoReflect.Descriptor instead.
+func (*WebhookPayload) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WebhookPayload) GetDeliveryUuid() string {
+	if x != nil {
+		return x.DeliveryUuid
+	}
+	return ""
+}
+
+func (x *WebhookPayload) GetEvent() *PaymentEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// SaveCardRequest is a request to save a card. The security code is never accepted or stored.
+type SaveCardRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Card number (PAN) of 12 to 19 digits without spaces.
+	CardNumber  string `protobuf:"bytes,2,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
+	ExpiryMonth int32  `protobuf:"varint,3,opt,name=expiry_month,json=expiryMonth,proto3" json:"expiry_month,omitempty"`
+	// Four-digit expiry year.
+	ExpiryYear    int32 `protobuf:"varint,4,opt,name=expiry_year,json=expiryYear,proto3" json:"expiry_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCardRequest) Reset() {
+	*x = SaveCardRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCardRequest) ProtoMessage() {}
+
+func (x *SaveCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCardRequest.ProtoReflect.Descriptor instead.
+func (*SaveCardRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SaveCardRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+func (x *SaveCardRequest) GetExpiryMonth() int32 {
+	if x != nil {
+		return x.ExpiryMonth
+	}
+	return 0
+}
+
+func (x *SaveCardRequest) GetExpiryYear() int32 {
+	if x != nil {
+		return x.ExpiryYear
+	}
+	return 0
+}
+
+// SaveCardResponse is a response with the saved card.
+type SaveCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *PaymentCard           `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCardResponse) Reset() {
+	*x = SaveCardResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCardResponse) ProtoMessage() {}
+
+func (x *SaveCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCardResponse.ProtoReflect.Descriptor instead.
+func (*SaveCardResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SaveCardResponse) GetCard() *PaymentCard {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+// ListCardsRequest is a request for saved cards of a user.
+type ListCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCardsRequest) Reset() {
+	*x = ListCardsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsRequest) ProtoMessage() {}
+
+func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListCardsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListCardsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// ListCardsResponse is a response with saved cards.
+type ListCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*PaymentCard         `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCardsResponse) Reset() {
+	*x = ListCardsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsResponse) ProtoMessage() {}
+
+func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsResponse.ProtoReflect.Descriptor instead.
+func (*ListCardsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListCardsResponse) GetCards() []*PaymentCard {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// DeleteCardRequest is a request to remove a saved card of a user.
+type DeleteCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserUuid      string                 `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentToken  string                 `protobuf:"bytes,2,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardRequest) Reset() {
+	*x = DeleteCardRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardRequest) ProtoMessage() {}
+
+func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteCardRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *DeleteCardRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+// DeleteCardResponse is an empty response.
+type DeleteCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardResponse) Reset() {
+	*x = DeleteCardResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardResponse) ProtoMessage() {}
+
+func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCardResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{86}
+}
+
+// ReencryptCardsRequest is a request to encrypt saved cards with the active key.
+type ReencryptCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReencryptCardsRequest) Reset() {
+	*x = ReencryptCardsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReencryptCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptCardsRequest) ProtoMessage() {}
+
+func (x *ReencryptCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptCardsRequest.ProtoReflect.Descriptor instead.
+func (*ReencryptCardsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{87}
+}
+
+// ReencryptCardsResponse is a response with the result of re-encryption.
+type ReencryptCardsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key of the keyring that encrypts all saved cards now.
+	ActiveKeyId string `protobuf:"bytes,1,opt,name=active_key_id,json=activeKeyId,proto3" json:"active_key_id,omitempty"`
+	// Number of cards that were encrypted with other keys.
+	ReencryptedCount int32 `protobuf:"varint,2,opt,name=reencrypted_count,json=reencryptedCount,proto3" json:"reencrypted_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReencryptCardsResponse) Reset() {
+	*x = ReencryptCardsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReencryptCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReencryptCardsResponse) ProtoMessage() {}
+
+func (x *ReencryptCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReencryptCardsResponse.ProtoReflect.Descriptor instead.
+func (*ReencryptCardsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ReencryptCardsResponse) GetActiveKeyId() string {
+	if x != nil {
+		return x.ActiveKeyId
+	}
+	return ""
+}
+
+func (x *ReencryptCardsResponse) GetReencryptedCount() int32 {
+	if x != nil {
+		return x.ReencryptedCount
+	}
+	return 0
+}
+
+// PaymentCard is a saved card. It carries masked details only, never the card number.
+type PaymentCard struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opaque token to pay with the card in PayOrderRequest.payment_token.
+	PaymentToken string    `protobuf:"bytes,1,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	UserUuid     string    `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Brand        CardBrand `protobuf:"varint,3,opt,name=brand,proto3,enum=payment.v1.CardBrand" json:"brand,omitempty"`
+	// Masked number, e.g. "411111******1111". Numbers shorter than 16 digits keep only the last four.
+	MaskedNumber  string                 `protobuf:"bytes,4,opt,name=masked_number,json=maskedNumber,proto3" json:"masked_number,omitempty"`
+	Last4         string                 `protobuf:"bytes,5,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpiryMonth   int32                  `protobuf:"varint,6,opt,name=expiry_month,json=expiryMonth,proto3" json:"expiry_month,omitempty"`
+	ExpiryYear    int32                  `protobuf:"varint,7,opt,name=expiry_year,json=expiryYear,proto3" json:"expiry_year,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentCard) Reset() {
+	*x = PaymentCard{}
+	mi := &file_payment_v1_payment_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentCard) ProtoMessage() {}
+
+func (x *PaymentCard) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentCard.ProtoReflect.Descriptor instead.
+func (*PaymentCard) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PaymentCard) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *PaymentCard) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentCard) GetBrand() CardBrand {
+	if x != nil {
+		return x.Brand
+	}
+	return CardBrand_CARD_BRAND_UNSPECIFIED
+}
+
+func (x *PaymentCard) GetMaskedNumber() string {
+	if x != nil {
+		return x.MaskedNumber
+	}
+	return ""
+}
+
+func (x *PaymentCard) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *PaymentCard) GetExpiryMonth() int32 {
+	if x != nil {
+		return x.ExpiryMonth
+	}
+	return 0
+}
+
+func (x *PaymentCard) GetExpiryYear() int32 {
+	if x != nil {
+		return x.ExpiryYear
+	}
+	return 0
+}
+
+func (x *PaymentCard) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// LineItem is a line of an order printed in the receipt.
+type LineItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the item in the seller's catalog, e.g. a part UUID.
+	Sku      string `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Price of a unit with taxes included.
+	UnitPrice     *v1.Money `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	mi := &file_payment_v1_payment_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItem) ProtoMessage() {}
+
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *LineItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *LineItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LineItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LineItem) GetUnitPrice() *v1.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+// GetReceiptRequest is a request for the receipt of a transaction.
+type GetReceiptRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetReceiptRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetReceiptResponse is a response with a receipt.
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// Receipt is a proof of payment of an order.
+type Receipt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod   PaymentMethod          `protobuf:"varint,5,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Masked number of the saved card that paid the transaction.
+	MaskedCardNumber string         `protobuf:"bytes,6,opt,name=masked_card_number,json=maskedCardNumber,proto3" json:"masked_card_number,omitempty"`
+	Items            []*ReceiptItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	// Amount charged at the payment. Together with credit_amount it makes the sum of
+	// the item amounts.
+	Total *v1.Money `protobuf:"bytes,8,opt,name=total,proto3" json:"total,omitempty"`
+	// Taxes included in the item amounts.
+	TaxTotal *v1.Money              `protobuf:"bytes,9,opt,name=tax_total,json=taxTotal,proto3" json:"tax_total,omitempty"`
+	PaidAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// The receipt as a standalone HTML document.
+	Html string `protobuf:"bytes,11,opt,name=html,proto3" json:"html,omitempty"`
+	// The receipt as plain text.
+	Text      string                 `protobuf:"bytes,12,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Amount left to be charged by the installments, zero for payments in full.
+	CreditAmount  *v1.Money `protobuf:"bytes,14,opt,name=credit_amount,json=creditAmount,proto3" json:"credit_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_payment_v1_payment_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Receipt) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Receipt) GetMaskedCardNumber() string {
+	if x != nil {
+		return x.MaskedCardNumber
+	}
+	return ""
+}
+
+func (x *Receipt) GetItems() []*ReceiptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Receipt) GetTotal() *v1.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Receipt) GetTaxTotal() *v1.Money {
+	if x != nil {
+		return x.TaxTotal
+	}
+	return nil
+}
+
+func (x *Receipt) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *Receipt) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Receipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetCreditAmount() *v1.Money {
+	if x != nil {
+		return x.CreditAmount
+	}
+	return nil
+}
+
+// ReceiptItem is a line of a receipt.
+type ReceiptItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Sku       string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice *v1.Money              `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// Price of all units of the line.
+	Amount *v1.Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// VAT rate in basis points (1 bp = 0.01%), included in the price.
+	TaxRateBasisPoints int64 `protobuf:"varint,6,opt,name=tax_rate_basis_points,json=taxRateBasisPoints,proto3" json:"tax_rate_basis_points,omitempty"`
+	// VAT included in the amount.
+	Tax           *v1.Money `protobuf:"bytes,7,opt,name=tax,proto3" json:"tax,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptItem) Reset() {
+	*x = ReceiptItem{}
+	mi := &file_payment_v1_payment_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptItem) ProtoMessage() {}
+
+func (x *ReceiptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptItem.ProtoReflect.Descriptor instead.
+func (*ReceiptItem) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReceiptItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetUnitPrice() *v1.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *ReceiptItem) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ReceiptItem) GetTaxRateBasisPoints() int64 {
+	if x != nil {
+		return x.TaxRateBasisPoints
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetTax() *v1.Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+// OpenDisputeRequest is a request to record a dispute raised by the card issuer.
+type OpenDisputeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Disputed amount. Unset disputes the whole amount that is not refunded yet.
+	Amount        *v1.Money     `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        DisputeReason `protobuf:"varint,3,opt,name=reason,proto3,enum=payment.v1.DisputeReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDisputeRequest) Reset() {
+	*x = OpenDisputeRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDisputeRequest) ProtoMessage() {}
+
+func (x *OpenDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDisputeRequest.ProtoReflect.Descriptor instead.
+func (*OpenDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OpenDisputeRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *OpenDisputeRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *OpenDisputeRequest) GetReason() DisputeReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DisputeReason_DISPUTE_REASON_UNSPECIFIED
+}
+
+// OpenDisputeResponse is a response with the opened dispute.
+type OpenDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDisputeResponse) Reset() {
+	*x = OpenDisputeResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDisputeResponse) ProtoMessage() {}
+
+func (x *OpenDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDisputeResponse.ProtoReflect.Descriptor instead.
+func (*OpenDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OpenDisputeResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+// GetDisputeRequest is a request to get a dispute by its UUID.
+type GetDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeUuid   string                 `protobuf:"bytes,1,opt,name=dispute_uuid,json=disputeUuid,proto3" json:"dispute_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisputeRequest) Reset() {
+	*x = GetDisputeRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisputeRequest) ProtoMessage() {}
+
+func (x *GetDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisputeRequest.ProtoReflect.Descriptor instead.
+func (*GetDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetDisputeRequest) GetDisputeUuid() string {
+	if x != nil {
+		return x.DisputeUuid
+	}
+	return ""
+}
+
+// GetDisputeResponse is a response with a dispute.
+type GetDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisputeResponse) Reset() {
+	*x = GetDisputeResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisputeResponse) ProtoMessage() {}
+
+func (x *GetDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisputeResponse.ProtoReflect.Descriptor instead.
+func (*GetDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetDisputeResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+// ListDisputesRequest is a request for disputes. Empty fields are not applied.
+type ListDisputesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	Statuses        []DisputeStatus        `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=payment.v1.DisputeStatus" json:"statuses,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListDisputesRequest) Reset() {
+	*x = ListDisputesRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisputesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisputesRequest) ProtoMessage() {}
+
+func (x *ListDisputesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisputesRequest.ProtoReflect.Descriptor instead.
+func (*ListDisputesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListDisputesRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ListDisputesRequest) GetStatuses() []DisputeStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ListDisputesResponse is a response with disputes.
+type ListDisputesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disputes      []*Dispute             `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDisputesResponse) Reset() {
+	*x = ListDisputesResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisputesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisputesResponse) ProtoMessage() {}
+
+func (x *ListDisputesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisputesResponse.ProtoReflect.Descriptor instead.
+func (*ListDisputesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListDisputesResponse) GetDisputes() []*Dispute {
+	if x != nil {
+		return x.Disputes
+	}
+	return nil
+}
+
+// SubmitDisputeEvidenceRequest is a request to contest a dispute.
+type SubmitDisputeEvidenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeUuid   string                 `protobuf:"bytes,1,opt,name=dispute_uuid,json=disputeUuid,proto3" json:"dispute_uuid,omitempty"`
+	Evidence      *DisputeEvidence       `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDisputeEvidenceRequest) Reset() {
+	*x = SubmitDisputeEvidenceRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDisputeEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDisputeEvidenceRequest) ProtoMessage() {}
+
+func (x *SubmitDisputeEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDisputeEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDisputeEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SubmitDisputeEvidenceRequest) GetDisputeUuid() string {
+	if x != nil {
+		return x.DisputeUuid
+	}
+	return ""
+}
+
+func (x *SubmitDisputeEvidenceRequest) GetEvidence() *DisputeEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// SubmitDisputeEvidenceResponse is a response with the contested dispute.
+type SubmitDisputeEvidenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDisputeEvidenceResponse) Reset() {
+	*x = SubmitDisputeEvidenceResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDisputeEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDisputeEvidenceResponse) ProtoMessage() {}
+
+func (x *SubmitDisputeEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDisputeEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDisputeEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SubmitDisputeEvidenceResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+// AcceptDisputeRequest is a request to concede a dispute.
+type AcceptDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeUuid   string                 `protobuf:"bytes,1,opt,name=dispute_uuid,json=disputeUuid,proto3" json:"dispute_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptDisputeRequest) Reset() {
+	*x = AcceptDisputeRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDisputeRequest) ProtoMessage() {}
+
+func (x *AcceptDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDisputeRequest.ProtoReflect.Descriptor instead.
+func (*AcceptDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AcceptDisputeRequest) GetDisputeUuid() string {
+	if x != nil {
+		return x.DisputeUuid
+	}
+	return ""
+}
+
+// AcceptDisputeResponse is a response with the lost dispute.
+type AcceptDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptDisputeResponse) Reset() {
+	*x = AcceptDisputeResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDisputeResponse) ProtoMessage() {}
+
+func (x *AcceptDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDisputeResponse.ProtoReflect.Descriptor instead.
+func (*AcceptDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AcceptDisputeResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+// ResolveDisputeRequest is a request to record the issuer decision on a dispute.
+type ResolveDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisputeUuid   string                 `protobuf:"bytes,1,opt,name=dispute_uuid,json=disputeUuid,proto3" json:"dispute_uuid,omitempty"`
+	Outcome       DisputeOutcome         `protobuf:"varint,2,opt,name=outcome,proto3,enum=payment.v1.DisputeOutcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDisputeRequest) Reset() {
+	*x = ResolveDisputeRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDisputeRequest) ProtoMessage() {}
+
+func (x *ResolveDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDisputeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ResolveDisputeRequest) GetDisputeUuid() string {
+	if x != nil {
+		return x.DisputeUuid
+	}
+	return ""
+}
+
+func (x *ResolveDisputeRequest) GetOutcome() DisputeOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return DisputeOutcome_DISPUTE_OUTCOME_UNSPECIFIED
+}
+
+// ResolveDisputeResponse is a response with the resolved dispute.
+type ResolveDisputeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dispute       *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDisputeResponse) Reset() {
+	*x = ResolveDisputeResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDisputeResponse) ProtoMessage() {}
+
+func (x *ResolveDisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDisputeResponse.ProtoReflect.Descriptor instead.
+func (*ResolveDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ResolveDisputeResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+// Dispute is a chargeback raised by the card issuer against a captured transaction.
+type Dispute struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TransactionUuid string                 `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	OrderUuid       string                 `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid        string                 `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	Amount          *v1.Money              `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason          DisputeReason          `protobuf:"varint,6,opt,name=reason,proto3,enum=payment.v1.DisputeReason" json:"reason,omitempty"`
+	Status          DisputeStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=payment.v1.DisputeStatus" json:"status,omitempty"`
+	// Deadline for submitting evidence.
+	EvidenceDueBy *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=evidence_due_by,json=evidenceDueBy,proto3" json:"evidence_due_by,omitempty"`
+	// Evidence of a contested dispute.
+	Evidence  *DisputeEvidence       `protobuf:"bytes,9,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Time of the outcome, unset for unresolved disputes.
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dispute) Reset() {
+	*x = Dispute{}
+	mi := &file_payment_v1_payment_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dispute) ProtoMessage() {}
+
+func (x *Dispute) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dispute.ProtoReflect.Descriptor instead.
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Dispute) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Dispute) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Dispute) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Dispute) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Dispute) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Dispute) GetReason() DisputeReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DisputeReason_DISPUTE_REASON_UNSPECIFIED
+}
+
+func (x *Dispute) GetStatus() DisputeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DisputeStatus_DISPUTE_STATUS_UNSPECIFIED
+}
+
+func (x *Dispute) GetEvidenceDueBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvidenceDueBy
+	}
+	return nil
+}
+
+func (x *Dispute) GetEvidence() *DisputeEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *Dispute) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Dispute) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	PaymentMethod_value = map[string]int32{
-		"PAYMENT_METHOD_UNSPECIFIED":    0,
-		"PAYMENT_METHOD_CARD":           1,
-		"PAYMENT_METHOD_SBP":            2,
-		"PAYMENT_METHOD_CREDIT_CARD":    3,
-		"PAYMENT_METHOD_INVESTOR_MONEY": 4,
+	return nil
+}
+
+func (x *Dispute) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
 	}
-)
+	return nil
+}
 
-func (x PaymentMethod) Enum() *PaymentMethod {
-	p := new(PaymentMethod)
-	*p = x
-	return p
+// DisputeEvidence is the merchant's response to a dispute.
+type DisputeEvidence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Explanation for the issuer, e.g. how and when the order was delivered.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Shipment tracking number of the order.
+	TrackingNumber string `protobuf:"bytes,2,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	// Links to supporting documents, e.g. a signed delivery note.
+	DocumentUrls []string `protobuf:"bytes,3,rep,name=document_urls,json=documentUrls,proto3" json:"document_urls,omitempty"`
+	// Time of submission, set by the service.
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x PaymentMethod) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *DisputeEvidence) Reset() {
+	*x = DisputeEvidence{}
+	mi := &file_payment_v1_payment_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
-	return file_payment_v1_payment_proto_enumTypes[0].Descriptor()
+func (x *DisputeEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (PaymentMethod) Type() protoreflect.EnumType {
-	return &file_payment_v1_payment_proto_enumTypes[0]
+func (*DisputeEvidence) ProtoMessage() {}
+
+func (x *DisputeEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x PaymentMethod) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use DisputeEvidence.ProtoReflect.Descriptor instead.
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{108}
 }
 
-// Deprecated: Use PaymentMethod.Descriptor instead.
-func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+func (x *DisputeEvidence) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-// PayOrderRequest is a request to for pay.
-type PayOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderUuid     string                 `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
-	UserUuid      string                 `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
-	PaymentMethod PaymentMethod          `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+func (x *DisputeEvidence) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *DisputeEvidence) GetDocumentUrls() []string {
+	if x != nil {
+		return x.DocumentUrls
+	}
+	return nil
+}
+
+func (x *DisputeEvidence) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+// TransactionsFilter is a filter for transactions. Empty fields are not applied.
+type TransactionsFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderUuids     []string               `protobuf:"bytes,1,rep,name=order_uuids,json=orderUuids,proto3" json:"order_uuids,omitempty"`
+	UserUuids      []string               `protobuf:"bytes,2,rep,name=user_uuids,json=userUuids,proto3" json:"user_uuids,omitempty"`
+	PaymentMethods []PaymentMethod        `protobuf:"varint,3,rep,packed,name=payment_methods,json=paymentMethods,proto3,enum=payment.v1.PaymentMethod" json:"payment_methods,omitempty"`
+	Statuses       []TransactionStatus    `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=payment.v1.TransactionStatus" json:"statuses,omitempty"`
+	// Inclusive lower bound of the creation time.
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	// Exclusive upper bound of the creation time.
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PayOrderRequest) Reset() {
-	*x = PayOrderRequest{}
-	mi := &file_payment_v1_payment_proto_msgTypes[0]
+func (x *TransactionsFilter) Reset() {
+	*x = TransactionsFilter{}
+	mi := &file_payment_v1_payment_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PayOrderRequest) String() string {
+func (x *TransactionsFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayOrderRequest) ProtoMessage() {}
+func (*TransactionsFilter) ProtoMessage() {}
 
-func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[0]
+func (x *TransactionsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,55 +7849,113 @@ func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayOrderRequest.ProtoReflect.Descriptor instead.
-func (*PayOrderRequest) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use TransactionsFilter.ProtoReflect.Descriptor instead.
+func (*TransactionsFilter) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *PayOrderRequest) GetOrderUuid() string {
+func (x *TransactionsFilter) GetOrderUuids() []string {
 	if x != nil {
-		return x.OrderUuid
+		return x.OrderUuids
 	}
-	return ""
+	return nil
 }
 
-func (x *PayOrderRequest) GetUserUuid() string {
+func (x *TransactionsFilter) GetUserUuids() []string {
 	if x != nil {
-		return x.UserUuid
+		return x.UserUuids
 	}
-	return ""
+	return nil
 }
 
-func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
+func (x *TransactionsFilter) GetPaymentMethods() []PaymentMethod {
 	if x != nil {
-		return x.PaymentMethod
+		return x.PaymentMethods
 	}
-	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	return nil
 }
 
-// PayOrderResponse is a response with an uuid.
-type PayOrderResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TransactionUuid string                 `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+func (x *TransactionsFilter) GetStatuses() []TransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TransactionsFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *TransactionsFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+// Transaction is a record of a payment of an order.
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	OrderUuid     string                 `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	UserUuid      string                 `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	PaymentMethod PaymentMethod          `protobuf:"varint,4,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	Status        TransactionStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount        *v1.Money              `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Total amount refunded so far.
+	RefundedAmount *v1.Money `protobuf:"bytes,8,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// Amount held by the authorization. The amount field holds the captured amount once captured.
+	AuthorizedAmount *v1.Money `protobuf:"bytes,9,opt,name=authorized_amount,json=authorizedAmount,proto3" json:"authorized_amount,omitempty"`
+	// Time after which an uncaptured authorization expires.
+	AuthorizationExpiresAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=authorization_expires_at,json=authorizationExpiresAt,proto3" json:"authorization_expires_at,omitempty"`
+	// Time of the capture, unset for uncaptured transactions.
+	CapturedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	// Provider decline code of a declined transaction, e.g. "insufficient_funds".
+	DeclineCode string `protobuf:"bytes,12,opt,name=decline_code,json=declineCode,proto3" json:"decline_code,omitempty"`
+	// Investor whose money pays a PAYMENT_METHOD_INVESTOR_MONEY transaction.
+	InvestorUuid string `protobuf:"bytes,13,opt,name=investor_uuid,json=investorUuid,proto3" json:"investor_uuid,omitempty"`
+	// Installment term in months, zero for transactions paid in full.
+	InstallmentTermMonths int32 `protobuf:"varint,14,opt,name=installment_term_months,json=installmentTermMonths,proto3" json:"installment_term_months,omitempty"`
+	// The amount converted to the settlement currency of the payment provider.
+	SettlementAmount *v1.Money `protobuf:"bytes,15,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
+	// Exchange rate from the amount currency to the settlement currency, fixed when
+	// the transaction is created, as a decimal string, e.g. "92.5".
+	ExchangeRate string `protobuf:"bytes,16,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// Token of the saved card that paid the transaction.
+	PaymentToken string `protobuf:"bytes,17,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	// Masked number of the saved card, e.g. "411111******1111".
+	MaskedCardNumber string `protobuf:"bytes,18,opt,name=masked_card_number,json=maskedCardNumber,proto3" json:"masked_card_number,omitempty"`
+	// Order lines to print in the receipt.
+	LineItems []*LineItem `protobuf:"bytes,19,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	// Total amount returned to the customer by lost disputes.
+	ChargedBackAmount *v1.Money `protobuf:"bytes,20,opt,name=charged_back_amount,json=chargedBackAmount,proto3" json:"charged_back_amount,omitempty"`
+	// Amount actually charged from the customer. Equals the amount once captured, except
+	// for installments, where it is the principal of the paid installments and grows with
+	// each of them. Refunds and disputes never exceed it.
+	CollectedAmount *v1.Money `protobuf:"bytes,21,opt,name=collected_amount,json=collectedAmount,proto3" json:"collected_amount,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PayOrderResponse) Reset() {
-	*x = PayOrderResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[1]
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_payment_v1_payment_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PayOrderResponse) String() string {
+func (x *Transaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayOrderResponse) ProtoMessage() {}
+func (*Transaction) ProtoMessage() {}
 
-func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[1]
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,39 +7966,878 @@ func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayOrderResponse.ProtoReflect.Descriptor instead.
-func (*PayOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *PayOrderResponse) GetTransactionUuid() string {
+func (x *Transaction) GetUuid() string {
 	if x != nil {
-		return x.TransactionUuid
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Transaction) GetRefundedAmount() *v1.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetAuthorizedAmount() *v1.Money {
+	if x != nil {
+		return x.AuthorizedAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetAuthorizationExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuthorizationExpiresAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetDeclineCode() string {
+	if x != nil {
+		return x.DeclineCode
+	}
+	return ""
+}
+
+func (x *Transaction) GetInvestorUuid() string {
+	if x != nil {
+		return x.InvestorUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetInstallmentTermMonths() int32 {
+	if x != nil {
+		return x.InstallmentTermMonths
+	}
+	return 0
+}
+
+func (x *Transaction) GetSettlementAmount() *v1.Money {
+	if x != nil {
+		return x.SettlementAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Transaction) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *Transaction) GetMaskedCardNumber() string {
+	if x != nil {
+		return x.MaskedCardNumber
 	}
 	return ""
 }
 
+func (x *Transaction) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Transaction) GetChargedBackAmount() *v1.Money {
+	if x != nil {
+		return x.ChargedBackAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetCollectedAmount() *v1.Money {
+	if x != nil {
+		return x.CollectedAmount
+	}
+	return nil
+}
+
 var File_payment_v1_payment_proto protoreflect.FileDescriptor
 
 const file_payment_v1_payment_proto_rawDesc = "" +
 	"\n" +
 	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
+	"payment.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14money/v1/money.proto\"\xf3\x03\n" +
+	"\x0fPayOrderRequest\x12'\n" +
 	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
+	"order_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\x12L\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\rpaymentMethod\x12/\n" +
+	"\x06amount\x18\x04 \x01(\v2\x0f.money.v1.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\x121\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0eidempotencyKey\x120\n" +
+	"\rinvestor_uuid\x18\x06 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\finvestorUuid\x12?\n" +
+	"\x17installment_term_months\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x15installmentTermMonths\x12,\n" +
+	"\rpayment_token\x18\b \x01(\tB\a\xbaH\x04r\x02\x18@R\fpaymentToken\x12=\n" +
+	"\n" +
+	"line_items\x18\t \x03(\v2\x14.payment.v1.LineItemB\b\xbaH\x05\x92\x01\x02\x10dR\tlineItems\"\x89\x01\n" +
 	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xa3\x01\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12J\n" +
+	"\x12sbp_payment_intent\x18\x02 \x01(\v2\x1c.payment.v1.SbpPaymentIntentR\x10sbpPaymentIntent\"\xfb\x03\n" +
+	"\x17AuthorizePaymentRequest\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\x12L\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\rpaymentMethod\x12/\n" +
+	"\x06amount\x18\x04 \x01(\v2\x0f.money.v1.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\x121\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0eidempotencyKey\x120\n" +
+	"\rinvestor_uuid\x18\x06 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\finvestorUuid\x12?\n" +
+	"\x17installment_term_months\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x15installmentTermMonths\x12,\n" +
+	"\rpayment_token\x18\b \x01(\tB\a\xbaH\x04r\x02\x18@R\fpaymentToken\x12=\n" +
+	"\n" +
+	"line_items\x18\t \x03(\v2\x14.payment.v1.LineItemB\b\xbaH\x05\x92\x01\x02\x10dR\tlineItems\"U\n" +
+	"\x18AuthorizePaymentResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"u\n" +
+	"\x15CapturePaymentRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\x12'\n" +
+	"\x06amount\x18\x02 \x01(\v2\x0f.money.v1.MoneyR\x06amount\"S\n" +
+	"\x16CapturePaymentResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"O\n" +
+	"\x18VoidAuthorizationRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\"V\n" +
+	"\x19VoidAuthorizationResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"\xda\x02\n" +
+	"\x1dCreateSbpPaymentIntentRequest\x12'\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\torderUuid\x12%\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\x12/\n" +
+	"\x06amount\x18\x03 \x01(\v2\x0f.money.v1.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\x121\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0eidempotencyKey\x12F\n" +
+	"\fimage_format\x18\x05 \x01(\x0e2\x19.payment.v1.QrImageFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\vimageFormat\x12=\n" +
+	"\n" +
+	"line_items\x18\x06 \x03(\v2\x14.payment.v1.LineItemB\b\xbaH\x05\x92\x01\x02\x10dR\tlineItems\"V\n" +
+	"\x1eCreateSbpPaymentIntentResponse\x124\n" +
+	"\x06intent\x18\x01 \x01(\v2\x1c.payment.v1.SbpPaymentIntentR\x06intent\"\x99\x01\n" +
+	"\x1aGetSbpPaymentIntentRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\x12F\n" +
+	"\fimage_format\x18\x02 \x01(\x0e2\x19.payment.v1.QrImageFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\vimageFormat\"S\n" +
+	"\x1bGetSbpPaymentIntentResponse\x124\n" +
+	"\x06intent\x18\x01 \x01(\v2\x1c.payment.v1.SbpPaymentIntentR\x06intent\"U\n" +
+	"\x1eConfirmSbpPaymentIntentRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\"\\\n" +
+	"\x1fConfirmSbpPaymentIntentResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"\xb5\x01\n" +
+	"\x10SbpPaymentIntent\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12\x19\n" +
+	"\bqr_image\x18\x03 \x01(\fR\aqrImage\x121\n" +
+	"\x15qr_image_content_type\x18\x04 \x01(\tR\x12qrImageContentType\"L\n" +
+	"\x15GetTransactionRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\"S\n" +
+	"\x16GetTransactionResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"Q\n" +
+	"\x17ListTransactionsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.payment.v1.TransactionsFilterR\x06filter\"W\n" +
+	"\x18ListTransactionsResponse\x12;\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x17.payment.v1.TransactionR\ftransactions\"\xb0\x01\n" +
+	"\x14RefundPaymentRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\x12'\n" +
+	"\x06amount\x18\x02 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x12:\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x18.payment.v1.RefundReasonB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06reason\"C\n" +
+	"\x15RefundPaymentResponse\x12*\n" +
+	"\x06refund\x18\x01 \x01(\v2\x12.payment.v1.RefundR\x06refund\"=\n" +
+	"\x10GetRefundRequest\x12)\n" +
+	"\vrefund_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"refundUuid\"?\n" +
+	"\x11GetRefundResponse\x12*\n" +
+	"\x06refund\x18\x01 \x01(\v2\x12.payment.v1.RefundR\x06refund\"I\n" +
+	"\x12ListRefundsRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\"C\n" +
+	"\x13ListRefundsResponse\x12,\n" +
+	"\arefunds\x18\x01 \x03(\v2\x12.payment.v1.RefundR\arefunds\"\xce\x02\n" +
+	"\x06Refund\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12'\n" +
+	"\x06amount\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x120\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x18.payment.v1.RefundReasonR\x06reason\x120\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x18.payment.v1.RefundStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\x12written_off_amount\x18\a \x01(\v2\x0f.money.v1.MoneyR\x10writtenOffAmount\"\x94\x01\n" +
+	"\x1aListAccountBalancesRequest\x12J\n" +
+	"\faccount_type\x18\x01 \x01(\x0e2\x1d.payment.v1.LedgerAccountTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\vaccountType\x12*\n" +
+	"\n" +
+	"owner_uuid\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\townerUuid\"U\n" +
+	"\x1bListAccountBalancesResponse\x126\n" +
+	"\bbalances\x18\x01 \x03(\v2\x1a.payment.v1.AccountBalanceR\bbalances\"S\n" +
+	"\x19ListJournalEntriesRequest\x126\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x0ftransactionUuid\"P\n" +
+	"\x1aListJournalEntriesResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.payment.v1.JournalEntryR\aentries\"\x1f\n" +
+	"\x1dCheckLedgerConsistencyRequest\"\xa6\x01\n" +
+	"\x1eCheckLedgerConsistencyResponse\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x01 \x01(\bR\n" +
+	"consistent\x12'\n" +
+	"\x0fentries_checked\x18\x02 \x01(\x03R\x0eentriesChecked\x12;\n" +
+	"\n" +
+	"violations\x18\x03 \x03(\v2\x1b.payment.v1.LedgerViolationR\n" +
+	"violations\"}\n" +
+	"\x0fLedgerViolation\x12\x1d\n" +
+	"\n" +
+	"entry_uuid\x18\x01 \x01(\tR\tentryUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"a\n" +
+	"\rLedgerAccount\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.payment.v1.LedgerAccountTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"owner_uuid\x18\x02 \x01(\tR\townerUuid\"\xc4\x01\n" +
+	"\x0eAccountBalance\x123\n" +
+	"\aaccount\x18\x01 \x01(\v2\x19.payment.v1.LedgerAccountR\aaccount\x12'\n" +
+	"\x06debits\x18\x02 \x01(\v2\x0f.money.v1.MoneyR\x06debits\x12)\n" +
+	"\acredits\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\acredits\x12)\n" +
+	"\abalance\x18\x04 \x01(\v2\x0f.money.v1.MoneyR\abalance\"\xdf\x02\n" +
+	"\fJournalEntry\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x120\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.payment.v1.JournalEntryKindR\x04kind\x12)\n" +
+	"\x10transaction_uuid\x18\x03 \x01(\tR\x0ftransactionUuid\x12\x1f\n" +
+	"\vrefund_uuid\x18\x04 \x01(\tR\n" +
+	"refundUuid\x12.\n" +
+	"\x13reverses_entry_uuid\x18\x05 \x01(\tR\x11reversesEntryUuid\x12/\n" +
+	"\bpostings\x18\x06 \x03(\v2\x13.payment.v1.PostingR\bpostings\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fdispute_uuid\x18\b \x01(\tR\vdisputeUuid\"\xa3\x01\n" +
+	"\aPosting\x123\n" +
+	"\aaccount\x18\x01 \x01(\v2\x19.payment.v1.LedgerAccountR\aaccount\x12:\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x1c.payment.v1.PostingDirectionR\tdirection\x12'\n" +
+	"\x06amount\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\x06amount\"\xb2\x01\n" +
+	"\x15CreateInvestorRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x126\n" +
+	"\rcurrency_code\x18\x02 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$R\fcurrencyCode\x12A\n" +
+	"\x15authorized_user_uuids\x18\x03 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x13authorizedUserUuids\"J\n" +
+	"\x16CreateInvestorResponse\x120\n" +
+	"\binvestor\x18\x01 \x01(\v2\x14.payment.v1.InvestorR\binvestor\"C\n" +
+	"\x12GetInvestorRequest\x12-\n" +
+	"\rinvestor_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\finvestorUuid\"G\n" +
+	"\x13GetInvestorResponse\x120\n" +
+	"\binvestor\x18\x01 \x01(\v2\x14.payment.v1.InvestorR\binvestor\"\x16\n" +
+	"\x14ListInvestorsRequest\"K\n" +
+	"\x15ListInvestorsResponse\x122\n" +
+	"\tinvestors\x18\x01 \x03(\v2\x14.payment.v1.InvestorR\tinvestors\"v\n" +
+	"\x14TopUpInvestorRequest\x12-\n" +
+	"\rinvestor_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\finvestorUuid\x12/\n" +
+	"\x06amount\x18\x02 \x01(\v2\x0f.money.v1.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\"I\n" +
+	"\x15TopUpInvestorResponse\x120\n" +
+	"\binvestor\x18\x01 \x01(\v2\x14.payment.v1.InvestorR\binvestor\"r\n" +
+	"\x1aGrantInvestorAccessRequest\x12-\n" +
+	"\rinvestor_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\finvestorUuid\x12%\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\"O\n" +
+	"\x1bGrantInvestorAccessResponse\x120\n" +
+	"\binvestor\x18\x01 \x01(\v2\x14.payment.v1.InvestorR\binvestor\"s\n" +
+	"\x1bRevokeInvestorAccessRequest\x12-\n" +
+	"\rinvestor_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\finvestorUuid\x12%\n" +
+	"\tuser_uuid\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\"P\n" +
+	"\x1cRevokeInvestorAccessResponse\x120\n" +
+	"\binvestor\x18\x01 \x01(\v2\x14.payment.v1.InvestorR\binvestor\"M\n" +
+	"\x1cListInvestorMovementsRequest\x12-\n" +
+	"\rinvestor_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\finvestorUuid\"[\n" +
+	"\x1dListInvestorMovementsResponse\x12:\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1c.payment.v1.InvestorMovementR\tmovements\"\xd7\x02\n" +
+	"\bInvestor\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\tavailable\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\tavailable\x12#\n" +
+	"\x04held\x18\x04 \x01(\v2\x0f.money.v1.MoneyR\x04held\x12%\n" +
+	"\x05spent\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\x05spent\x122\n" +
+	"\x15authorized_user_uuids\x18\x06 \x03(\tR\x13authorizedUserUuids\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xad\x02\n" +
+	"\x10InvestorMovement\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12#\n" +
+	"\rinvestor_uuid\x18\x02 \x01(\tR\finvestorUuid\x124\n" +
+	"\x04kind\x18\x03 \x01(\x0e2 .payment.v1.InvestorMovementKindR\x04kind\x12'\n" +
+	"\x06amount\x18\x04 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x12)\n" +
+	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x06 \x01(\tR\buserUuid\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaf\x01\n" +
+	"\x19ListFraudDecisionsRequest\x12(\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\buserUuid\x12*\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\torderUuid\x12<\n" +
+	"\aoutcome\x18\x03 \x01(\x0e2\x18.payment.v1.FraudOutcomeB\b\xbaH\x05\x82\x01\x02\x10\x01R\aoutcome\"U\n" +
+	"\x1aListFraudDecisionsResponse\x127\n" +
+	"\tdecisions\x18\x01 \x03(\v2\x19.payment.v1.FraudDecisionR\tdecisions\"\xa0\x03\n" +
+	"\rFraudDecision\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12'\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x122\n" +
+	"\aoutcome\x18\x06 \x01(\x0e2\x18.payment.v1.FraudOutcomeR\aoutcome\x12/\n" +
+	"\x06reason\x18\a \x01(\x0e2\x17.payment.v1.FraudReasonR\x06reason\x12\x12\n" +
+	"\x04rule\x18\b \x01(\tR\x04rule\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"x\n" +
+	"\x1bQuoteInstallmentPlanRequest\x12/\n" +
+	"\x06amount\x18\x01 \x01(\v2\x0f.money.v1.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\x12(\n" +
+	"\vterm_months\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\n" +
+	"termMonths\"R\n" +
+	"\x1cQuoteInstallmentPlanResponse\x122\n" +
+	"\x05quote\x18\x01 \x01(\v2\x1c.payment.v1.InstallmentQuoteR\x05quote\"B\n" +
+	"\x19GetInstallmentPlanRequest\x12%\n" +
+	"\tplan_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bplanUuid\"M\n" +
+	"\x1aGetInstallmentPlanResponse\x12/\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1b.payment.v1.InstallmentPlanR\x04plan\"\xcd\x01\n" +
+	"\x1bListInstallmentPlansRequest\x12(\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\buserUuid\x126\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x0ftransactionUuid\x12L\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2!.payment.v1.InstallmentPlanStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\"Q\n" +
+	"\x1cListInstallmentPlansResponse\x121\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1b.payment.v1.InstallmentPlanR\x05plans\"\xb7\x02\n" +
+	"\x10InstallmentQuote\x12-\n" +
+	"\tprincipal\x18\x01 \x01(\v2\x0f.money.v1.MoneyR\tprincipal\x12\x1f\n" +
+	"\vterm_months\x18\x02 \x01(\x05R\n" +
+	"termMonths\x127\n" +
+	"\x18annual_rate_basis_points\x18\x03 \x01(\x03R\x15annualRateBasisPoints\x12;\n" +
+	"\finstallments\x18\x04 \x03(\v2\x17.payment.v1.InstallmentR\finstallments\x126\n" +
+	"\x0etotal_interest\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\rtotalInterest\x12%\n" +
+	"\x05total\x18\x06 \x01(\v2\x0f.money.v1.MoneyR\x05total\"\x9a\x03\n" +
+	"\x0fInstallmentPlan\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x129\n" +
+	"\x06status\x18\x05 \x01(\x0e2!.payment.v1.InstallmentPlanStatusR\x06status\x128\n" +
+	"\bschedule\x18\x06 \x01(\v2\x1c.payment.v1.InstallmentQuoteR\bschedule\x12!\n" +
+	"\fmissed_count\x18\a \x01(\x05R\vmissedCount\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd5\x03\n" +
+	"\vInstallment\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x121\n" +
+	"\x06due_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12-\n" +
+	"\tprincipal\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\tprincipal\x12+\n" +
+	"\binterest\x18\x04 \x01(\v2\x0f.money.v1.MoneyR\binterest\x12'\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x125\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1d.payment.v1.InstallmentStatusR\x06status\x123\n" +
+	"\apaid_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12B\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12*\n" +
+	"\x11last_decline_code\x18\n" +
+	" \x01(\tR\x0flastDeclineCode\"O\n" +
+	"\x1dSubscribePaymentEventsRequest\x12.\n" +
+	"\x0eafter_sequence\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\rafterSequence\"P\n" +
+	"\x1eSubscribePaymentEventsResponse\x12.\n" +
+	"\x05event\x18\x01 \x01(\v2\x18.payment.v1.PaymentEventR\x05event\"\x93\x04\n" +
+	"\fPaymentEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.payment.v1.PaymentEventTypeR\x04type\x12)\n" +
+	"\x10transaction_uuid\x18\x03 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x04 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x05 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x06 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12L\n" +
+	"\x12transaction_status\x18\a \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x11transactionStatus\x12'\n" +
+	"\x06amount\x18\b \x01(\v2\x0f.money.v1.MoneyR\x06amount\x12\x1f\n" +
+	"\vrefund_uuid\x18\t \x01(\tR\n" +
+	"refundUuid\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fdispute_uuid\x18\f \x01(\tR\vdisputeUuid\"\x8e\x01\n" +
+	" CreateWebhookSubscriptionRequest\x12\x1a\n" +
+	"\x03url\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\x03url\x12N\n" +
+	"\vevent_types\x18\x02 \x03(\x0e2\x1c.payment.v1.PaymentEventTypeB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"eventTypes\"h\n" +
+	"!CreateWebhookSubscriptionResponse\x12C\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1f.payment.v1.WebhookSubscriptionR\fsubscription\"!\n" +
+	"\x1fListWebhookSubscriptionsRequest\"i\n" +
+	" ListWebhookSubscriptionsResponse\x12E\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1f.payment.v1.WebhookSubscriptionR\rsubscriptions\"Y\n" +
+	" DeleteWebhookSubscriptionRequest\x125\n" +
+	"\x11subscription_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10subscriptionUuid\"#\n" +
+	"!DeleteWebhookSubscriptionResponse\"\xa6\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x128\n" +
+	"\x11subscription_uuid\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x10subscriptionUuid\x12L\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2!.payment.v1.WebhookDeliveryStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\"\\\n" +
+	"\x1dListWebhookDeliveriesResponse\x12;\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1b.payment.v1.WebhookDeliveryR\n" +
+	"deliveries\"\x8d\x01\n" +
+	"\x1eReplayWebhookDeliveriesRequest\x125\n" +
+	"\x11subscription_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x10subscriptionUuid\x124\n" +
+	"\x0edelivery_uuids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\rdeliveryUuids\"^\n" +
+	"\x1fReplayWebhookDeliveriesResponse\x12;\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1b.payment.v1.WebhookDeliveryR\n" +
+	"deliveries\"\xcd\x01\n" +
+	"\x13WebhookSubscription\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12=\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x1c.payment.v1.PaymentEventTypeR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa5\x04\n" +
+	"\x0fWebhookDelivery\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12+\n" +
+	"\x11subscription_uuid\x18\x02 \x01(\tR\x10subscriptionUuid\x12%\n" +
+	"\x0eevent_sequence\x18\x03 \x01(\x03R\reventSequence\x12;\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2\x1c.payment.v1.PaymentEventTypeR\teventType\x129\n" +
+	"\x06status\x18\x05 \x01(\x0e2!.payment.v1.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12B\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12=\n" +
+	"\fdelivered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"e\n" +
+	"\x0eWebhookPayload\x12#\n" +
+	"\rdelivery_uuid\x18\x01 \x01(\tR\fdeliveryUuid\x12.\n" +
+	"\x05event\x18\x02 \x01(\v2\x18.payment.v1.PaymentEventR\x05event\"\xcc\x01\n" +
+	"\x0fSaveCardRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\x126\n" +
+	"\vcard_number\x18\x02 \x01(\tB\x15\xbaH\x12r\x102\x0e^[0-9]{12,19}$R\n" +
+	"cardNumber\x12,\n" +
+	"\fexpiry_month\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\f(\x01R\vexpiryMonth\x12,\n" +
+	"\vexpiry_year\x18\x04 \x01(\x05B\v\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fR\n" +
+	"expiryYear\"?\n" +
+	"\x10SaveCardResponse\x12+\n" +
+	"\x04card\x18\x01 \x01(\v2\x17.payment.v1.PaymentCardR\x04card\"9\n" +
+	"\x10ListCardsRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\"B\n" +
+	"\x11ListCardsResponse\x12-\n" +
+	"\x05cards\x18\x01 \x03(\v2\x17.payment.v1.PaymentCardR\x05cards\"j\n" +
+	"\x11DeleteCardRequest\x12%\n" +
+	"\tuser_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\buserUuid\x12.\n" +
+	"\rpayment_token\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\fpaymentToken\"\x14\n" +
+	"\x12DeleteCardResponse\"\x17\n" +
+	"\x15ReencryptCardsRequest\"i\n" +
+	"\x16ReencryptCardsResponse\x12\"\n" +
+	"\ractive_key_id\x18\x01 \x01(\tR\vactiveKeyId\x12+\n" +
+	"\x11reencrypted_count\x18\x02 \x01(\x05R\x10reencryptedCount\"\xb6\x02\n" +
+	"\vPaymentCard\x12#\n" +
+	"\rpayment_token\x18\x01 \x01(\tR\fpaymentToken\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12+\n" +
+	"\x05brand\x18\x03 \x01(\x0e2\x15.payment.v1.CardBrandR\x05brand\x12#\n" +
+	"\rmasked_number\x18\x04 \x01(\tR\fmaskedNumber\x12\x14\n" +
+	"\x05last4\x18\x05 \x01(\tR\x05last4\x12!\n" +
+	"\fexpiry_month\x18\x06 \x01(\x05R\vexpiryMonth\x12\x1f\n" +
+	"\vexpiry_year\x18\a \x01(\x05R\n" +
+	"expiryYear\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa2\x01\n" +
+	"\bLineItem\x12\x19\n" +
+	"\x03sku\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18@R\x03sku\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x04name\x12#\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x126\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x0f.money.v1.MoneyB\x06\xbaH\x03\xc8\x01\x01R\tunitPrice\"H\n" +
+	"\x11GetReceiptRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\"C\n" +
+	"\x12GetReceiptResponse\x12-\n" +
+	"\areceipt\x18\x01 \x01(\v2\x13.payment.v1.ReceiptR\areceipt\"\xc6\x04\n" +
+	"\aReceipt\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12,\n" +
+	"\x12masked_card_number\x18\x06 \x01(\tR\x10maskedCardNumber\x12-\n" +
+	"\x05items\x18\a \x03(\v2\x17.payment.v1.ReceiptItemR\x05items\x12%\n" +
+	"\x05total\x18\b \x01(\v2\x0f.money.v1.MoneyR\x05total\x12,\n" +
+	"\ttax_total\x18\t \x01(\v2\x0f.money.v1.MoneyR\btaxTotal\x123\n" +
+	"\apaid_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x12\x12\n" +
+	"\x04html\x18\v \x01(\tR\x04html\x12\x12\n" +
+	"\x04text\x18\f \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x124\n" +
+	"\rcredit_amount\x18\x0e \x01(\v2\x0f.money.v1.MoneyR\fcreditAmount\"\xfe\x01\n" +
+	"\vReceiptItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12.\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x0f.money.v1.MoneyR\tunitPrice\x12'\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x121\n" +
+	"\x15tax_rate_basis_points\x18\x06 \x01(\x03R\x12taxRateBasisPoints\x12!\n" +
+	"\x03tax\x18\a \x01(\v2\x0f.money.v1.MoneyR\x03tax\"\xb1\x01\n" +
+	"\x12OpenDisputeRequest\x123\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0ftransactionUuid\x12'\n" +
+	"\x06amount\x18\x02 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x12=\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x19.payment.v1.DisputeReasonB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06reason\"D\n" +
+	"\x13OpenDisputeResponse\x12-\n" +
+	"\adispute\x18\x01 \x01(\v2\x13.payment.v1.DisputeR\adispute\"@\n" +
+	"\x11GetDisputeRequest\x12+\n" +
+	"\fdispute_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vdisputeUuid\"C\n" +
+	"\x12GetDisputeResponse\x12-\n" +
+	"\adispute\x18\x01 \x01(\v2\x13.payment.v1.DisputeR\adispute\"\x93\x01\n" +
+	"\x13ListDisputesRequest\x126\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x0ftransactionUuid\x12D\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2\x19.payment.v1.DisputeStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\"G\n" +
+	"\x14ListDisputesResponse\x12/\n" +
+	"\bdisputes\x18\x01 \x03(\v2\x13.payment.v1.DisputeR\bdisputes\"\x8c\x01\n" +
+	"\x1cSubmitDisputeEvidenceRequest\x12+\n" +
+	"\fdispute_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vdisputeUuid\x12?\n" +
+	"\bevidence\x18\x02 \x01(\v2\x1b.payment.v1.DisputeEvidenceB\x06\xbaH\x03\xc8\x01\x01R\bevidence\"N\n" +
+	"\x1dSubmitDisputeEvidenceResponse\x12-\n" +
+	"\adispute\x18\x01 \x01(\v2\x13.payment.v1.DisputeR\adispute\"C\n" +
+	"\x14AcceptDisputeRequest\x12+\n" +
+	"\fdispute_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vdisputeUuid\"F\n" +
+	"\x15AcceptDisputeResponse\x12-\n" +
+	"\adispute\x18\x01 \x01(\v2\x13.payment.v1.DisputeR\adispute\"\x86\x01\n" +
+	"\x15ResolveDisputeRequest\x12+\n" +
+	"\fdispute_uuid\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vdisputeUuid\x12@\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2\x1a.payment.v1.DisputeOutcomeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\aoutcome\"G\n" +
+	"\x16ResolveDisputeResponse\x12-\n" +
+	"\adispute\x18\x01 \x01(\v2\x13.payment.v1.DisputeR\adispute\"\xc3\x04\n" +
+	"\aDispute\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12'\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x121\n" +
+	"\x06reason\x18\x06 \x01(\x0e2\x19.payment.v1.DisputeReasonR\x06reason\x121\n" +
+	"\x06status\x18\a \x01(\x0e2\x19.payment.v1.DisputeStatusR\x06status\x12B\n" +
+	"\x0fevidence_due_by\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\revidenceDueBy\x127\n" +
+	"\bevidence\x18\t \x01(\v2\x1b.payment.v1.DisputeEvidenceR\bevidence\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vresolved_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\xe6\x01\n" +
+	"\x0fDisputeEvidence\x12,\n" +
+	"\vdescription\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xa0\x1fR\vdescription\x120\n" +
+	"\x0ftracking_number\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18@R\x0etrackingNumber\x124\n" +
+	"\rdocument_urls\x18\x03 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10\n" +
+	"\"\x05r\x03\x88\x01\x01R\fdocumentUrls\x12=\n" +
+	"\fsubmitted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\x89\x03\n" +
+	"\x12TransactionsFilter\x12.\n" +
+	"\vorder_uuids\x18\x01 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\n" +
+	"orderUuids\x12,\n" +
+	"\n" +
+	"user_uuids\x18\x02 \x03(\tB\r\xbaH\n" +
+	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\tuserUuids\x12Q\n" +
+	"\x0fpayment_methods\x18\x03 \x03(\x0e2\x19.payment.v1.PaymentMethodB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\x0epaymentMethods\x12H\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x1d.payment.v1.TransactionStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\x12=\n" +
+	"\fcreated_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\"\xad\b\n" +
+	"\vTransaction\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x04 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12'\n" +
+	"\x06amount\x18\a \x01(\v2\x0f.money.v1.MoneyR\x06amount\x128\n" +
+	"\x0frefunded_amount\x18\b \x01(\v2\x0f.money.v1.MoneyR\x0erefundedAmount\x12<\n" +
+	"\x11authorized_amount\x18\t \x01(\v2\x0f.money.v1.MoneyR\x10authorizedAmount\x12T\n" +
+	"\x18authorization_expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x16authorizationExpiresAt\x12;\n" +
+	"\vcaptured_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"capturedAt\x12!\n" +
+	"\fdecline_code\x18\f \x01(\tR\vdeclineCode\x12#\n" +
+	"\rinvestor_uuid\x18\r \x01(\tR\finvestorUuid\x126\n" +
+	"\x17installment_term_months\x18\x0e \x01(\x05R\x15installmentTermMonths\x12<\n" +
+	"\x11settlement_amount\x18\x0f \x01(\v2\x0f.money.v1.MoneyR\x10settlementAmount\x12#\n" +
+	"\rexchange_rate\x18\x10 \x01(\tR\fexchangeRate\x12#\n" +
+	"\rpayment_token\x18\x11 \x01(\tR\fpaymentToken\x12,\n" +
+	"\x12masked_card_number\x18\x12 \x01(\tR\x10maskedCardNumber\x123\n" +
+	"\n" +
+	"line_items\x18\x13 \x03(\v2\x14.payment.v1.LineItemR\tlineItems\x12?\n" +
+	"\x13charged_back_amount\x18\x14 \x01(\v2\x0f.money.v1.MoneyR\x11chargedBackAmount\x12:\n" +
+	"\x10collected_amount\x18\x15 \x01(\v2\x0f.money.v1.MoneyR\x0fcollectedAmount*b\n" +
+	"\rQrImageFormat\x12\x1f\n" +
+	"\x1bQR_IMAGE_FORMAT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13QR_IMAGE_FORMAT_PNG\x10\x01\x12\x17\n" +
+	"\x13QR_IMAGE_FORMAT_SVG\x10\x02*\xb4\x01\n" +
+	"\fRefundReason\x12\x1d\n" +
+	"\x19REFUND_REASON_UNSPECIFIED\x10\x00\x12'\n" +
+	"#REFUND_REASON_REQUESTED_BY_CUSTOMER\x10\x01\x12!\n" +
+	"\x1dREFUND_REASON_ORDER_CANCELLED\x10\x02\x12\x1b\n" +
+	"\x17REFUND_REASON_DUPLICATE\x10\x03\x12\x1c\n" +
+	"\x18REFUND_REASON_FRAUDULENT\x10\x04*d\n" +
+	"\fRefundStatus\x12\x1d\n" +
+	"\x19REFUND_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REFUND_STATUS_SUCCEEDED\x10\x01\x12\x18\n" +
+	"\x14REFUND_STATUS_FAILED\x10\x02*\x82\x02\n" +
+	"\x11LedgerAccountType\x12#\n" +
+	"\x1fLEDGER_ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cLEDGER_ACCOUNT_TYPE_CUSTOMER\x10\x01\x12 \n" +
+	"\x1cLEDGER_ACCOUNT_TYPE_MERCHANT\x10\x02\x12\x1f\n" +
+	"\x1bLEDGER_ACCOUNT_TYPE_REFUNDS\x10\x03\x12\x1c\n" +
+	"\x18LEDGER_ACCOUNT_TYPE_FEES\x10\x04\x12#\n" +
+	"\x1fLEDGER_ACCOUNT_TYPE_CHARGEBACKS\x10\x05\x12 \n" +
+	"\x1cLEDGER_ACCOUNT_TYPE_INTEREST\x10\x06*\xb9\x01\n" +
+	"\x10JournalEntryKind\x12\"\n" +
+	"\x1eJOURNAL_ENTRY_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aJOURNAL_ENTRY_KIND_CAPTURE\x10\x01\x12\x1d\n" +
+	"\x19JOURNAL_ENTRY_KIND_REFUND\x10\x02\x12\x1f\n" +
+	"\x1bJOURNAL_ENTRY_KIND_REVERSAL\x10\x03\x12!\n" +
+	"\x1dJOURNAL_ENTRY_KIND_CHARGEBACK\x10\x04*p\n" +
+	"\x10PostingDirection\x12!\n" +
+	"\x1dPOSTING_DIRECTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17POSTING_DIRECTION_DEBIT\x10\x01\x12\x1c\n" +
+	"\x18POSTING_DIRECTION_CREDIT\x10\x02*\xed\x01\n" +
+	"\x14InvestorMovementKind\x12&\n" +
+	"\"INVESTOR_MOVEMENT_KIND_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dINVESTOR_MOVEMENT_KIND_TOP_UP\x10\x01\x12\x1f\n" +
+	"\x1bINVESTOR_MOVEMENT_KIND_HOLD\x10\x02\x12\"\n" +
+	"\x1eINVESTOR_MOVEMENT_KIND_CAPTURE\x10\x03\x12\"\n" +
+	"\x1eINVESTOR_MOVEMENT_KIND_RELEASE\x10\x04\x12!\n" +
+	"\x1dINVESTOR_MOVEMENT_KIND_REFUND\x10\x05*_\n" +
+	"\fFraudOutcome\x12\x1d\n" +
+	"\x19FRAUD_OUTCOME_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13FRAUD_OUTCOME_ALLOW\x10\x01\x12\x17\n" +
+	"\x13FRAUD_OUTCOME_BLOCK\x10\x02*\xa9\x01\n" +
+	"\vFraudReason\x12\x1c\n" +
+	"\x18FRAUD_REASON_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16FRAUD_REASON_BLOCKLIST\x10\x01\x12\x1d\n" +
+	"\x19FRAUD_REASON_AMOUNT_LIMIT\x10\x02\x12\x1f\n" +
+	"\x1bFRAUD_REASON_VELOCITY_LIMIT\x10\x03\x12 \n" +
+	"\x1cFRAUD_REASON_FAILED_ATTEMPTS\x10\x04*\x81\x02\n" +
+	"\x15InstallmentPlanStatus\x12'\n" +
+	"#INSTALLMENT_PLAN_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eINSTALLMENT_PLAN_STATUS_ACTIVE\x10\x01\x12&\n" +
+	"\"INSTALLMENT_PLAN_STATUS_DELINQUENT\x10\x02\x12%\n" +
+	"!INSTALLMENT_PLAN_STATUS_DEFAULTED\x10\x03\x12%\n" +
+	"!INSTALLMENT_PLAN_STATUS_COMPLETED\x10\x04\x12%\n" +
+	"!INSTALLMENT_PLAN_STATUS_CANCELLED\x10\x05*\x95\x01\n" +
+	"\x11InstallmentStatus\x12\"\n" +
+	"\x1eINSTALLMENT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cINSTALLMENT_STATUS_SCHEDULED\x10\x01\x12\x1b\n" +
+	"\x17INSTALLMENT_STATUS_PAID\x10\x02\x12\x1d\n" +
+	"\x19INSTALLMENT_STATUS_MISSED\x10\x03*\xf5\x02\n" +
+	"\x10PaymentEventType\x12\"\n" +
+	"\x1ePAYMENT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPAYMENT_EVENT_TYPE_AUTHORIZED\x10\x01\x12\x1f\n" +
+	"\x1bPAYMENT_EVENT_TYPE_CAPTURED\x10\x02\x12\x1d\n" +
+	"\x19PAYMENT_EVENT_TYPE_FAILED\x10\x03\x12\x1f\n" +
+	"\x1bPAYMENT_EVENT_TYPE_REFUNDED\x10\x04\x12\x1d\n" +
+	"\x19PAYMENT_EVENT_TYPE_VOIDED\x10\x05\x12%\n" +
+	"!PAYMENT_EVENT_TYPE_DISPUTE_OPENED\x10\x06\x12\"\n" +
+	"\x1ePAYMENT_EVENT_TYPE_DISPUTE_WON\x10\a\x12#\n" +
+	"\x1fPAYMENT_EVENT_TYPE_DISPUTE_LOST\x10\b\x12*\n" +
+	"&PAYMENT_EVENT_TYPE_INSTALLMENT_CHARGED\x10\t*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_DELIVERED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x03*\xad\x01\n" +
+	"\tCardBrand\x12\x1a\n" +
+	"\x16CARD_BRAND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fCARD_BRAND_VISA\x10\x01\x12\x19\n" +
+	"\x15CARD_BRAND_MASTERCARD\x10\x02\x12\x12\n" +
+	"\x0eCARD_BRAND_MIR\x10\x03\x12\x13\n" +
+	"\x0fCARD_BRAND_AMEX\x10\x04\x12\x17\n" +
+	"\x13CARD_BRAND_UNIONPAY\x10\x05\x12\x12\n" +
+	"\x0eCARD_BRAND_JCB\x10\x06*\x83\x02\n" +
+	"\rDisputeReason\x12\x1e\n" +
+	"\x1aDISPUTE_REASON_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DISPUTE_REASON_FRAUDULENT\x10\x01\x12'\n" +
+	"#DISPUTE_REASON_PRODUCT_NOT_RECEIVED\x10\x02\x12'\n" +
+	"#DISPUTE_REASON_PRODUCT_UNACCEPTABLE\x10\x03\x12\x1c\n" +
+	"\x18DISPUTE_REASON_DUPLICATE\x10\x04\x12'\n" +
+	"#DISPUTE_REASON_CREDIT_NOT_PROCESSED\x10\x05\x12\x1a\n" +
+	"\x16DISPUTE_REASON_GENERAL\x10\x06*\xa4\x01\n" +
+	"\rDisputeStatus\x12\x1e\n" +
+	"\x1aDISPUTE_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dDISPUTE_STATUS_NEEDS_RESPONSE\x10\x01\x12\x1f\n" +
+	"\x1bDISPUTE_STATUS_UNDER_REVIEW\x10\x02\x12\x16\n" +
+	"\x12DISPUTE_STATUS_WON\x10\x03\x12\x17\n" +
+	"\x13DISPUTE_STATUS_LOST\x10\x04*d\n" +
+	"\x0eDisputeOutcome\x12\x1f\n" +
+	"\x1bDISPUTE_OUTCOME_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13DISPUTE_OUTCOME_WON\x10\x01\x12\x18\n" +
+	"\x14DISPUTE_OUTCOME_LOST\x10\x02*\xe8\x02\n" +
+	"\x11TransactionStatus\x12\"\n" +
+	"\x1eTRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TRANSACTION_STATUS_PAID\x10\x01\x12)\n" +
+	"%TRANSACTION_STATUS_PARTIALLY_REFUNDED\x10\x02\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_REFUNDED\x10\x03\x12!\n" +
+	"\x1dTRANSACTION_STATUS_AUTHORIZED\x10\x04\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_VOIDED\x10\x05\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_EXPIRED\x10\x06\x12\x1f\n" +
+	"\x1bTRANSACTION_STATUS_DECLINED\x10\a\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\b\x12#\n" +
+	"\x1fTRANSACTION_STATUS_CHARGED_BACK\x10\t*\xa3\x01\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042Y\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04*\x85\t\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dERROR_REASON_INVALID_ARGUMENT\x10\x01\x12-\n" +
+	")ERROR_REASON_PAYMENT_METHOD_NOT_SUPPORTED\x10\x02\x12&\n" +
+	"\"ERROR_REASON_TRANSACTION_NOT_FOUND\x10\x03\x12\x1f\n" +
+	"\x1bERROR_REASON_INVALID_AMOUNT\x10\x04\x12'\n" +
+	"#ERROR_REASON_IDEMPOTENCY_KEY_REUSED\x10\x05\x12(\n" +
+	"$ERROR_REASON_REFUND_EXCEEDS_CAPTURED\x10\x06\x12+\n" +
+	"'ERROR_REASON_TRANSACTION_NOT_REFUNDABLE\x10\a\x12!\n" +
+	"\x1dERROR_REASON_REFUND_NOT_FOUND\x10\b\x12&\n" +
+	"\"ERROR_REASON_AUTHORIZATION_EXPIRED\x10\t\x12*\n" +
+	"&ERROR_REASON_INVALID_TRANSACTION_STATE\x10\n" +
+	"\x12!\n" +
+	"\x1dERROR_REASON_PAYMENT_DECLINED\x10\v\x12%\n" +
+	"!ERROR_REASON_PROVIDER_UNAVAILABLE\x10\f\x12#\n" +
+	"\x1fERROR_REASON_INVESTOR_NOT_FOUND\x10\r\x12'\n" +
+	"#ERROR_REASON_PAYMENT_INTENT_EXPIRED\x10\x0e\x12 \n" +
+	"\x1cERROR_REASON_PAYMENT_BLOCKED\x10\x0f\x12)\n" +
+	"%ERROR_REASON_INVALID_INSTALLMENT_TERM\x10\x10\x12+\n" +
+	"'ERROR_REASON_INSTALLMENT_PLAN_NOT_FOUND\x10\x11\x12/\n" +
+	"+ERROR_REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND\x10\x12\x12+\n" +
+	"'ERROR_REASON_WEBHOOK_DELIVERY_NOT_FOUND\x10\x13\x12\x1f\n" +
+	"\x1bERROR_REASON_CARD_NOT_FOUND\x10\x14\x12\x1d\n" +
+	"\x19ERROR_REASON_CARD_EXPIRED\x10\x15\x12'\n" +
+	"#ERROR_REASON_CARD_VAULT_UNAVAILABLE\x10\x16\x12\"\n" +
+	"\x1eERROR_REASON_RECEIPT_NOT_FOUND\x10\x17\x12\"\n" +
+	"\x1eERROR_REASON_DISPUTE_NOT_FOUND\x10\x18\x12+\n" +
+	"'ERROR_REASON_TRANSACTION_NOT_DISPUTABLE\x10\x19\x12%\n" +
+	"!ERROR_REASON_DISPUTE_ALREADY_OPEN\x10\x1a\x12&\n" +
+	"\"ERROR_REASON_INVALID_DISPUTE_STATE\x10\x1b\x12)\n" +
+	"%ERROR_REASON_DISPUTE_EVIDENCE_OVERDUE\x10\x1c2\x81!\n" +
 	"\x0ePaymentService\x12G\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\"\x00B9Z7github.com/ms_bigtech/shared/proto/payment/v1;paymentv1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\"\x00\x12_\n" +
+	"\x10AuthorizePayment\x12#.payment.v1.AuthorizePaymentRequest\x1a$.payment.v1.AuthorizePaymentResponse\"\x00\x12Y\n" +
+	"\x0eCapturePayment\x12!.payment.v1.CapturePaymentRequest\x1a\".payment.v1.CapturePaymentResponse\"\x00\x12b\n" +
+	"\x11VoidAuthorization\x12$.payment.v1.VoidAuthorizationRequest\x1a%.payment.v1.VoidAuthorizationResponse\"\x00\x12q\n" +
+	"\x16CreateSbpPaymentIntent\x12).payment.v1.CreateSbpPaymentIntentRequest\x1a*.payment.v1.CreateSbpPaymentIntentResponse\"\x00\x12h\n" +
+	"\x13GetSbpPaymentIntent\x12&.payment.v1.GetSbpPaymentIntentRequest\x1a'.payment.v1.GetSbpPaymentIntentResponse\"\x00\x12t\n" +
+	"\x17ConfirmSbpPaymentIntent\x12*.payment.v1.ConfirmSbpPaymentIntentRequest\x1a+.payment.v1.ConfirmSbpPaymentIntentResponse\"\x00\x12Y\n" +
+	"\x0eGetTransaction\x12!.payment.v1.GetTransactionRequest\x1a\".payment.v1.GetTransactionResponse\"\x00\x12_\n" +
+	"\x10ListTransactions\x12#.payment.v1.ListTransactionsRequest\x1a$.payment.v1.ListTransactionsResponse\"\x00\x12V\n" +
+	"\rRefundPayment\x12 .payment.v1.RefundPaymentRequest\x1a!.payment.v1.RefundPaymentResponse\"\x00\x12J\n" +
+	"\tGetRefund\x12\x1c.payment.v1.GetRefundRequest\x1a\x1d.payment.v1.GetRefundResponse\"\x00\x12P\n" +
+	"\vListRefunds\x12\x1e.payment.v1.ListRefundsRequest\x1a\x1f.payment.v1.ListRefundsResponse\"\x00\x12h\n" +
+	"\x13ListAccountBalances\x12&.payment.v1.ListAccountBalancesRequest\x1a'.payment.v1.ListAccountBalancesResponse\"\x00\x12e\n" +
+	"\x12ListJournalEntries\x12%.payment.v1.ListJournalEntriesRequest\x1a&.payment.v1.ListJournalEntriesResponse\"\x00\x12q\n" +
+	"\x16CheckLedgerConsistency\x12).payment.v1.CheckLedgerConsistencyRequest\x1a*.payment.v1.CheckLedgerConsistencyResponse\"\x00\x12Y\n" +
+	"\x0eCreateInvestor\x12!.payment.v1.CreateInvestorRequest\x1a\".payment.v1.CreateInvestorResponse\"\x00\x12P\n" +
+	"\vGetInvestor\x12\x1e.payment.v1.GetInvestorRequest\x1a\x1f.payment.v1.GetInvestorResponse\"\x00\x12V\n" +
+	"\rListInvestors\x12 .payment.v1.ListInvestorsRequest\x1a!.payment.v1.ListInvestorsResponse\"\x00\x12V\n" +
+	"\rTopUpInvestor\x12 .payment.v1.TopUpInvestorRequest\x1a!.payment.v1.TopUpInvestorResponse\"\x00\x12h\n" +
+	"\x13GrantInvestorAccess\x12&.payment.v1.GrantInvestorAccessRequest\x1a'.payment.v1.GrantInvestorAccessResponse\"\x00\x12k\n" +
+	"\x14RevokeInvestorAccess\x12'.payment.v1.RevokeInvestorAccessRequest\x1a(.payment.v1.RevokeInvestorAccessResponse\"\x00\x12n\n" +
+	"\x15ListInvestorMovements\x12(.payment.v1.ListInvestorMovementsRequest\x1a).payment.v1.ListInvestorMovementsResponse\"\x00\x12e\n" +
+	"\x12ListFraudDecisions\x12%.payment.v1.ListFraudDecisionsRequest\x1a&.payment.v1.ListFraudDecisionsResponse\"\x00\x12k\n" +
+	"\x14QuoteInstallmentPlan\x12'.payment.v1.QuoteInstallmentPlanRequest\x1a(.payment.v1.QuoteInstallmentPlanResponse\"\x00\x12e\n" +
+	"\x12GetInstallmentPlan\x12%.payment.v1.GetInstallmentPlanRequest\x1a&.payment.v1.GetInstallmentPlanResponse\"\x00\x12k\n" +
+	"\x14ListInstallmentPlans\x12'.payment.v1.ListInstallmentPlansRequest\x1a(.payment.v1.ListInstallmentPlansResponse\"\x00\x12s\n" +
+	"\x16SubscribePaymentEvents\x12).payment.v1.SubscribePaymentEventsRequest\x1a*.payment.v1.SubscribePaymentEventsResponse\"\x000\x01\x12z\n" +
+	"\x19CreateWebhookSubscription\x12,.payment.v1.CreateWebhookSubscriptionRequest\x1a-.payment.v1.CreateWebhookSubscriptionResponse\"\x00\x12w\n" +
+	"\x18ListWebhookSubscriptions\x12+.payment.v1.ListWebhookSubscriptionsRequest\x1a,.payment.v1.ListWebhookSubscriptionsResponse\"\x00\x12z\n" +
+	"\x19DeleteWebhookSubscription\x12,.payment.v1.DeleteWebhookSubscriptionRequest\x1a-.payment.v1.DeleteWebhookSubscriptionResponse\"\x00\x12n\n" +
+	"\x15ListWebhookDeliveries\x12(.payment.v1.ListWebhookDeliveriesRequest\x1a).payment.v1.ListWebhookDeliveriesResponse\"\x00\x12t\n" +
+	"\x17ReplayWebhookDeliveries\x12*.payment.v1.ReplayWebhookDeliveriesRequest\x1a+.payment.v1.ReplayWebhookDeliveriesResponse\"\x00\x12G\n" +
+	"\bSaveCard\x12\x1b.payment.v1.SaveCardRequest\x1a\x1c.payment.v1.SaveCardResponse\"\x00\x12J\n" +
+	"\tListCards\x12\x1c.payment.v1.ListCardsRequest\x1a\x1d.payment.v1.ListCardsResponse\"\x00\x12M\n" +
+	"\n" +
+	"DeleteCard\x12\x1d.payment.v1.DeleteCardRequest\x1a\x1e.payment.v1.DeleteCardResponse\"\x00\x12Y\n" +
+	"\x0eReencryptCards\x12!.payment.v1.ReencryptCardsRequest\x1a\".payment.v1.ReencryptCardsResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetReceipt\x12\x1d.payment.v1.GetReceiptRequest\x1a\x1e.payment.v1.GetReceiptResponse\"\x00\x12P\n" +
+	"\vOpenDispute\x12\x1e.payment.v1.OpenDisputeRequest\x1a\x1f.payment.v1.OpenDisputeResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetDispute\x12\x1d.payment.v1.GetDisputeRequest\x1a\x1e.payment.v1.GetDisputeResponse\"\x00\x12S\n" +
+	"\fListDisputes\x12\x1f.payment.v1.ListDisputesRequest\x1a .payment.v1.ListDisputesResponse\"\x00\x12n\n" +
+	"\x15SubmitDisputeEvidence\x12(.payment.v1.SubmitDisputeEvidenceRequest\x1a).payment.v1.SubmitDisputeEvidenceResponse\"\x00\x12V\n" +
+	"\rAcceptDispute\x12 .payment.v1.AcceptDisputeRequest\x1a!.payment.v1.AcceptDisputeResponse\"\x00\x12Y\n" +
+	"\x0eResolveDispute\x12!.payment.v1.ResolveDisputeRequest\x1a\".payment.v1.ResolveDisputeResponse\"\x00BGZEgithub.com/Denisz0785/spaceyard/shared/pkg/proto/payment/v1;paymentv1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -217,22 +8851,398 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(QrImageFormat)(0),                        // 0: payment.v1.QrImageFormat
+	(RefundReason)(0),                         // 1: payment.v1.RefundReason
+	(RefundStatus)(0),                         // 2: payment.v1.RefundStatus
+	(LedgerAccountType)(0),                    // 3: payment.v1.LedgerAccountType
+	(JournalEntryKind)(0),                     // 4: payment.v1.JournalEntryKind
+	(PostingDirection)(0),                     // 5: payment.v1.PostingDirection
+	(InvestorMovementKind)(0),                 // 6: payment.v1.InvestorMovementKind
+	(FraudOutcome)(0),                         // 7: payment.v1.FraudOutcome
+	(FraudReason)(0),                          // 8: payment.v1.FraudReason
+	(InstallmentPlanStatus)(0),                // 9: payment.v1.InstallmentPlanStatus
+	(InstallmentStatus)(0),                    // 10: payment.v1.InstallmentStatus
+	(PaymentEventType)(0),                     // 11: payment.v1.PaymentEventType
+	(WebhookDeliveryStatus)(0),                // 12: payment.v1.WebhookDeliveryStatus
+	(CardBrand)(0),                            // 13: payment.v1.CardBrand
+	(DisputeReason)(0),                        // 14: payment.v1.DisputeReason
+	(DisputeStatus)(0),                        // 15: payment.v1.DisputeStatus
+	(DisputeOutcome)(0),                       // 16: payment.v1.DisputeOutcome
+	(TransactionStatus)(0),                    // 17: payment.v1.TransactionStatus
+	(PaymentMethod)(0),                        // 18: payment.v1.PaymentMethod
+	(ErrorReason)(0),                          // 19: payment.v1.ErrorReason
+	(*PayOrderRequest)(nil),                   // 20: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),                  // 21: payment.v1.PayOrderResponse
+	(*AuthorizePaymentRequest)(nil),           // 22: payment.v1.AuthorizePaymentRequest
+	(*AuthorizePaymentResponse)(nil),          // 23: payment.v1.AuthorizePaymentResponse
+	(*CapturePaymentRequest)(nil),             // 24: payment.v1.CapturePaymentRequest
+	(*CapturePaymentResponse)(nil),            // 25: payment.v1.CapturePaymentResponse
+	(*VoidAuthorizationRequest)(nil),          // 26: payment.v1.VoidAuthorizationRequest
+	(*VoidAuthorizationResponse)(nil),         // 27: payment.v1.VoidAuthorizationResponse
+	(*CreateSbpPaymentIntentRequest)(nil),     // 28: payment.v1.CreateSbpPaymentIntentRequest
+	(*CreateSbpPaymentIntentResponse)(nil),    // 29: payment.v1.CreateSbpPaymentIntentResponse
+	(*GetSbpPaymentIntentRequest)(nil),        // 30: payment.v1.GetSbpPaymentIntentRequest
+	(*GetSbpPaymentIntentResponse)(nil),       // 31: payment.v1.GetSbpPaymentIntentResponse
+	(*ConfirmSbpPaymentIntentRequest)(nil),    // 32: payment.v1.ConfirmSbpPaymentIntentRequest
+	(*ConfirmSbpPaymentIntentResponse)(nil),   // 33: payment.v1.ConfirmSbpPaymentIntentResponse
+	(*SbpPaymentIntent)(nil),                  // 34: payment.v1.SbpPaymentIntent
+	(*GetTransactionRequest)(nil),             // 35: payment.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),            // 36: payment.v1.GetTransactionResponse
+	(*ListTransactionsRequest)(nil),           // 37: payment.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),          // 38: payment.v1.ListTransactionsResponse
+	(*RefundPaymentRequest)(nil),              // 39: payment.v1.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),             // 40: payment.v1.RefundPaymentResponse
+	(*GetRefundRequest)(nil),                  // 41: payment.v1.GetRefundRequest
+	(*GetRefundResponse)(nil),                 // 42: payment.v1.GetRefundResponse
+	(*ListRefundsRequest)(nil),                // 43: payment.v1.ListRefundsRequest
+	(*ListRefundsResponse)(nil),               // 44: payment.v1.ListRefundsResponse
+	(*Refund)(nil),                            // 45: payment.v1.Refund
+	(*ListAccountBalancesRequest)(nil),        // 46: payment.v1.ListAccountBalancesRequest
+	(*ListAccountBalancesResponse)(nil),       // 47: payment.v1.ListAccountBalancesResponse
+	(*ListJournalEntriesRequest)(nil),         // 48: payment.v1.ListJournalEntriesRequest
+	(*ListJournalEntriesResponse)(nil),        // 49: payment.v1.ListJournalEntriesResponse
+	(*CheckLedgerConsistencyRequest)(nil),     // 50: payment.v1.CheckLedgerConsistencyRequest
+	(*CheckLedgerConsistencyResponse)(nil),    // 51: payment.v1.CheckLedgerConsistencyResponse
+	(*LedgerViolation)(nil),                   // 52: payment.v1.LedgerViolation
+	(*LedgerAccount)(nil),                     // 53: payment.v1.LedgerAccount
+	(*AccountBalance)(nil),                    // 54: payment.v1.AccountBalance
+	(*JournalEntry)(nil),                      // 55: payment.v1.JournalEntry
+	(*Posting)(nil),                           // 56: payment.v1.Posting
+	(*CreateInvestorRequest)(nil),             // 57: payment.v1.CreateInvestorRequest
+	(*CreateInvestorResponse)(nil),            // 58: payment.v1.CreateInvestorResponse
+	(*GetInvestorRequest)(nil),                // 59: payment.v1.GetInvestorRequest
+	(*GetInvestorResponse)(nil),               // 60: payment.v1.GetInvestorResponse
+	(*ListInvestorsRequest)(nil),              // 61: payment.v1.ListInvestorsRequest
+	(*ListInvestorsResponse)(nil),             // 62: payment.v1.ListInvestorsResponse
+	(*TopUpInvestorRequest)(nil),              // 63: payment.v1.TopUpInvestorRequest
+	(*TopUpInvestorResponse)(nil),             // 64: payment.v1.TopUpInvestorResponse
+	(*GrantInvestorAccessRequest)(nil),        // 65: payment.v1.GrantInvestorAccessRequest
+	(*GrantInvestorAccessResponse)(nil),       // 66: payment.v1.GrantInvestorAccessResponse
+	(*RevokeInvestorAccessRequest)(nil),       // 67: payment.v1.RevokeInvestorAccessRequest
+	(*RevokeInvestorAccessResponse)(nil),      // 68: payment.v1.RevokeInvestorAccessResponse
+	(*ListInvestorMovementsRequest)(nil),      // 69: payment.v1.ListInvestorMovementsRequest
+	(*ListInvestorMovementsResponse)(nil),     // 70: payment.v1.ListInvestorMovementsResponse
+	(*Investor)(nil),                          // 71: payment.v1.Investor
+	(*InvestorMovement)(nil),                  // 72: payment.v1.InvestorMovement
+	(*ListFraudDecisionsRequest)(nil),         // 73: payment.v1.ListFraudDecisionsRequest
+	(*ListFraudDecisionsResponse)(nil),        // 74: payment.v1.ListFraudDecisionsResponse
+	(*FraudDecision)(nil),                     // 75: payment.v1.FraudDecision
+	(*QuoteInstallmentPlanRequest)(nil),       // 76: payment.v1.QuoteInstallmentPlanRequest
+	(*QuoteInstallmentPlanResponse)(nil),      // 77: payment.v1.QuoteInstallmentPlanResponse
+	(*GetInstallmentPlanRequest)(nil),         // 78: payment.v1.GetInstallmentPlanRequest
+	(*GetInstallmentPlanResponse)(nil),        // 79: payment.v1.GetInstallmentPlanResponse
+	(*ListInstallmentPlansRequest)(nil),       // 80: payment.v1.ListInstallmentPlansRequest
+	(*ListInstallmentPlansResponse)(nil),      // 81: payment.v1.ListInstallmentPlansResponse
+	(*InstallmentQuote)(nil),                  // 82: payment.v1.InstallmentQuote
+	(*InstallmentPlan)(nil),                   // 83: payment.v1.InstallmentPlan
+	(*Installment)(nil),                       // 84: payment.v1.Installment
+	(*SubscribePaymentEventsRequest)(nil),     // 85: payment.v1.SubscribePaymentEventsRequest
+	(*SubscribePaymentEventsResponse)(nil),    // 86: payment.v1.SubscribePaymentEventsResponse
+	(*PaymentEvent)(nil),                      // 87: payment.v1.PaymentEvent
+	(*CreateWebhookSubscriptionRequest)(nil),  // 88: payment.v1.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 89: payment.v1.CreateWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionsRequest)(nil),   // 90: payment.v1.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),  // 91: payment.v1.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 92: payment.v1.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 93: payment.v1.DeleteWebhookSubscriptionResponse
+	(*ListWebhookDeliveriesRequest)(nil),      // 94: payment.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 95: payment.v1.ListWebhookDeliveriesResponse
+	(*ReplayWebhookDeliveriesRequest)(nil),    // 96: payment.v1.ReplayWebhookDeliveriesRequest
+	(*ReplayWebhookDeliveriesResponse)(nil),   // 97: payment.v1.ReplayWebhookDeliveriesResponse
+	(*WebhookSubscription)(nil),               // 98: payment.v1.WebhookSubscription
+	(*WebhookDelivery)(nil),                   // 99: payment.v1.WebhookDelivery
+	(*WebhookPayload)(nil),                    // 100: payment.v1.WebhookPayload
+	(*SaveCardRequest)(nil),                   // 101: payment.v1.SaveCardRequest
+	(*SaveCardResponse)(nil),                  // 102: payment.v1.SaveCardResponse
+	(*ListCardsRequest)(nil),                  // 103: payment.v1.ListCardsRequest
+	(*ListCardsResponse)(nil),                 // 104: payment.v1.ListCardsResponse
+	(*DeleteCardRequest)(nil),                 // 105: payment.v1.DeleteCardRequest
+	(*DeleteCardResponse)(nil),                // 106: payment.v1.DeleteCardResponse
+	(*ReencryptCardsRequest)(nil),             // 107: payment.v1.ReencryptCardsRequest
+	(*ReencryptCardsResponse)(nil),            // 108: payment.v1.ReencryptCardsResponse
+	(*PaymentCard)(nil),                       // 109: payment.v1.PaymentCard
+	(*LineItem)(nil),                          // 110: payment.v1.LineItem
+	(*GetReceiptRequest)(nil),                 // 111: payment.v1.GetReceiptRequest
+	(*GetReceiptResponse)(nil),                // 112: payment.v1.GetReceiptResponse
+	(*Receipt)(nil),                           // 113: payment.v1.Receipt
+	(*ReceiptItem)(nil),                       // 114: payment.v1.ReceiptItem
+	(*OpenDisputeRequest)(nil),                // 115: payment.v1.OpenDisputeRequest
+	(*OpenDisputeResponse)(nil),               // 116: payment.v1.OpenDisputeResponse
+	(*GetDisputeRequest)(nil),                 // 117: payment.v1.GetDisputeRequest
+	(*GetDisputeResponse)(nil),                // 118: payment.v1.GetDisputeResponse
+	(*ListDisputesRequest)(nil),               // 119: payment.v1.ListDisputesRequest
+	(*ListDisputesResponse)(nil),              // 120: payment.v1.ListDisputesResponse
+	(*SubmitDisputeEvidenceRequest)(nil),      // 121: payment.v1.SubmitDisputeEvidenceRequest
+	(*SubmitDisputeEvidenceResponse)(nil),     // 122: payment.v1.SubmitDisputeEvidenceResponse
+	(*AcceptDisputeRequest)(nil),              // 123: payment.v1.AcceptDisputeRequest
+	(*AcceptDisputeResponse)(nil),             // 124: payment.v1.AcceptDisputeResponse
+	(*ResolveDisputeRequest)(nil),             // 125: payment.v1.ResolveDisputeRequest
+	(*ResolveDisputeResponse)(nil),            // 126: payment.v1.ResolveDisputeResponse
+	(*Dispute)(nil),                           // 127: payment.v1.Dispute
+	(*DisputeEvidence)(nil),                   // 128: payment.v1.DisputeEvidence
+	(*TransactionsFilter)(nil),                // 129: payment.v1.TransactionsFilter
+	(*Transaction)(nil),                       // 130: payment.v1.Transaction
+	(*v1.Money)(nil),                          // 131: money.v1.Money
+	(*timestamppb.Timestamp)(nil),             // 132: google.protobuf.Timestamp
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	18,  // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	131, // 1: payment.v1.PayOrderRequest.amount:type_name -> money.v1.Money
+	110, // 2: payment.v1.PayOrderRequest.line_items:type_name -> payment.v1.LineItem
+	34,  // 3: payment.v1.PayOrderResponse.sbp_payment_intent:type_name -> payment.v1.SbpPaymentIntent
+	18,  // 4: payment.v1.AuthorizePaymentRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	131, // 5: payment.v1.AuthorizePaymentRequest.amount:type_name -> money.v1.Money
+	110, // 6: payment.v1.AuthorizePaymentRequest.line_items:type_name -> payment.v1.LineItem
+	130, // 7: payment.v1.AuthorizePaymentResponse.transaction:type_name -> payment.v1.Transaction
+	131, // 8: payment.v1.CapturePaymentRequest.amount:type_name -> money.v1.Money
+	130, // 9: payment.v1.CapturePaymentResponse.transaction:type_name -> payment.v1.Transaction
+	130, // 10: payment.v1.VoidAuthorizationResponse.transaction:type_name -> payment.v1.Transaction
+	131, // 11: payment.v1.CreateSbpPaymentIntentRequest.amount:type_name -> money.v1.Money
+	0,   // 12: payment.v1.CreateSbpPaymentIntentRequest.image_format:type_name -> payment.v1.QrImageFormat
+	110, // 13: payment.v1.CreateSbpPaymentIntentRequest.line_items:type_name -> payment.v1.LineItem
+	34,  // 14: payment.v1.CreateSbpPaymentIntentResponse.intent:type_name -> payment.v1.SbpPaymentIntent
+	0,   // 15: payment.v1.GetSbpPaymentIntentRequest.image_format:type_name -> payment.v1.QrImageFormat
+	34,  // 16: payment.v1.GetSbpPaymentIntentResponse.intent:type_name -> payment.v1.SbpPaymentIntent
+	130, // 17: payment.v1.ConfirmSbpPaymentIntentResponse.transaction:type_name -> payment.v1.Transaction
+	130, // 18: payment.v1.SbpPaymentIntent.transaction:type_name -> payment.v1.Transaction
+	130, // 19: payment.v1.GetTransactionResponse.transaction:type_name -> payment.v1.Transaction
+	129, // 20: payment.v1.ListTransactionsRequest.filter:type_name -> payment.v1.TransactionsFilter
+	130, // 21: payment.v1.ListTransactionsResponse.transactions:type_name -> payment.v1.Transaction
+	131, // 22: payment.v1.RefundPaymentRequest.amount:type_name -> money.v1.Money
+	1,   // 23: payment.v1.RefundPaymentRequest.reason:type_name -> payment.v1.RefundReason
+	45,  // 24: payment.v1.RefundPaymentResponse.refund:type_name -> payment.v1.Refund
+	45,  // 25: payment.v1.GetRefundResponse.refund:type_name -> payment.v1.Refund
+	45,  // 26: payment.v1.ListRefundsResponse.refunds:type_name -> payment.v1.Refund
+	131, // 27: payment.v1.Refund.amount:type_name -> money.v1.Money
+	1,   // 28: payment.v1.Refund.reason:type_name -> payment.v1.RefundReason
+	2,   // 29: payment.v1.Refund.status:type_name -> payment.v1.RefundStatus
+	132, // 30: payment.v1.Refund.created_at:type_name -> google.protobuf.Timestamp
+	131, // 31: payment.v1.Refund.written_off_amount:type_name -> money.v1.Money
+	3,   // 32: payment.v1.ListAccountBalancesRequest.account_type:type_name -> payment.v1.LedgerAccountType
+	54,  // 33: payment.v1.ListAccountBalancesResponse.balances:type_name -> payment.v1.AccountBalance
+	55,  // 34: payment.v1.ListJournalEntriesResponse.entries:type_name -> payment.v1.JournalEntry
+	52,  // 35: payment.v1.CheckLedgerConsistencyResponse.violations:type_name -> payment.v1.LedgerViolation
+	3,   // 36: payment.v1.LedgerAccount.type:type_name -> payment.v1.LedgerAccountType
+	53,  // 37: payment.v1.AccountBalance.account:type_name -> payment.v1.LedgerAccount
+	131, // 38: payment.v1.AccountBalance.debits:type_name -> money.v1.Money
+	131, // 39: payment.v1.AccountBalance.credits:type_name -> money.v1.Money
+	131, // 40: payment.v1.AccountBalance.balance:type_name -> money.v1.Money
+	4,   // 41: payment.v1.JournalEntry.kind:type_name -> payment.v1.JournalEntryKind
+	56,  // 42: payment.v1.JournalEntry.postings:type_name -> payment.v1.Posting
+	132, // 43: payment.v1.JournalEntry.created_at:type_name -> google.protobuf.Timestamp
+	53,  // 44: payment.v1.Posting.account:type_name -> payment.v1.LedgerAccount
+	5,   // 45: payment.v1.Posting.direction:type_name -> payment.v1.PostingDirection
+	131, // 46: payment.v1.Posting.amount:type_name -> money.v1.Money
+	71,  // 47: payment.v1.CreateInvestorResponse.investor:type_name -> payment.v1.Investor
+	71,  // 48: payment.v1.GetInvestorResponse.investor:type_name -> payment.v1.Investor
+	71,  // 49: payment.v1.ListInvestorsResponse.investors:type_name -> payment.v1.Investor
+	131, // 50: payment.v1.TopUpInvestorRequest.amount:type_name -> money.v1.Money
+	71,  // 51: payment.v1.TopUpInvestorResponse.investor:type_name -> payment.v1.Investor
+	71,  // 52: payment.v1.GrantInvestorAccessResponse.investor:type_name -> payment.v1.Investor
+	71,  // 53: payment.v1.RevokeInvestorAccessResponse.investor:type_name -> payment.v1.Investor
+	72,  // 54: payment.v1.ListInvestorMovementsResponse.movements:type_name -> payment.v1.InvestorMovement
+	131, // 55: payment.v1.Investor.available:type_name -> money.v1.Money
+	131, // 56: payment.v1.Investor.held:type_name -> money.v1.Money
+	131, // 57: payment.v1.Investor.spent:type_name -> money.v1.Money
+	132, // 58: payment.v1.Investor.created_at:type_name -> google.protobuf.Timestamp
+	132, // 59: payment.v1.Investor.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 60: payment.v1.InvestorMovement.kind:type_name -> payment.v1.InvestorMovementKind
+	131, // 61: payment.v1.InvestorMovement.amount:type_name -> money.v1.Money
+	132, // 62: payment.v1.InvestorMovement.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 63: payment.v1.ListFraudDecisionsRequest.outcome:type_name -> payment.v1.FraudOutcome
+	75,  // 64: payment.v1.ListFraudDecisionsResponse.decisions:type_name -> payment.v1.FraudDecision
+	18,  // 65: payment.v1.FraudDecision.payment_method:type_name -> payment.v1.PaymentMethod
+	131, // 66: payment.v1.FraudDecision.amount:type_name -> money.v1.Money
+	7,   // 67: payment.v1.FraudDecision.outcome:type_name -> payment.v1.FraudOutcome
+	8,   // 68: payment.v1.FraudDecision.reason:type_name -> payment.v1.FraudReason
+	132, // 69: payment.v1.FraudDecision.created_at:type_name -> google.protobuf.Timestamp
+	131, // 70: payment.v1.QuoteInstallmentPlanRequest.amount:type_name -> money.v1.Money
+	82,  // 71: payment.v1.QuoteInstallmentPlanResponse.quote:type_name -> payment.v1.InstallmentQuote
+	83,  // 72: payment.v1.GetInstallmentPlanResponse.plan:type_name -> payment.v1.InstallmentPlan
+	9,   // 73: payment.v1.ListInstallmentPlansRequest.statuses:type_name -> payment.v1.InstallmentPlanStatus
+	83,  // 74: payment.v1.ListInstallmentPlansResponse.plans:type_name -> payment.v1.InstallmentPlan
+	131, // 75: payment.v1.InstallmentQuote.principal:type_name -> money.v1.Money
+	84,  // 76: payment.v1.InstallmentQuote.installments:type_name -> payment.v1.Installment
+	131, // 77: payment.v1.InstallmentQuote.total_interest:type_name -> money.v1.Money
+	131, // 78: payment.v1.InstallmentQuote.total:type_name -> money.v1.Money
+	9,   // 79: payment.v1.InstallmentPlan.status:type_name -> payment.v1.InstallmentPlanStatus
+	82,  // 80: payment.v1.InstallmentPlan.schedule:type_name -> payment.v1.InstallmentQuote
+	132, // 81: payment.v1.InstallmentPlan.created_at:type_name -> google.protobuf.Timestamp
+	132, // 82: payment.v1.InstallmentPlan.updated_at:type_name -> google.protobuf.Timestamp
+	132, // 83: payment.v1.Installment.due_at:type_name -> google.protobuf.Timestamp
+	131, // 84: payment.v1.Installment.principal:type_name -> money.v1.Money
+	131, // 85: payment.v1.Installment.interest:type_name -> money.v1.Money
+	131, // 86: payment.v1.Installment.amount:type_name -> money.v1.Money
+	10,  // 87: payment.v1.Installment.status:type_name -> payment.v1.InstallmentStatus
+	132, // 88: payment.v1.Installment.paid_at:type_name -> google.protobuf.Timestamp
+	132, // 89: payment.v1.Installment.next_attempt_at:type_name -> google.protobuf.Timestamp
+	87,  // 90: payment.v1.SubscribePaymentEventsResponse.event:type_name -> payment.v1.PaymentEvent
+	11,  // 91: payment.v1.PaymentEvent.type:type_name -> payment.v1.PaymentEventType
+	18,  // 92: payment.v1.PaymentEvent.payment_method:type_name -> payment.v1.PaymentMethod
+	17,  // 93: payment.v1.PaymentEvent.transaction_status:type_name -> payment.v1.TransactionStatus
+	131, // 94: payment.v1.PaymentEvent.amount:type_name -> money.v1.Money
+	132, // 95: payment.v1.PaymentEvent.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 96: payment.v1.CreateWebhookSubscriptionRequest.event_types:type_name -> payment.v1.PaymentEventType
+	98,  // 97: payment.v1.CreateWebhookSubscriptionResponse.subscription:type_name -> payment.v1.WebhookSubscription
+	98,  // 98: payment.v1.ListWebhookSubscriptionsResponse.subscriptions:type_name -> payment.v1.WebhookSubscription
+	12,  // 99: payment.v1.ListWebhookDeliveriesRequest.statuses:type_name -> payment.v1.WebhookDeliveryStatus
+	99,  // 100: payment.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> payment.v1.WebhookDelivery
+	99,  // 101: payment.v1.ReplayWebhookDeliveriesResponse.deliveries:type_name -> payment.v1.WebhookDelivery
+	11,  // 102: payment.v1.WebhookSubscription.event_types:type_name -> payment.v1.PaymentEventType
+	132, // 103: payment.v1.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 104: payment.v1.WebhookDelivery.event_type:type_name -> payment.v1.PaymentEventType
+	12,  // 105: payment.v1.WebhookDelivery.status:type_name -> payment.v1.WebhookDeliveryStatus
+	132, // 106: payment.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	132, // 107: payment.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	132, // 108: payment.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	132, // 109: payment.v1.WebhookDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	87,  // 110: payment.v1.WebhookPayload.event:type_name -> payment.v1.PaymentEvent
+	109, // 111: payment.v1.SaveCardResponse.card:type_name -> payment.v1.PaymentCard
+	109, // 112: payment.v1.ListCardsResponse.cards:type_name -> payment.v1.PaymentCard
+	13,  // 113: payment.v1.PaymentCard.brand:type_name -> payment.v1.CardBrand
+	132, // 114: payment.v1.PaymentCard.created_at:type_name -> google.protobuf.Timestamp
+	131, // 115: payment.v1.LineItem.unit_price:type_name -> money.v1.Money
+	113, // 116: payment.v1.GetReceiptResponse.receipt:type_name -> payment.v1.Receipt
+	18,  // 117: payment.v1.Receipt.payment_method:type_name -> payment.v1.PaymentMethod
+	114, // 118: payment.v1.Receipt.items:type_name -> payment.v1.ReceiptItem
+	131, // 119: payment.v1.Receipt.total:type_name -> money.v1.Money
+	131, // 120: payment.v1.Receipt.tax_total:type_name -> money.v1.Money
+	132, // 121: payment.v1.Receipt.paid_at:type_name -> google.protobuf.Timestamp
+	132, // 122: payment.v1.Receipt.created_at:type_name -> google.protobuf.Timestamp
+	131, // 123: payment.v1.Receipt.credit_amount:type_name -> money.v1.Money
+	131, // 124: payment.v1.ReceiptItem.unit_price:type_name -> money.v1.Money
+	131, // 125: payment.v1.ReceiptItem.amount:type_name -> money.v1.Money
+	131, // 126: payment.v1.ReceiptItem.tax:type_name -> money.v1.Money
+	131, // 127: payment.v1.OpenDisputeRequest.amount:type_name -> money.v1.Money
+	14,  // 128: payment.v1.OpenDisputeRequest.reason:type_name -> payment.v1.DisputeReason
+	127, // 129: payment.v1.OpenDisputeResponse.dispute:type_name -> payment.v1.Dispute
+	127, // 130: payment.v1.GetDisputeResponse.dispute:type_name -> payment.v1.Dispute
+	15,  // 131: payment.v1.ListDisputesRequest.statuses:type_name -> payment.v1.DisputeStatus
+	127, // 132: payment.v1.ListDisputesResponse.disputes:type_name -> payment.v1.Dispute
+	128, // 133: payment.v1.SubmitDisputeEvidenceRequest.evidence:type_name -> payment.v1.DisputeEvidence
+	127, // 134: payment.v1.SubmitDisputeEvidenceResponse.dispute:type_name -> payment.v1.Dispute
+	127, // 135: payment.v1.AcceptDisputeResponse.dispute:type_name -> payment.v1.Dispute
+	16,  // 136: payment.v1.ResolveDisputeRequest.outcome:type_name -> payment.v1.DisputeOutcome
+	127, // 137: payment.v1.ResolveDisputeResponse.dispute:type_name -> payment.v1.Dispute
+	131, // 138: payment.v1.Dispute.amount:type_name -> money.v1.Money
+	14,  // 139: payment.v1.Dispute.reason:type_name -> payment.v1.DisputeReason
+	15,  // 140: payment.v1.Dispute.status:type_name -> payment.v1.DisputeStatus
+	132, // 141: payment.v1.Dispute.evidence_due_by:type_name -> google.protobuf.Timestamp
+	128, // 142: payment.v1.Dispute.evidence:type_name -> payment.v1.DisputeEvidence
+	132, // 143: payment.v1.Dispute.created_at:type_name -> google.protobuf.Timestamp
+	132, // 144: payment.v1.Dispute.updated_at:type_name -> google.protobuf.Timestamp
+	132, // 145: payment.v1.Dispute.resolved_at:type_name -> google.protobuf.Timestamp
+	132, // 146: payment.v1.DisputeEvidence.submitted_at:type_name -> google.protobuf.Timestamp
+	18,  // 147: payment.v1.TransactionsFilter.payment_methods:type_name -> payment.v1.PaymentMethod
+	17,  // 148: payment.v1.TransactionsFilter.statuses:type_name -> payment.v1.TransactionStatus
+	132, // 149: payment.v1.TransactionsFilter.created_from:type_name -> google.protobuf.Timestamp
+	132, // 150: payment.v1.TransactionsFilter.created_to:type_name -> google.protobuf.Timestamp
+	18,  // 151: payment.v1.Transaction.payment_method:type_name -> payment.v1.PaymentMethod
+	17,  // 152: payment.v1.Transaction.status:type_name -> payment.v1.TransactionStatus
+	132, // 153: payment.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	131, // 154: payment.v1.Transaction.amount:type_name -> money.v1.Money
+	131, // 155: payment.v1.Transaction.refunded_amount:type_name -> money.v1.Money
+	131, // 156: payment.v1.Transaction.authorized_amount:type_name -> money.v1.Money
+	132, // 157: payment.v1.Transaction.authorization_expires_at:type_name -> google.protobuf.Timestamp
+	132, // 158: payment.v1.Transaction.captured_at:type_name -> google.protobuf.Timestamp
+	131, // 159: payment.v1.Transaction.settlement_amount:type_name -> money.v1.Money
+	110, // 160: payment.v1.Transaction.line_items:type_name -> payment.v1.LineItem
+	131, // 161: payment.v1.Transaction.charged_back_amount:type_name -> money.v1.Money
+	131, // 162: payment.v1.Transaction.collected_amount:type_name -> money.v1.Money
+	20,  // 163: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	22,  // 164: payment.v1.PaymentService.AuthorizePayment:input_type -> payment.v1.AuthorizePaymentRequest
+	24,  // 165: payment.v1.PaymentService.CapturePayment:input_type -> payment.v1.CapturePaymentRequest
+	26,  // 166: payment.v1.PaymentService.VoidAuthorization:input_type -> payment.v1.VoidAuthorizationRequest
+	28,  // 167: payment.v1.PaymentService.CreateSbpPaymentIntent:input_type -> payment.v1.CreateSbpPaymentIntentRequest
+	30,  // 168: payment.v1.PaymentService.GetSbpPaymentIntent:input_type -> payment.v1.GetSbpPaymentIntentRequest
+	32,  // 169: payment.v1.PaymentService.ConfirmSbpPaymentIntent:input_type -> payment.v1.ConfirmSbpPaymentIntentRequest
+	35,  // 170: payment.v1.PaymentService.GetTransaction:input_type -> payment.v1.GetTransactionRequest
+	37,  // 171: payment.v1.PaymentService.ListTransactions:input_type -> payment.v1.ListTransactionsRequest
+	39,  // 172: payment.v1.PaymentService.RefundPayment:input_type -> payment.v1.RefundPaymentRequest
+	41,  // 173: payment.v1.PaymentService.GetRefund:input_type -> payment.v1.GetRefundRequest
+	43,  // 174: payment.v1.PaymentService.ListRefunds:input_type -> payment.v1.ListRefundsRequest
+	46,  // 175: payment.v1.PaymentService.ListAccountBalances:input_type -> payment.v1.ListAccountBalancesRequest
+	48,  // 176: payment.v1.PaymentService.ListJournalEntries:input_type -> payment.v1.ListJournalEntriesRequest
+	50,  // 177: payment.v1.PaymentService.CheckLedgerConsistency:input_type -> payment.v1.CheckLedgerConsistencyRequest
+	57,  // 178: payment.v1.PaymentService.CreateInvestor:input_type -> payment.v1.CreateInvestorRequest
+	59,  // 179: payment.v1.PaymentService.GetInvestor:input_type -> payment.v1.GetInvestorRequest
+	61,  // 180: payment.v1.PaymentService.ListInvestors:input_type -> payment.v1.ListInvestorsRequest
+	63,  // 181: payment.v1.PaymentService.TopUpInvestor:input_type -> payment.v1.TopUpInvestorRequest
+	65,  // 182: payment.v1.PaymentService.GrantInvestorAccess:input_type -> payment.v1.GrantInvestorAccessRequest
+	67,  // 183: payment.v1.PaymentService.RevokeInvestorAccess:input_type -> payment.v1.RevokeInvestorAccessRequest
+	69,  // 184: payment.v1.PaymentService.ListInvestorMovements:input_type -> payment.v1.ListInvestorMovementsRequest
+	73,  // 185: payment.v1.PaymentService.ListFraudDecisions:input_type -> payment.v1.ListFraudDecisionsRequest
+	76,  // 186: payment.v1.PaymentService.QuoteInstallmentPlan:input_type -> payment.v1.QuoteInstallmentPlanRequest
+	78,  // 187: payment.v1.PaymentService.GetInstallmentPlan:input_type -> payment.v1.GetInstallmentPlanRequest
+	80,  // 188: payment.v1.PaymentService.ListInstallmentPlans:input_type -> payment.v1.ListInstallmentPlansRequest
+	85,  // 189: payment.v1.PaymentService.SubscribePaymentEvents:input_type -> payment.v1.SubscribePaymentEventsRequest
+	88,  // 190: payment.v1.PaymentService.CreateWebhookSubscription:input_type -> payment.v1.CreateWebhookSubscriptionRequest
+	90,  // 191: payment.v1.PaymentService.ListWebhookSubscriptions:input_type -> payment.v1.ListWebhookSubscriptionsRequest
+	92,  // 192: payment.v1.PaymentService.DeleteWebhookSubscription:input_type -> payment.v1.DeleteWebhookSubscriptionRequest
+	94,  // 193: payment.v1.PaymentService.ListWebhookDeliveries:input_type -> payment.v1.ListWebhookDeliveriesRequest
+	96,  // 194: payment.v1.PaymentService.ReplayWebhookDeliveries:input_type -> payment.v1.ReplayWebhookDeliveriesRequest
+	101, // 195: payment.v1.PaymentService.SaveCard:input_type -> payment.v1.SaveCardRequest
+	103, // 196: payment.v1.PaymentService.ListCards:input_type -> payment.v1.ListCardsRequest
+	105, // 197: payment.v1.PaymentService.DeleteCard:input_type -> payment.v1.DeleteCardRequest
+	107, // 198: payment.v1.PaymentService.ReencryptCards:input_type -> payment.v1.ReencryptCardsRequest
+	111, // 199: payment.v1.PaymentService.GetReceipt:input_type -> payment.v1.GetReceiptRequest
+	115, // 200: payment.v1.PaymentService.OpenDispute:input_type -> payment.v1.OpenDisputeRequest
+	117, // 201: payment.v1.PaymentService.GetDispute:input_type -> payment.v1.GetDisputeRequest
+	119, // 202: payment.v1.PaymentService.ListDisputes:input_type -> payment.v1.ListDisputesRequest
+	121, // 203: payment.v1.PaymentService.SubmitDisputeEvidence:input_type -> payment.v1.SubmitDisputeEvidenceRequest
+	123, // 204: payment.v1.PaymentService.AcceptDispute:input_type -> payment.v1.AcceptDisputeRequest
+	125, // 205: payment.v1.PaymentService.ResolveDispute:input_type -> payment.v1.ResolveDisputeRequest
+	21,  // 206: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	23,  // 207: payment.v1.PaymentService.AuthorizePayment:output_type -> payment.v1.AuthorizePaymentResponse
+	25,  // 208: payment.v1.PaymentService.CapturePayment:output_type -> payment.v1.CapturePaymentResponse
+	27,  // 209: payment.v1.PaymentService.VoidAuthorization:output_type -> payment.v1.VoidAuthorizationResponse
+	29,  // 210: payment.v1.PaymentService.CreateSbpPaymentIntent:output_type -> payment.v1.CreateSbpPaymentIntentResponse
+	31,  // 211: payment.v1.PaymentService.GetSbpPaymentIntent:output_type -> payment.v1.GetSbpPaymentIntentResponse
+	33,  // 212: payment.v1.PaymentService.ConfirmSbpPaymentIntent:output_type -> payment.v1.ConfirmSbpPaymentIntentResponse
+	36,  // 213: payment.v1.PaymentService.GetTransaction:output_type -> payment.v1.GetTransactionResponse
+	38,  // 214: payment.v1.PaymentService.ListTransactions:output_type -> payment.v1.ListTransactionsResponse
+	40,  // 215: payment.v1.PaymentService.RefundPayment:output_type -> payment.v1.RefundPaymentResponse
+	42,  // 216: payment.v1.PaymentService.GetRefund:output_type -> payment.v1.GetRefundResponse
+	44,  // 217: payment.v1.PaymentService.ListRefunds:output_type -> payment.v1.ListRefundsResponse
+	47,  // 218: payment.v1.PaymentService.ListAccountBalances:output_type -> payment.v1.ListAccountBalancesResponse
+	49,  // 219: payment.v1.PaymentService.ListJournalEntries:output_type -> payment.v1.ListJournalEntriesResponse
+	51,  // 220: payment.v1.PaymentService.CheckLedgerConsistency:output_type -> payment.v1.CheckLedgerConsistencyResponse
+	58,  // 221: payment.v1.PaymentService.CreateInvestor:output_type -> payment.v1.CreateInvestorResponse
+	60,  // 222: payment.v1.PaymentService.GetInvestor:output_type -> payment.v1.GetInvestorResponse
+	62,  // 223: payment.v1.PaymentService.ListInvestors:output_type -> payment.v1.ListInvestorsResponse
+	64,  // 224: payment.v1.PaymentService.TopUpInvestor:output_type -> payment.v1.TopUpInvestorResponse
+	66,  // 225: payment.v1.PaymentService.GrantInvestorAccess:output_type -> payment.v1.GrantInvestorAccessResponse
+	68,  // 226: payment.v1.PaymentService.RevokeInvestorAccess:output_type -> payment.v1.RevokeInvestorAccessResponse
+	70,  // 227: payment.v1.PaymentService.ListInvestorMovements:output_type -> payment.v1.ListInvestorMovementsResponse
+	74,  // 228: payment.v1.PaymentService.ListFraudDecisions:output_type -> payment.v1.ListFraudDecisionsResponse
+	77,  // 229: payment.v1.PaymentService.QuoteInstallmentPlan:output_type -> payment.v1.QuoteInstallmentPlanResponse
+	79,  // 230: payment.v1.PaymentService.GetInstallmentPlan:output_type -> payment.v1.GetInstallmentPlanResponse
+	81,  // 231: payment.v1.PaymentService.ListInstallmentPlans:output_type -> payment.v1.ListInstallmentPlansResponse
+	86,  // 232: payment.v1.PaymentService.SubscribePaymentEvents:output_type -> payment.v1.SubscribePaymentEventsResponse
+	89,  // 233: payment.v1.PaymentService.CreateWebhookSubscription:output_type -> payment.v1.CreateWebhookSubscriptionResponse
+	91,  // 234: payment.v1.PaymentService.ListWebhookSubscriptions:output_type -> payment.v1.ListWebhookSubscriptionsResponse
+	93,  // 235: payment.v1.PaymentService.DeleteWebhookSubscription:output_type -> payment.v1.DeleteWebhookSubscriptionResponse
+	95,  // 236: payment.v1.PaymentService.ListWebhookDeliveries:output_type -> payment.v1.ListWebhookDeliveriesResponse
+	97,  // 237: payment.v1.PaymentService.ReplayWebhookDeliveries:output_type -> payment.v1.ReplayWebhookDeliveriesResponse
+	102, // 238: payment.v1.PaymentService.SaveCard:output_type -> payment.v1.SaveCardResponse
+	104, // 239: payment.v1.PaymentService.ListCards:output_type -> payment.v1.ListCardsResponse
+	106, // 240: payment.v1.PaymentService.DeleteCard:output_type -> payment.v1.DeleteCardResponse
+	108, // 241: payment.v1.PaymentService.ReencryptCards:output_type -> payment.v1.ReencryptCardsResponse
+	112, // 242: payment.v1.PaymentService.GetReceipt:output_type -> payment.v1.GetReceiptResponse
+	116, // 243: payment.v1.PaymentService.OpenDispute:output_type -> payment.v1.OpenDisputeResponse
+	118, // 244: payment.v1.PaymentService.GetDispute:output_type -> payment.v1.GetDisputeResponse
+	120, // 245: payment.v1.PaymentService.ListDisputes:output_type -> payment.v1.ListDisputesResponse
+	122, // 246: payment.v1.PaymentService.SubmitDisputeEvidence:output_type -> payment.v1.SubmitDisputeEvidenceResponse
+	124, // 247: payment.v1.PaymentService.AcceptDispute:output_type -> payment.v1.AcceptDisputeResponse
+	126, // 248: payment.v1.PaymentService.ResolveDispute:output_type -> payment.v1.ResolveDisputeResponse
+	206, // [206:249] is the sub-list for method output_type
+	163, // [163:206] is the sub-list for method input_type
+	163, // [163:163] is the sub-list for extension type_name
+	163, // [163:163] is the sub-list for extension extendee
+	0,   // [0:163] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -245,8 +9255,8 @@ func file_payment_v1_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      20,
+			NumMessages:   111,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_PayOrder_FullMethodName = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_PayOrder_FullMethodName                  = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_AuthorizePayment_FullMethodName          = "/payment.v1.PaymentService/AuthorizePayment"
+	PaymentService_CapturePayment_FullMethodName            = "/payment.v1.PaymentService/CapturePayment"
+	PaymentService_VoidAuthorization_FullMethodName         = "/payment.v1.PaymentService/VoidAuthorization"
+	PaymentService_CreateSbpPaymentIntent_FullMethodName    = "/payment.v1.PaymentService/CreateSbpPaymentIntent"
+	PaymentService_GetSbpPaymentIntent_FullMethodName       = "/payment.v1.PaymentService/GetSbpPaymentIntent"
+	PaymentService_ConfirmSbpPaymentIntent_FullMethodName   = "/payment.v1.PaymentService/ConfirmSbpPaymentIntent"
+	PaymentService_GetTransaction_FullMethodName            = "/payment.v1.PaymentService/GetTransaction"
+	PaymentService_ListTransactions_FullMethodName          = "/payment.v1.PaymentService/ListTransactions"
+	PaymentService_RefundPayment_FullMethodName             = "/payment.v1.PaymentService/RefundPayment"
+	PaymentService_GetRefund_FullMethodName                 = "/payment.v1.PaymentService/GetRefund"
+	PaymentService_ListRefunds_FullMethodName               = "/payment.v1.PaymentService/ListRefunds"
+	PaymentService_ListAccountBalances_FullMethodName       = "/payment.v1.PaymentService/ListAccountBalances"
+	PaymentService_ListJournalEntries_FullMethodName        = "/payment.v1.PaymentService/ListJournalEntries"
+	PaymentService_CheckLedgerConsistency_FullMethodName    = "/payment.v1.PaymentService/CheckLedgerConsistency"
+	PaymentService_CreateInvestor_FullMethodName            = "/payment.v1.PaymentService/CreateInvestor"
+	PaymentService_GetInvestor_FullMethodName               = "/payment.v1.PaymentService/GetInvestor"
+	PaymentService_ListInvestors_FullMethodName             = "/payment.v1.PaymentService/ListInvestors"
+	PaymentService_TopUpInvestor_FullMethodName             = "/payment.v1.PaymentService/TopUpInvestor"
+	PaymentService_GrantInvestorAccess_FullMethodName       = "/payment.v1.PaymentService/GrantInvestorAccess"
+	PaymentService_RevokeInvestorAccess_FullMethodName      = "/payment.v1.PaymentService/RevokeInvestorAccess"
+	PaymentService_ListInvestorMovements_FullMethodName     = "/payment.v1.PaymentService/ListInvestorMovements"
+	PaymentService_ListFraudDecisions_FullMethodName        = "/payment.v1.PaymentService/ListFraudDecisions"
+	PaymentService_QuoteInstallmentPlan_FullMethodName      = "/payment.v1.PaymentService/QuoteInstallmentPlan"
+	PaymentService_GetInstallmentPlan_FullMethodName        = "/payment.v1.PaymentService/GetInstallmentPlan"
+	PaymentService_ListInstallmentPlans_FullMethodName      = "/payment.v1.PaymentService/ListInstallmentPlans"
+	PaymentService_SubscribePaymentEvents_FullMethodName    = "/payment.v1.PaymentService/SubscribePaymentEvents"
+	PaymentService_CreateWebhookSubscription_FullMethodName = "/payment.v1.PaymentService/CreateWebhookSubscription"
+	PaymentService_ListWebhookSubscriptions_FullMethodName  = "/payment.v1.PaymentService/ListWebhookSubscriptions"
+	PaymentService_DeleteWebhookSubscription_FullMethodName = "/payment.v1.PaymentService/DeleteWebhookSubscription"
+	PaymentService_ListWebhookDeliveries_FullMethodName     = "/payment.v1.PaymentService/ListWebhookDeliveries"
+	PaymentService_ReplayWebhookDeliveries_FullMethodName   = "/payment.v1.PaymentService/ReplayWebhookDeliveries"
+	PaymentService_SaveCard_FullMethodName                  = "/payment.v1.PaymentService/SaveCard"
+	PaymentService_ListCards_FullMethodName                 = "/payment.v1.PaymentService/ListCards"
+	PaymentService_DeleteCard_FullMethodName                = "/payment.v1.PaymentService/DeleteCard"
+	PaymentService_ReencryptCards_FullMethodName            = "/payment.v1.PaymentService/ReencryptCards"
+	PaymentService_GetReceipt_FullMethodName                = "/payment.v1.PaymentService/GetReceipt"
+	PaymentService_OpenDispute_FullMethodName               = "/payment.v1.PaymentService/OpenDispute"
+	PaymentService_GetDispute_FullMethodName                = "/payment.v1.PaymentService/GetDispute"
+	PaymentService_ListDisputes_FullMethodName              = "/payment.v1.PaymentService/ListDisputes"
+	PaymentService_SubmitDisputeEvidence_FullMethodName     = "/payment.v1.PaymentService/SubmitDisputeEvidence"
+	PaymentService_AcceptDispute_FullMethodName             = "/payment.v1.PaymentService/AcceptDispute"
+	PaymentService_ResolveDispute_FullMethodName            = "/payment.v1.PaymentService/ResolveDispute"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -28,7 +70,119 @@ const (
 //
 // Payment is a service for payment order.
 type PaymentServiceClient interface {
+	// PayOrder pays an order in one step: it authorizes and immediately captures the amount.
+	// PAYMENT_METHOD_SBP is the exception: the response carries an SBP QR code, and the
+	// transaction stays pending until the customer pays it and the intent is confirmed.
+	// Requests with an idempotency key are charged once: a replay with the same payload
+	// returns the original transaction. A key whose transaction was voided, expired or declined
+	// is free again, so the order can be paid anew, also by another payment method.
 	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	// AuthorizePayment holds the amount until it is captured, voided or the authorization expires.
+	// Idempotency keys work the same way as in PayOrder. PAYMENT_METHOD_SBP cannot be held and is
+	// rejected with INVALID_ARGUMENT: create an SBP payment with CreateSbpPaymentIntent instead.
+	AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error)
+	// CapturePayment charges the whole or a part of an authorized amount.
+	// Capturing an already captured transaction with the same amount returns it unchanged.
+	CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error)
+	// VoidAuthorization releases an authorized amount without charging it.
+	VoidAuthorization(ctx context.Context, in *VoidAuthorizationRequest, opts ...grpc.CallOption) (*VoidAuthorizationResponse, error)
+	// CreateSbpPaymentIntent creates a pending SBP payment and returns the payload of its QR code
+	// in the NSPK format together with the rendered image. Idempotency keys work as in PayOrder.
+	CreateSbpPaymentIntent(ctx context.Context, in *CreateSbpPaymentIntentRequest, opts ...grpc.CallOption) (*CreateSbpPaymentIntentResponse, error)
+	// GetSbpPaymentIntent returns an SBP payment with its QR code.
+	GetSbpPaymentIntent(ctx context.Context, in *GetSbpPaymentIntentRequest, opts ...grpc.CallOption) (*GetSbpPaymentIntentResponse, error)
+	// ConfirmSbpPaymentIntent simulates the customer scanning the QR code and paying:
+	// the amount is authorized and captured. Confirming a paid intent returns it unchanged.
+	ConfirmSbpPaymentIntent(ctx context.Context, in *ConfirmSbpPaymentIntentRequest, opts ...grpc.CallOption) (*ConfirmSbpPaymentIntentResponse, error)
+	// GetTransaction returns a transaction by its UUID.
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	// ListTransactions returns transactions with optional filtering.
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// RefundPayment returns a part or the rest of the captured amount of a transaction.
+	// While the transaction has an open dispute it fails with ERROR_REASON_DISPUTE_ALREADY_OPEN.
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	// GetRefund returns a refund by its UUID.
+	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*GetRefundResponse, error)
+	// ListRefunds returns refunds of a transaction ordered by creation time.
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
+	// ListAccountBalances returns turnovers and balances of ledger accounts, one row per account and currency.
+	ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest, opts ...grpc.CallOption) (*ListAccountBalancesResponse, error)
+	// ListJournalEntries returns ledger journal entries in the order they were posted.
+	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+	// CheckLedgerConsistency verifies that every journal entry balances, the trial balance
+	// is zero and the journal agrees with captured, refunded and charged back amounts of transactions.
+	CheckLedgerConsistency(ctx context.Context, in *CheckLedgerConsistencyRequest, opts ...grpc.CallOption) (*CheckLedgerConsistencyResponse, error)
+	// CreateInvestor creates an investor with zero balances in the given currency.
+	CreateInvestor(ctx context.Context, in *CreateInvestorRequest, opts ...grpc.CallOption) (*CreateInvestorResponse, error)
+	// GetInvestor returns an investor with its balances by UUID.
+	GetInvestor(ctx context.Context, in *GetInvestorRequest, opts ...grpc.CallOption) (*GetInvestorResponse, error)
+	// ListInvestors returns investors ordered by creation time.
+	ListInvestors(ctx context.Context, in *ListInvestorsRequest, opts ...grpc.CallOption) (*ListInvestorsResponse, error)
+	// TopUpInvestor adds money to the available balance of an investor.
+	TopUpInvestor(ctx context.Context, in *TopUpInvestorRequest, opts ...grpc.CallOption) (*TopUpInvestorResponse, error)
+	// GrantInvestorAccess allows a user to pay orders with the money of an investor.
+	GrantInvestorAccess(ctx context.Context, in *GrantInvestorAccessRequest, opts ...grpc.CallOption) (*GrantInvestorAccessResponse, error)
+	// RevokeInvestorAccess forbids a user to pay new orders with the money of an investor.
+	// Amounts already held for the user stay in force.
+	RevokeInvestorAccess(ctx context.Context, in *RevokeInvestorAccessRequest, opts ...grpc.CallOption) (*RevokeInvestorAccessResponse, error)
+	// ListInvestorMovements returns top-ups, holds, captures, releases and refunds of an investor
+	// in the order they were made.
+	ListInvestorMovements(ctx context.Context, in *ListInvestorMovementsRequest, opts ...grpc.CallOption) (*ListInvestorMovementsResponse, error)
+	// ListFraudDecisions returns fraud screening decisions in the order they were made.
+	// Every payment attempt is screened before it reaches the payment provider; a blocked
+	// attempt fails with PERMISSION_DENIED and ERROR_REASON_PAYMENT_BLOCKED.
+	ListFraudDecisions(ctx context.Context, in *ListFraudDecisionsRequest, opts ...grpc.CallOption) (*ListFraudDecisionsResponse, error)
+	// QuoteInstallmentPlan calculates an installment schedule for an amount without creating a plan.
+	QuoteInstallmentPlan(ctx context.Context, in *QuoteInstallmentPlanRequest, opts ...grpc.CallOption) (*QuoteInstallmentPlanResponse, error)
+	// GetInstallmentPlan returns an installment plan with the status of every installment.
+	GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*GetInstallmentPlanResponse, error)
+	// ListInstallmentPlans returns installment plans ordered by creation time.
+	ListInstallmentPlans(ctx context.Context, in *ListInstallmentPlansRequest, opts ...grpc.CallOption) (*ListInstallmentPlansResponse, error)
+	// SubscribePaymentEvents streams payment events in sequence order: first the stored events
+	// after after_sequence, then new events as they happen. The stream never ends by itself;
+	// after a reconnect a consumer resumes by passing the sequence of the last processed event.
+	SubscribePaymentEvents(ctx context.Context, in *SubscribePaymentEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribePaymentEventsResponse], error)
+	// CreateWebhookSubscription registers an HTTP endpoint for payment events. Every event is
+	// POSTed as a JSON WebhookPayload with the X-Spaceyard-Signature header
+	// "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>" keyed by the secret>".
+	// Failed deliveries are retried with exponential backoff and then moved to the dead letters.
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	// ListWebhookSubscriptions returns webhook subscriptions without their secrets.
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	// DeleteWebhookSubscription stops deliveries to an endpoint; its pending deliveries become dead.
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	// ListWebhookDeliveries returns deliveries in event sequence order.
+	// Deliveries in WEBHOOK_DELIVERY_STATUS_DEAD form the dead-letter list.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// ReplayWebhookDeliveries queues dead deliveries of a subscription for a new round of attempts.
+	ReplayWebhookDeliveries(ctx context.Context, in *ReplayWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ReplayWebhookDeliveriesResponse, error)
+	// SaveCard stores a card in the vault and returns a payment token to pay with it by
+	// PAYMENT_METHOD_CARD. The card number is encrypted at rest and is never returned.
+	SaveCard(ctx context.Context, in *SaveCardRequest, opts ...grpc.CallOption) (*SaveCardResponse, error)
+	// ListCards returns saved cards of a user with masked numbers.
+	ListCards(ctx context.Context, in *ListCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error)
+	// DeleteCard removes a saved card; its payment token can no longer be used.
+	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error)
+	// ReencryptCards encrypts saved cards with the active key of the keyring, so that
+	// retired keys can be removed from the keyring after a rotation.
+	ReencryptCards(ctx context.Context, in *ReencryptCardsRequest, opts ...grpc.CallOption) (*ReencryptCardsResponse, error)
+	// GetReceipt returns the receipt of a paid transaction, rendered as HTML and plain text.
+	// A receipt is issued when the amount is captured.
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
+	// OpenDispute records a chargeback raised by the card issuer against a captured card
+	// transaction. The disputed amount cannot be refunded until the dispute is resolved.
+	OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*OpenDisputeResponse, error)
+	// GetDispute returns a dispute by its UUID.
+	GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*GetDisputeResponse, error)
+	// ListDisputes returns disputes ordered by creation time with optional filtering.
+	ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*ListDisputesResponse, error)
+	// SubmitDisputeEvidence contests a dispute with evidence before its deadline.
+	SubmitDisputeEvidence(ctx context.Context, in *SubmitDisputeEvidenceRequest, opts ...grpc.CallOption) (*SubmitDisputeEvidenceResponse, error)
+	// AcceptDispute concedes a dispute without contesting it; the dispute is lost.
+	AcceptDispute(ctx context.Context, in *AcceptDisputeRequest, opts ...grpc.CallOption) (*AcceptDisputeResponse, error)
+	// ResolveDispute records the issuer decision. A lost dispute returns the disputed
+	// amount to the customer and posts a chargeback entry to the ledger.
+	ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*ResolveDisputeResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -49,61 +203,1477 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 	return out, nil
 }
 
+func (c *paymentServiceClient) AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*AuthorizePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_AuthorizePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*CapturePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapturePaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CapturePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) VoidAuthorization(ctx context.Context, in *VoidAuthorizationRequest, opts ...grpc.CallOption) (*VoidAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidAuthorizationResponse)
+	err := c.cc.Invoke(ctx, PaymentService_VoidAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateSbpPaymentIntent(ctx context.Context, in *CreateSbpPaymentIntentRequest, opts ...grpc.CallOption) (*CreateSbpPaymentIntentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSbpPaymentIntentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreateSbpPaymentIntent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetSbpPaymentIntent(ctx context.Context, in *GetSbpPaymentIntentRequest, opts ...grpc.CallOption) (*GetSbpPaymentIntentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSbpPaymentIntentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetSbpPaymentIntent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ConfirmSbpPaymentIntent(ctx context.Context, in *ConfirmSbpPaymentIntentRequest, opts ...grpc.CallOption) (*ConfirmSbpPaymentIntentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmSbpPaymentIntentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ConfirmSbpPaymentIntent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*GetRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest, opts ...grpc.CallOption) (*ListAccountBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListAccountBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CheckLedgerConsistency(ctx context.Context, in *CheckLedgerConsistencyRequest, opts ...grpc.CallOption) (*CheckLedgerConsistencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckLedgerConsistencyResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CheckLedgerConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CreateInvestor(ctx context.Context, in *CreateInvestorRequest, opts ...grpc.CallOption) (*CreateInvestorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInvestorResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreateInvestor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInvestor(ctx context.Context, in *GetInvestorRequest, opts ...grpc.CallOption) (*GetInvestorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvestorResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetInvestor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListInvestors(ctx context.Context, in *ListInvestorsRequest, opts ...grpc.CallOption) (*ListInvestorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvestorsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListInvestors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) TopUpInvestor(ctx context.Context, in *TopUpInvestorRequest, opts ...grpc.CallOption) (*TopUpInvestorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpInvestorResponse)
+	err := c.cc.Invoke(ctx, PaymentService_TopUpInvestor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GrantInvestorAccess(ctx context.Context, in *GrantInvestorAccessRequest, opts ...grpc.CallOption) (*GrantInvestorAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantInvestorAccessResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GrantInvestorAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RevokeInvestorAccess(ctx context.Context, in *RevokeInvestorAccessRequest, opts ...grpc.CallOption) (*RevokeInvestorAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInvestorAccessResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RevokeInvestorAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListInvestorMovements(ctx context.Context, in *ListInvestorMovementsRequest, opts ...grpc.CallOption) (*ListInvestorMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvestorMovementsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListInvestorMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListFraudDecisions(ctx context.Context, in *ListFraudDecisionsRequest, opts ...grpc.CallOption) (*ListFraudDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudDecisionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListFraudDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) QuoteInstallmentPlan(ctx context.Context, in *QuoteInstallmentPlanRequest, opts ...grpc.CallOption) (*QuoteInstallmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteInstallmentPlanResponse)
+	err := c.cc.Invoke(ctx, PaymentService_QuoteInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*GetInstallmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstallmentPlanResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListInstallmentPlans(ctx context.Context, in *ListInstallmentPlansRequest, opts ...grpc.CallOption) (*ListInstallmentPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstallmentPlansResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListInstallmentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SubscribePaymentEvents(ctx context.Context, in *SubscribePaymentEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribePaymentEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PaymentService_ServiceDesc.Streams[0], PaymentService_SubscribePaymentEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribePaymentEventsRequest, SubscribePaymentEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaymentService_SubscribePaymentEventsClient = grpc.ServerStreamingClient[SubscribePaymentEventsResponse]
+
+func (c *paymentServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, PaymentService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReplayWebhookDeliveries(ctx context.Context, in *ReplayWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ReplayWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ReplayWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SaveCard(ctx context.Context, in *SaveCardRequest, opts ...grpc.CallOption) (*SaveCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveCardResponse)
+	err := c.cc.Invoke(ctx, PaymentService_SaveCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListCards(ctx context.Context, in *ListCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCardsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCardResponse)
+	err := c.cc.Invoke(ctx, PaymentService_DeleteCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReencryptCards(ctx context.Context, in *ReencryptCardsRequest, opts ...grpc.CallOption) (*ReencryptCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReencryptCardsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ReencryptCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*OpenDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenDisputeResponse)
+	err := c.cc.Invoke(ctx, PaymentService_OpenDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*GetDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDisputeResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*ListDisputesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDisputesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListDisputes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SubmitDisputeEvidence(ctx context.Context, in *SubmitDisputeEvidenceRequest, opts ...grpc.CallOption) (*SubmitDisputeEvidenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitDisputeEvidenceResponse)
+	err := c.cc.Invoke(ctx, PaymentService_SubmitDisputeEvidence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) AcceptDispute(ctx context.Context, in *AcceptDisputeRequest, opts ...grpc.CallOption) (*AcceptDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptDisputeResponse)
+	err := c.cc.Invoke(ctx, PaymentService_AcceptDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*ResolveDisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveDisputeResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ResolveDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 //
 // Payment is a service for payment order.
 type PaymentServiceServer interface {
+	// PayOrder pays an order in one step: it authorizes and immediately captures the amount.
+	// PAYMENT_METHOD_SBP is the exception: the response carries an SBP QR code, and the
+	// transaction stays pending until the customer pays it and the intent is confirmed.
+	// Requests with an idempotency key are charged once: a replay with the same payload
+	// returns the original transaction. A key whose transaction was voided, expired or declined
+	// is free again, so the order can be paid anew, also by another payment method.
 	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	// AuthorizePayment holds the amount until it is captured, voided or the authorization expires.
+	// Idempotency keys work the same way as in PayOrder. PAYMENT_METHOD_SBP cannot be held and is
+	// rejected with INVALID_ARGUMENT: create an SBP payment with CreateSbpPaymentIntent instead.
+	AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error)
+	// CapturePayment charges the whole or a part of an authorized amount.
+	// Capturing an already captured transaction with the same amount returns it unchanged.
+	CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error)
+	// VoidAuthorization releases an authorized amount without charging it.
+	VoidAuthorization(context.Context, *VoidAuthorizationRequest) (*VoidAuthorizationResponse, error)
+	// CreateSbpPaymentIntent creates a pending SBP payment and returns the payload of its QR code
+	// in the NSPK format together with the rendered image. Idempotency keys work as in PayOrder.
+	CreateSbpPaymentIntent(context.Context, *CreateSbpPaymentIntentRequest) (*CreateSbpPaymentIntentResponse, error)
+	// GetSbpPaymentIntent returns an SBP payment with its QR code.
+	GetSbpPaymentIntent(context.Context, *GetSbpPaymentIntentRequest) (*GetSbpPaymentIntentResponse, error)
+	// ConfirmSbpPaymentIntent simulates the customer scanning the QR code and paying:
+	// the amount is authorized and captured. Confirming a paid intent returns it unchanged.
+	ConfirmSbpPaymentIntent(context.Context, *ConfirmSbpPaymentIntentRequest) (*ConfirmSbpPaymentIntentResponse, error)
+	// GetTransaction returns a transaction by its UUID.
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	// ListTransactions returns transactions with optional filtering.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// RefundPayment returns a part or the rest of the captured amount of a transaction.
+	// While the transaction has an open dispute it fails with ERROR_REASON_DISPUTE_ALREADY_OPEN.
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	// GetRefund returns a refund by its UUID.
+	GetRefund(context.Context, *GetRefundRequest) (*GetRefundResponse, error)
+	// ListRefunds returns refunds of a transaction ordered by creation time.
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
+	// ListAccountBalances returns turnovers and balances of ledger accounts, one row per account and currency.
+	ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error)
+	// ListJournalEntries returns ledger journal entries in the order they were posted.
+	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	// CheckLedgerConsistency verifies that every journal entry balances, the trial balance
+	// is zero and the journal agrees with captured, refunded and charged back amounts of transactions.
+	CheckLedgerConsistency(context.Context, *CheckLedgerConsistencyRequest) (*CheckLedgerConsistencyResponse, error)
+	// CreateInvestor creates an investor with zero balances in the given currency.
+	CreateInvestor(context.Context, *CreateInvestorRequest) (*CreateInvestorResponse, error)
+	// GetInvestor returns an investor with its balances by UUID.
+	GetInvestor(context.Context, *GetInvestorRequest) (*GetInvestorResponse, error)
+	// ListInvestors returns investors ordered by creation time.
+	ListInvestors(context.Context, *ListInvestorsRequest) (*ListInvestorsResponse, error)
+	// TopUpInvestor adds money to the available balance of an investor.
+	TopUpInvestor(context.Context, *TopUpInvestorRequest) (*TopUpInvestorResponse, error)
+	// GrantInvestorAccess allows a user to pay orders with the money of an investor.
+	GrantInvestorAccess(context.Context, *GrantInvestorAccessRequest) (*GrantInvestorAccessResponse, error)
+	// RevokeInvestorAccess forbids a user to pay new orders with the money of an investor.
+	// Amounts already held for the user stay in force.
+	RevokeInvestorAccess(context.Context, *RevokeInvestorAccessRequest) (*RevokeInvestorAccessResponse, error)
+	// ListInvestorMovements returns top-ups, holds, captures, releases and refunds of an investor
+	// in the order they were made.
+	ListInvestorMovements(context.Context, *ListInvestorMovementsRequest) (*ListInvestorMovementsResponse, error)
+	// ListFraudDecisions returns fraud screening decisions in the order they were made.
+	// Every payment attempt is screened before it reaches the payment provider; a blocked
+	// attempt fails with PERMISSION_DENIED and ERROR_REASON_PAYMENT_BLOCKED.
+	ListFraudDecisions(context.Context, *ListFraudDecisionsRequest) (*ListFraudDecisionsResponse, error)
+	// QuoteInstallmentPlan calculates an installment schedule for an amount without creating a plan.
+	QuoteInstallmentPlan(context.Context, *QuoteInstallmentPlanRequest) (*QuoteInstallmentPlanResponse, error)
+	// GetInstallmentPlan returns an installment plan with the status of every installment.
+	GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*GetInstallmentPlanResponse, error)
+	// ListInstallmentPlans returns installment plans ordered by creation time.
+	ListInstallmentPlans(context.Context, *ListInstallmentPlansRequest) (*ListInstallmentPlansResponse, error)
+	// SubscribePaymentEvents streams payment events in sequence order: first the stored events
+	// after after_sequence, then new events as they happen. The stream never ends by itself;
+	// after a reconnect a consumer resumes by passing the sequence of the last processed event.
+	SubscribePaymentEvents(*SubscribePaymentEventsRequest, grpc.ServerStreamingServer[SubscribePaymentEventsResponse]) error
+	// CreateWebhookSubscription registers an HTTP endpoint for payment events. Every event is
+	// POSTed as a JSON WebhookPayload with the X-Spaceyard-Signature header
+	// "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>" keyed by the secret>".
+	// Failed deliveries are retried with exponential backoff and then moved to the dead letters.
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	// ListWebhookSubscriptions returns webhook subscriptions without their secrets.
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	// DeleteWebhookSubscription stops deliveries to an endpoint; its pending deliveries become dead.
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	// ListWebhookDeliveries returns deliveries in event sequence order.
+	// Deliveries in WEBHOOK_DELIVERY_STATUS_DEAD form the dead-letter list.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// ReplayWebhookDeliveries queues dead deliveries of a subscription for a new round of attempts.
+	ReplayWebhookDeliveries(context.Context, *ReplayWebhookDeliveriesRequest) (*ReplayWebhookDeliveriesResponse, error)
+	// SaveCard stores a card in the vault and returns a payment token to pay with it by
+	// PAYMENT_METHOD_CARD. The card number is encrypted at rest and is never returned.
+	SaveCard(context.Context, *SaveCardRequest) (*SaveCardResponse, error)
+	// ListCards returns saved cards of a user with masked numbers.
+	ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error)
+	// DeleteCard removes a saved card; its payment token can no longer be used.
+	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
+	// ReencryptCards encrypts saved cards with the active key of the keyring, so that
+	// retired keys can be removed from the keyring after a rotation.
+	ReencryptCards(context.Context, *ReencryptCardsRequest) (*ReencryptCardsResponse, error)
+	// GetReceipt returns the receipt of a paid transaction, rendered as HTML and plain text.
+	// A receipt is issued when the amount is captured.
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
+	// OpenDispute records a chargeback raised by the card issuer against a captured card
+	// transaction. The disputed amount cannot be refunded until the dispute is resolved.
+	OpenDispute(context.Context, *OpenDisputeRequest) (*OpenDisputeResponse, error)
+	// GetDispute returns a dispute by its UUID.
+	GetDispute(context.Context, *GetDisputeRequest) (*GetDisputeResponse, error)
+	// ListDisputes returns disputes ordered by creation time with optional filtering.
+	ListDisputes(context.Context, *ListDisputesRequest) (*ListDisputesResponse, error)
+	// SubmitDisputeEvidence contests a dispute with evidence before its deadline.
+	SubmitDisputeEvidence(context.Context, *SubmitDisputeEvidenceRequest) (*SubmitDisputeEvidenceResponse, error)
+	// AcceptDispute concedes a dispute without contesting it; the dispute is lost.
+	AcceptDispute(context.Context, *AcceptDisputeRequest) (*AcceptDisputeResponse, error)
+	// ResolveDispute records the issuer decision. A lost dispute returns the disputed
+	// amount to the customer and posts a chargeback entry to the ledger.
+	ResolveDispute(context.Context, *ResolveDisputeRequest) (*ResolveDisputeResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
-// UnimplementedPaymentServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPaymentServiceServer struct{}
+// UnimplementedPaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentServiceServer struct{}
+
+func (UnimplementedPaymentServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+}
+func (UnimplementedPaymentServiceServer) AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*AuthorizePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) CapturePayment(context.Context, *CapturePaymentRequest) (*CapturePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapturePayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) VoidAuthorization(context.Context, *VoidAuthorizationRequest) (*VoidAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidAuthorization not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateSbpPaymentIntent(context.Context, *CreateSbpPaymentIntentRequest) (*CreateSbpPaymentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSbpPaymentIntent not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetSbpPaymentIntent(context.Context, *GetSbpPaymentIntentRequest) (*GetSbpPaymentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSbpPaymentIntent not implemented")
+}
+func (UnimplementedPaymentServiceServer) ConfirmSbpPaymentIntent(context.Context, *ConfirmSbpPaymentIntentRequest) (*ConfirmSbpPaymentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSbpPaymentIntent not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetRefund(context.Context, *GetRefundRequest) (*GetRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefunds not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountBalances not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+}
+func (UnimplementedPaymentServiceServer) CheckLedgerConsistency(context.Context, *CheckLedgerConsistencyRequest) (*CheckLedgerConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLedgerConsistency not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateInvestor(context.Context, *CreateInvestorRequest) (*CreateInvestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvestor not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInvestor(context.Context, *GetInvestorRequest) (*GetInvestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvestor not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListInvestors(context.Context, *ListInvestorsRequest) (*ListInvestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvestors not implemented")
+}
+func (UnimplementedPaymentServiceServer) TopUpInvestor(context.Context, *TopUpInvestorRequest) (*TopUpInvestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpInvestor not implemented")
+}
+func (UnimplementedPaymentServiceServer) GrantInvestorAccess(context.Context, *GrantInvestorAccessRequest) (*GrantInvestorAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantInvestorAccess not implemented")
+}
+func (UnimplementedPaymentServiceServer) RevokeInvestorAccess(context.Context, *RevokeInvestorAccessRequest) (*RevokeInvestorAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvestorAccess not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListInvestorMovements(context.Context, *ListInvestorMovementsRequest) (*ListInvestorMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvestorMovements not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListFraudDecisions(context.Context, *ListFraudDecisionsRequest) (*ListFraudDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudDecisions not implemented")
+}
+func (UnimplementedPaymentServiceServer) QuoteInstallmentPlan(context.Context, *QuoteInstallmentPlanRequest) (*QuoteInstallmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteInstallmentPlan not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*GetInstallmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstallmentPlan not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListInstallmentPlans(context.Context, *ListInstallmentPlansRequest) (*ListInstallmentPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstallmentPlans not implemented")
+}
+func (UnimplementedPaymentServiceServer) SubscribePaymentEvents(*SubscribePaymentEventsRequest, grpc.ServerStreamingServer[SubscribePaymentEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePaymentEvents not implemented")
+}
+func (UnimplementedPaymentServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedPaymentServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReplayWebhookDeliveries(context.Context, *ReplayWebhookDeliveriesRequest) (*ReplayWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDeliveries not implemented")
+}
+func (UnimplementedPaymentServiceServer) SaveCard(context.Context, *SaveCardRequest) (*SaveCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCard not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCards not implemented")
+}
+func (UnimplementedPaymentServiceServer) DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReencryptCards(context.Context, *ReencryptCardsRequest) (*ReencryptCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReencryptCards not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedPaymentServiceServer) OpenDispute(context.Context, *OpenDisputeRequest) (*OpenDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetDispute(context.Context, *GetDisputeRequest) (*GetDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListDisputes(context.Context, *ListDisputesRequest) (*ListDisputesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisputes not implemented")
+}
+func (UnimplementedPaymentServiceServer) SubmitDisputeEvidence(context.Context, *SubmitDisputeEvidenceRequest) (*SubmitDisputeEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDisputeEvidence not implemented")
+}
+func (UnimplementedPaymentServiceServer) AcceptDispute(context.Context, *AcceptDisputeRequest) (*AcceptDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) ResolveDispute(context.Context, *ResolveDisputeRequest) (*ResolveDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// result in compilation errors.
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PayOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PayOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PayOrder(ctx, req.(*PayOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_AuthorizePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AuthorizePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_AuthorizePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AuthorizePayment(ctx, req.(*AuthorizePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CapturePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CapturePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CapturePayment(ctx, req.(*CapturePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_VoidAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).VoidAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_VoidAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).VoidAuthorization(ctx, req.(*VoidAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateSbpPaymentIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSbpPaymentIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateSbpPaymentIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateSbpPaymentIntent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateSbpPaymentIntent(ctx, req.(*CreateSbpPaymentIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetSbpPaymentIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSbpPaymentIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetSbpPaymentIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetSbpPaymentIntent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetSbpPaymentIntent(ctx, req.(*GetSbpPaymentIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ConfirmSbpPaymentIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSbpPaymentIntentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ConfirmSbpPaymentIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ConfirmSbpPaymentIntent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ConfirmSbpPaymentIntent(ctx, req.(*ConfirmSbpPaymentIntentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetRefund(ctx, req.(*GetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListAccountBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListAccountBalances(ctx, req.(*ListAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CheckLedgerConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckLedgerConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CheckLedgerConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CheckLedgerConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CheckLedgerConsistency(ctx, req.(*CheckLedgerConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateInvestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateInvestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateInvestor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateInvestor(ctx, req.(*CreateInvestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInvestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInvestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInvestor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInvestor(ctx, req.(*GetInvestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListInvestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListInvestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListInvestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListInvestors(ctx, req.(*ListInvestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_TopUpInvestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpInvestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).TopUpInvestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_TopUpInvestor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).TopUpInvestor(ctx, req.(*TopUpInvestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GrantInvestorAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantInvestorAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GrantInvestorAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GrantInvestorAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GrantInvestorAccess(ctx, req.(*GrantInvestorAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RevokeInvestorAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvestorAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RevokeInvestorAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RevokeInvestorAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RevokeInvestorAccess(ctx, req.(*RevokeInvestorAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListInvestorMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvestorMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListInvestorMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListInvestorMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListInvestorMovements(ctx, req.(*ListInvestorMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListFraudDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListFraudDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListFraudDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListFraudDecisions(ctx, req.(*ListFraudDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_QuoteInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).QuoteInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_QuoteInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).QuoteInstallmentPlan(ctx, req.(*QuoteInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInstallmentPlan(ctx, req.(*GetInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListInstallmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstallmentPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListInstallmentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListInstallmentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListInstallmentPlans(ctx, req.(*ListInstallmentPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SubscribePaymentEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePaymentEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PaymentServiceServer).SubscribePaymentEvents(m, &grpc.GenericServerStream[SubscribePaymentEventsRequest, SubscribePaymentEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PaymentService_SubscribePaymentEventsServer = grpc.ServerStreamingServer[SubscribePaymentEventsResponse]
+
+func _PaymentService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ReplayWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReplayWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ReplayWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReplayWebhookDeliveries(ctx, req.(*ReplayWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedPaymentServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
+func _PaymentService_SaveCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SaveCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SaveCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SaveCard(ctx, req.(*SaveCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
-func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PaymentServiceServer will
-// result in compilation errors.
-type UnsafePaymentServiceServer interface {
-	mustEmbedUnimplementedPaymentServiceServer()
+func _PaymentService_ListCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListCards(ctx, req.(*ListCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _PaymentService_DeleteCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PaymentService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DeleteCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DeleteCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DeleteCard(ctx, req.(*DeleteCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayOrderRequest)
+func _PaymentService_ReencryptCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReencryptCardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).PayOrder(ctx, in)
+		return srv.(PaymentServiceServer).ReencryptCards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_PayOrder_FullMethodName,
+		FullMethod: PaymentService_ReencryptCards_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).PayOrder(ctx, req.(*PayOrderRequest))
+		return srv.(PaymentServiceServer).ReencryptCards(ctx, req.(*ReencryptCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_OpenDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).OpenDispute(ctx, req.(*OpenDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetDispute(ctx, req.(*GetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListDisputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListDisputes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListDisputes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListDisputes(ctx, req.(*ListDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SubmitDisputeEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDisputeEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SubmitDisputeEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SubmitDisputeEvidence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SubmitDisputeEvidence(ctx, req.(*SubmitDisputeEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_AcceptDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AcceptDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_AcceptDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AcceptDispute(ctx, req.(*AcceptDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ResolveDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ResolveDispute(ctx, req.(*ResolveDisputeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -119,7 +1689,177 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayOrder",
 			Handler:    _PaymentService_PayOrder_Handler,
 		},
+		{
+			MethodName: "AuthorizePayment",
+			Handler:    _PaymentService_AuthorizePayment_Handler,
+		},
+		{
+			MethodName: "CapturePayment",
+			Handler:    _PaymentService_CapturePayment_Handler,
+		},
+		{
+			MethodName: "VoidAuthorization",
+			Handler:    _PaymentService_VoidAuthorization_Handler,
+		},
+		{
+			MethodName: "CreateSbpPaymentIntent",
+			Handler:    _PaymentService_CreateSbpPaymentIntent_Handler,
+		},
+		{
+			MethodName: "GetSbpPaymentIntent",
+			Handler:    _PaymentService_GetSbpPaymentIntent_Handler,
+		},
+		{
+			MethodName: "ConfirmSbpPaymentIntent",
+			Handler:    _PaymentService_ConfirmSbpPaymentIntent_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _PaymentService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _PaymentService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _PaymentService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _PaymentService_GetRefund_Handler,
+		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _PaymentService_ListRefunds_Handler,
+		},
+		{
+			MethodName: "ListAccountBalances",
+			Handler:    _PaymentService_ListAccountBalances_Handler,
+		},
+		{
+			MethodName: "ListJournalEntries",
+			Handler:    _PaymentService_ListJournalEntries_Handler,
+		},
+		{
+			MethodName: "CheckLedgerConsistency",
+			Handler:    _PaymentService_CheckLedgerConsistency_Handler,
+		},
+		{
+			MethodName: "CreateInvestor",
+			Handler:    _PaymentService_CreateInvestor_Handler,
+		},
+		{
+			MethodName: "GetInvestor",
+			Handler:    _PaymentService_GetInvestor_Handler,
+		},
+		{
+			MethodName: "ListInvestors",
+			Handler:    _PaymentService_ListInvestors_Handler,
+		},
+		{
+			MethodName: "TopUpInvestor",
+			Handler:    _PaymentService_TopUpInvestor_Handler,
+		},
+		{
+			MethodName: "GrantInvestorAccess",
+			Handler:    _PaymentService_GrantInvestorAccess_Handler,
+		},
+		{
+			MethodName: "RevokeInvestorAccess",
+			Handler:    _PaymentService_RevokeInvestorAccess_Handler,
+		},
+		{
+			MethodName: "ListInvestorMovements",
+			Handler:    _PaymentService_ListInvestorMovements_Handler,
+		},
+		{
+			MethodName: "ListFraudDecisions",
+			Handler:    _PaymentService_ListFraudDecisions_Handler,
+		},
+		{
+			MethodName: "QuoteInstallmentPlan",
+			Handler:    _PaymentService_QuoteInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "GetInstallmentPlan",
+			Handler:    _PaymentService_GetInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "ListInstallmentPlans",
+			Handler:    _PaymentService_ListInstallmentPlans_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _PaymentService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _PaymentService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _PaymentService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _PaymentService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDeliveries",
+			Handler:    _PaymentService_ReplayWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "SaveCard",
+			Handler:    _PaymentService_SaveCard_Handler,
+		},
+		{
+			MethodName: "ListCards",
+			Handler:    _PaymentService_ListCards_Handler,
+		},
+		{
+			MethodName: "DeleteCard",
+			Handler:    _PaymentService_DeleteCard_Handler,
+		},
+		{
+			MethodName: "ReencryptCards",
+			Handler:    _PaymentService_ReencryptCards_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _PaymentService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _PaymentService_OpenDispute_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _PaymentService_GetDispute_Handler,
+		},
+		{
+			MethodName: "ListDisputes",
+			Handler:    _PaymentService_ListDisputes_Handler,
+		},
+		{
+			MethodName: "SubmitDisputeEvidence",
+			Handler:    _PaymentService_SubmitDisputeEvidence_Handler,
+		},
+		{
+			MethodName: "AcceptDispute",
+			Handler:    _PaymentService_AcceptDispute_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _PaymentService_ResolveDispute_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePaymentEvents",
+			Handler:       _PaymentService_SubscribePaymentEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/v1/payment.proto",
 }